@@ -0,0 +1,23 @@
+// Package database provides functionality to manage envoke databases.
+package database
+
+import (
+	"github.com/spf13/cobra"
+)
+
+const GroupID = "database"
+
+func Command() *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: GroupID,
+		Use:     "database",
+		Aliases: []string{"db"},
+		Short:   "Manage envoke databases",
+	}
+
+	cmd.AddCommand(
+		mergeCommand(),
+	)
+
+	return cmd
+}
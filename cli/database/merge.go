@@ -0,0 +1,490 @@
+package database
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/fatih/color"
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+	envpred "github.com/kechako/envoke/ent/environment"
+	mergepred "github.com/kechako/envoke/ent/mergestate"
+	varpred "github.com/kechako/envoke/ent/variable"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+)
+
+func mergeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge [flags] --from <database>",
+		Short: "Merge environments and variables from another envoke database",
+		Long: `Merge environments and variables from another envoke database file.
+
+Environments and variables that do not exist in the current database are
+added. When a variable exists in both databases with different values, the
+change is taken from the database where it was updated since the last merge
+from the same database. If it was updated in both, or if it is merged for the
+first time, the most recently updated one wins (last-writer-wins) and the
+conflict is reported. Comments, expand and secret flags are merged together
+with the values.
+
+Variables that only exist in the current database are kept. The other
+database is read from a snapshot, including the changes not yet checkpointed
+from its write-ahead log, and is never modified.`,
+		Example: `  # Merge everything from another database
+  envoke db merge --from ~/vm/data.db
+
+  # Merge selected environments only
+  envoke db merge --from ~/vm/data.db --env development --env staging
+
+  # Show what would change without merging
+  envoke db merge --from ~/vm/data.db --dry-run`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.ExactArgs(0)(cmd, args); err != nil {
+				return err
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			from, _ := cmd.Flags().GetString("from")
+			envNames, _ := cmd.Flags().GetStringSlice("env")
+			dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+			if from == "" {
+				return clierrors.Exit(errors.New("source database (--from) is required"), 1)
+			}
+			from, err := filepath.Abs(from)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+			for i, name := range envNames {
+				envNames[i] = util.EnvironmentName(ctx, name)
+			}
+
+			srcEnvs, err := loadSourceEnvironments(ctx, from, envNames)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			client := ent.FromContext(ctx)
+
+			var report mergeReport
+			err = client.DoTransaction(ctx, nil, func(ctx context.Context, tx *ent.Tx) error {
+				for _, src := range srcEnvs {
+					err := mergeEnvironment(ctx, tx, from, src, &report)
+					if err != nil {
+						return err
+					}
+				}
+
+				if dryRun {
+					return errDryRun
+				}
+
+				return nil
+			})
+			if err != nil && !errors.Is(err, errDryRun) {
+				return clierrors.Exit(fmt.Errorf("failed to merge database: %w", err), 1)
+			}
+
+			report.print()
+
+			if dryRun {
+				fmt.Println("(Dry run: no changes were made)")
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String("from", "", "Path to the database to merge from")
+	cmd.Flags().StringSlice("env", nil, "Merge only the specified environments (can be repeated)")
+	cmd.Flags().Bool("dry-run", false, "Show the changes without merging (default: false)")
+
+	return cmd
+}
+
+// errDryRun is used to roll back the merge transaction in dry-run mode.
+var errDryRun = errors.New("dry run")
+
+// loadSourceEnvironments loads the environments with their variables from
+// the database at path. A snapshot of the database is taken into a temporary
+// file and migrated there, so that a database of an older version can be read
+// without modifying it.
+func loadSourceEnvironments(ctx context.Context, path string, names []string) ([]*ent.Environment, error) {
+	tmpDir, err := os.MkdirTemp("", "envoke-merge-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	tmpPath := filepath.Join(tmpDir, "data.db")
+	err = snapshotDatabase(ctx, tmpPath, path)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := ent.Open("sqlite3", util.BuildDataSourceName(tmpPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database '%s': %w", path, err)
+	}
+	defer client.Close()
+
+	err = util.MigrateDatabase(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read database '%s': %w", path, err)
+	}
+
+	query := client.Environment.Query().
+		Order(envpred.ByName(sql.OrderAsc())).
+		WithVariables(func(q *ent.VariableQuery) {
+			q.Order(varpred.ByName(sql.OrderAsc()))
+		})
+	if len(names) > 0 {
+		query.Where(envpred.NameIn(names...))
+	}
+
+	envs, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read database '%s': %w", path, err)
+	}
+
+	for _, name := range names {
+		if !slices.ContainsFunc(envs, func(env *ent.Environment) bool { return env.Name == name }) {
+			return nil, fmt.Errorf("environment '%s' not found in '%s'", name, path)
+		}
+	}
+
+	return envs, nil
+}
+
+// snapshotDatabase writes a consistent copy of the database src to dst. src is
+// opened read-only, and its write-ahead log is read like by any other reader,
+// so that recent changes are included even if they are not checkpointed.
+func snapshotDatabase(ctx context.Context, dst, src string) error {
+	if _, err := os.Stat(src); err != nil {
+		return fmt.Errorf("failed to open database '%s': %w", src, err)
+	}
+
+	dsn := &url.URL{
+		Scheme:   "file",
+		Opaque:   src,
+		RawQuery: url.Values{"mode": {"ro"}}.Encode(),
+	}
+	db, err := stdsql.Open("sqlite3", dsn.String())
+	if err != nil {
+		return fmt.Errorf("failed to open database '%s': %w", src, err)
+	}
+	defer db.Close()
+
+	_, err = db.ExecContext(ctx, "VACUUM INTO ?", dst)
+	if err != nil {
+		return fmt.Errorf("failed to read database '%s': %w", src, err)
+	}
+
+	return nil
+}
+
+// mergeEnvironment merges the environment src of the database at path, loaded
+// with its variables, into the current database.
+func mergeEnvironment(ctx context.Context, tx *ent.Tx, path string, src *ent.Environment, report *mergeReport) error {
+	dst, err := tx.Environment.Query().
+		Where(envpred.Name(src.Name)).
+		WithVariables().
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	var state *ent.MergeState
+	if dst != nil {
+		state, err = tx.MergeState.Query().
+			Where(
+				mergepred.EnvironmentID(dst.ID),
+				mergepred.Path(path),
+			).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("failed to query merge state: %w", err)
+		}
+	}
+	base := newMergeBase(state)
+
+	if dst == nil {
+		create := tx.Environment.Create().
+			SetName(src.Name)
+		if src.Description != "" {
+			create.SetDescription(src.Description)
+		}
+		setTimes(create.Mutation(), src.CreatedAt, src.UpdatedAt)
+
+		dst, err = create.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create environment '%s': %w", src.Name, err)
+		}
+		report.addedEnvs++
+	} else if dst.Description != src.Description {
+		takeRemote, conflict := base.resolve("", dst.UpdatedAt, src.UpdatedAt)
+		if takeRemote {
+			update := tx.Environment.UpdateOne(dst)
+			if src.Description != "" {
+				update.SetDescription(src.Description)
+			} else {
+				update.ClearDescription()
+			}
+			update.SetUpdatedAt(src.UpdatedAt)
+
+			dst, err = update.Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to update environment '%s': %w", src.Name, err)
+			}
+		}
+		if conflict {
+			report.addConflict(src.Name, "", base.local[""], src.UpdatedAt, takeRemote)
+		}
+	}
+	next := mergeBase{
+		local:  map[string]time.Time{"": dst.UpdatedAt},
+		remote: map[string]time.Time{"": src.UpdatedAt},
+	}
+	if dst.Description != src.Description {
+		next.keepLocal(base, "")
+	}
+
+	dstVars := util.MakeVariableMap(dst.Edges.Variables)
+
+	for _, sv := range src.Edges.Variables {
+		next.remote[sv.Name] = sv.UpdatedAt
+
+		dv, exists := dstVars[sv.Name]
+		if !exists {
+			create := tx.Variable.Create().
+				SetEnvironment(dst).
+				SetName(sv.Name).
+				SetValue(sv.Value)
+			if sv.Expand {
+				create.SetExpand(true)
+			}
+			if sv.Secret {
+				create.SetSecret(true)
+			}
+			if sv.Comment != "" {
+				create.SetComment(sv.Comment)
+			}
+			util.SetVariableRule(create.Mutation(), sv)
+			setTimes(create.Mutation(), sv.CreatedAt, sv.UpdatedAt)
+
+			v, err := create.Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create variable '%s' in environment '%s': %w", sv.Name, src.Name, err)
+			}
+			next.local[sv.Name] = v.UpdatedAt
+			report.addedVars++
+			continue
+		}
+
+		next.local[sv.Name] = dv.UpdatedAt
+
+		if dv.Value == sv.Value && dv.Expand == sv.Expand && dv.Secret == sv.Secret && dv.Comment == sv.Comment && util.EqualVariableRule(dv, sv) {
+			continue
+		}
+
+		takeRemote, conflict := base.resolve(sv.Name, dv.UpdatedAt, sv.UpdatedAt)
+		if conflict {
+			report.addConflict(src.Name, sv.Name, dv.UpdatedAt, sv.UpdatedAt, takeRemote)
+		}
+		if !takeRemote {
+			next.keepLocal(base, sv.Name)
+			continue
+		}
+
+		update := tx.Variable.UpdateOne(dv).
+			SetValue(sv.Value).
+			SetUpdatedAt(sv.UpdatedAt)
+		if sv.Expand {
+			update.SetExpand(true)
+		} else {
+			update.ClearExpand()
+		}
+		if sv.Secret {
+			update.SetSecret(true)
+		} else {
+			update.ClearSecret()
+		}
+		if sv.Comment != "" {
+			update.SetComment(sv.Comment)
+		} else {
+			update.ClearComment()
+		}
+		util.SetVariableRule(update.Mutation(), sv)
+
+		v, err := update.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update variable '%s' in environment '%s': %w", sv.Name, src.Name, err)
+		}
+		next.local[sv.Name] = v.UpdatedAt
+		report.updatedVars++
+	}
+
+	if state != nil {
+		err = tx.MergeState.UpdateOne(state).
+			SetLocal(next.local).
+			SetRemote(next.remote).
+			Exec(ctx)
+	} else {
+		err = tx.MergeState.Create().
+			SetEnvironment(dst).
+			SetPath(path).
+			SetLocal(next.local).
+			SetRemote(next.remote).
+			Exec(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save merge state of environment '%s': %w", src.Name, err)
+	}
+
+	return nil
+}
+
+// mergeBase is the modification times of the variables of an environment in
+// the current database (local) and in the merged database (remote) after the
+// last merge. The times of the environment itself have the empty name.
+type mergeBase struct {
+	local  map[string]time.Time
+	remote map[string]time.Time
+}
+
+func newMergeBase(state *ent.MergeState) mergeBase {
+	if state == nil {
+		return mergeBase{}
+	}
+	return mergeBase{local: state.Local, remote: state.Remote}
+}
+
+// keepLocal keeps the local time of name from the previous base when the
+// local side is kept while it still differs from the remote side, so that a
+// later remote update is detected as a conflict rather than overwriting the
+// local change.
+func (b mergeBase) keepLocal(prev mergeBase, name string) {
+	if t, ok := prev.local[name]; ok {
+		b.local[name] = t
+	} else {
+		delete(b.local, name)
+	}
+}
+
+// resolve decides how a variable name that differs between the databases is
+// merged, given its modification times. The remote change is taken if only
+// the remote side was updated since the last merge. If both sides were
+// updated, or the variable was not merged before, the most recent one wins
+// and the difference is a conflict.
+func (b mergeBase) resolve(name string, local, remote time.Time) (takeRemote, conflict bool) {
+	baseLocal, okLocal := b.local[name]
+	baseRemote, okRemote := b.remote[name]
+	localChanged := !okLocal || !local.Equal(baseLocal)
+	remoteChanged := !okRemote || !remote.Equal(baseRemote)
+
+	switch {
+	case localChanged && remoteChanged:
+		return remote.After(local), true
+	case remoteChanged:
+		return true, false
+	default:
+		// Only the local side was updated, or neither since a conflict was
+		// resolved in favor of the local side.
+		return false, false
+	}
+}
+
+// setTimes copies the creation and modification times of a merged entity.
+// Zero times (rows created before the fields were introduced) are left to
+// their defaults.
+func setTimes(m interface {
+	SetCreatedAt(time.Time)
+	SetUpdatedAt(time.Time)
+}, createdAt, updatedAt time.Time) {
+	if !createdAt.IsZero() {
+		m.SetCreatedAt(createdAt)
+	}
+	if !updatedAt.IsZero() {
+		m.SetUpdatedAt(updatedAt)
+	}
+}
+
+type mergeResolution string
+
+const (
+	resolutionLocal  mergeResolution = "kept local"
+	resolutionRemote mergeResolution = "took remote"
+)
+
+type mergeConflict struct {
+	env        string
+	name       string
+	local      time.Time
+	remote     time.Time
+	resolution mergeResolution
+}
+
+type mergeReport struct {
+	addedEnvs   int
+	addedVars   int
+	updatedVars int
+	conflicts   []*mergeConflict
+}
+
+func (r *mergeReport) addConflict(env, name string, local, remote time.Time, takeRemote bool) {
+	resolution := resolutionLocal
+	if takeRemote {
+		resolution = resolutionRemote
+	}
+	r.conflicts = append(r.conflicts, &mergeConflict{
+		env:        env,
+		name:       name,
+		local:      local,
+		remote:     remote,
+		resolution: resolution,
+	})
+}
+
+func (r *mergeReport) print() {
+	if len(r.conflicts) > 0 {
+		headerFmt := color.New(color.ResetUnderline, color.Bold).SprintfFunc()
+
+		tbl := table.New("Environment", "Variable", "Local Updated", "Remote Updated", "Resolution")
+		tbl.WithHeaderFormatter(headerFmt)
+
+		for _, c := range r.conflicts {
+			name := c.name
+			if name == "" {
+				name = "(description)"
+			}
+			tbl.AddRow(c.env, name, formatTime(c.local), formatTime(c.remote), c.resolution)
+		}
+
+		tbl.Print()
+		fmt.Println()
+	}
+
+	fmt.Printf("Merged: %d environments added, %d variables added, %d variables updated, %d conflicts.\n",
+		r.addedEnvs, r.addedVars, r.updatedVars, len(r.conflicts))
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "(unknown)"
+	}
+	return t.Local().Format(time.DateTime)
+}
@@ -0,0 +1,43 @@
+package database
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMergeBaseResolve(t *testing.T) {
+	t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Hour)
+	t2 := t0.Add(2 * time.Hour)
+
+	base := mergeBase{
+		local:  map[string]time.Time{"A": t0},
+		remote: map[string]time.Time{"A": t0},
+	}
+
+	tests := []struct {
+		name           string
+		base           mergeBase
+		variable       string
+		local, remote  time.Time
+		wantTakeRemote bool
+		wantConflict   bool
+	}{
+		{name: "remote changed", base: base, variable: "A", local: t0, remote: t1, wantTakeRemote: true},
+		{name: "local changed", base: base, variable: "A", local: t1, remote: t0},
+		{name: "neither changed", base: base, variable: "A", local: t0, remote: t0},
+		{name: "both changed, remote newer", base: base, variable: "A", local: t1, remote: t2, wantTakeRemote: true, wantConflict: true},
+		{name: "both changed, local newer", base: base, variable: "A", local: t2, remote: t1, wantConflict: true},
+		{name: "not merged before", base: base, variable: "B", local: t1, remote: t2, wantTakeRemote: true, wantConflict: true},
+		{name: "first merge", base: mergeBase{}, variable: "A", local: t2, remote: t1, wantConflict: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			takeRemote, conflict := tt.base.resolve(tt.variable, tt.local, tt.remote)
+			if takeRemote != tt.wantTakeRemote || conflict != tt.wantConflict {
+				t.Errorf("resolve() = (%v, %v), want (%v, %v)", takeRemote, conflict, tt.wantTakeRemote, tt.wantConflict)
+			}
+		})
+	}
+}
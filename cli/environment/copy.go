@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/util"
 	"github.com/kechako/envoke/ent"
 	envpred "github.com/kechako/envoke/ent/environment"
 	"github.com/spf13/cobra"
@@ -34,8 +35,8 @@ func CopyCommand() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
-			name := args[0]
-			newName := args[1]
+			name := util.EnvironmentName(ctx, args[0])
+			newName := util.EnvironmentName(ctx, args[1])
 
 			client := ent.FromContext(ctx)
 
@@ -58,6 +59,9 @@ func CopyCommand() *cobra.Command {
 				if env.Description != "" {
 					create.SetDescription(env.Description)
 				}
+				if env.SchemaID != nil {
+					create.SetSchemaID(*env.SchemaID)
+				}
 
 				newEnv, err := create.Save(ctx)
 				if err != nil {
@@ -76,9 +80,13 @@ func CopyCommand() *cobra.Command {
 					if v.Expand {
 						create.SetExpand(true)
 					}
+					if v.Secret {
+						create.SetSecret(true)
+					}
 					if v.Comment != "" {
 						create.SetComment(v.Comment)
 					}
+					util.SetVariableRule(create.Mutation(), v)
 
 					builders[i] = create
 				}
@@ -1,11 +1,17 @@
 package environment
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"os"
+	"strings"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/util"
 	"github.com/kechako/envoke/ent"
+	keypred "github.com/kechako/envoke/ent/schemakey"
 	"github.com/spf13/cobra"
 )
 
@@ -19,12 +25,20 @@ func CreateCommand() *cobra.Command {
 
 Each environment maintains its own set of variables that can be used
 when running commands. Variables from the 'global' environment are
-always included and can be overridden by environment-specific values.`,
+always included and can be overridden by environment-specific values.
+
+With --from-schema, the environment is attached to the schema, and a variable
+is created for each key of the schema with a default value, with the type, the
+constraints and the secret flag of the key. Keys without a default value are
+listed so that they can be set afterwards.`,
 		Example: `  # Create a basic environment
   envoke create development
 
   # Create an environment with description
-  envoke create production --description "Production environment"`,
+  envoke create production --description "Production environment"
+
+  # Create an environment from the keys and the defaults of a schema
+  envoke create staging --from-schema myapp`,
 		Args: func(cmd *cobra.Command, args []string) error {
 			if err := cobra.ExactArgs(1)(cmd, args); err != nil {
 				return err
@@ -42,34 +56,121 @@ always included and can be overridden by environment-specific values.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
-			name := args[0]
+			name := util.EnvironmentName(ctx, args[0])
 
 			client := ent.FromContext(ctx)
 
-			create := client.Environment.Create().
-				SetName(name)
-			setEnvironmentMutation(create.Mutation(), cmd)
+			schemaName, _ := cmd.Flags().GetString("from-schema")
+
+			var env *ent.Environment
+			var unset []string
+			err := client.DoTransaction(ctx, nil, func(ctx context.Context, tx *ent.Tx) error {
+				create := tx.Environment.Create().
+					SetName(name)
+				setEnvironmentMutation(create.Mutation(), cmd)
+
+				var schema *ent.EnvironmentSchema
+				if schemaName != "" {
+					var err error
+					schema, err = util.FindSchema(ctx, tx.Client(), schemaName)
+					if err != nil {
+						return clierrors.Exit(err, 1)
+					}
+					create.SetSchema(schema)
+				}
 
-			env, err := create.Save(ctx)
-			if err != nil {
-				if ent.IsConstraintError(err) {
-					return clierrors.Exit(fmt.Errorf("environment '%s' already exists", name), 1)
+				var err error
+				env, err = create.Save(ctx)
+				if err != nil {
+					if ent.IsConstraintError(err) {
+						return clierrors.Exit(fmt.Errorf("environment '%s' already exists", name), 1)
+					}
+					return clierrors.Exit(err, 1)
 				}
-				return clierrors.Exit(err, 1)
+
+				if schema != nil {
+					unset, err = scaffoldEnvironment(ctx, tx, schema, env)
+					if err != nil {
+						return clierrors.Exit(err, 1)
+					}
+				}
+
+				return nil
+			})
+			if err != nil {
+				return err
 			}
 
 			// Print success message
 			fmt.Printf("Environment '%s' created successfully!\n", env.Name)
+			if len(unset) > 0 {
+				fmt.Fprintf(os.Stderr, "Warning: environment '%s' does not match its schema until these keys are set: %s\n", env.Name, strings.Join(unset, ", "))
+				fmt.Fprintf(os.Stderr, "(use 'envoke var add -e %s <key> <value>' to set them)\n", env.Name)
+			}
 
 			return nil
 		},
 	}
 
 	cmd.Flags().StringP("description", "d", "", "Description of the environment")
+	cmd.Flags().String("from-schema", "", "Create the variables of the environment from the defaults of a schema")
 
 	return cmd
 }
 
+// scaffoldEnvironment creates the variables of env from the keys of schema
+// with default values. It returns the names of the required keys without
+// default values, which are reported as missing by schema check until set.
+func scaffoldEnvironment(ctx context.Context, tx *ent.Tx, schema *ent.EnvironmentSchema, env *ent.Environment) ([]string, error) {
+	keys, err := schema.QueryKeys().
+		Order(keypred.ByName(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query keys of schema '%s': %w", schema.Name, err)
+	}
+
+	var unset []string
+	for _, k := range keys {
+		if k.DefaultValue == nil {
+			if !k.Optional {
+				unset = append(unset, k.Name)
+			}
+			continue
+		}
+
+		create := tx.Variable.Create().
+			SetEnvironment(env).
+			SetName(k.Name).
+			SetValue(*k.DefaultValue).
+			SetType(k.Type)
+		if k.Secret {
+			create.SetSecret(true)
+		}
+		if k.Description != "" {
+			create.SetComment(k.Description)
+		}
+		if k.Pattern != "" {
+			create.SetPattern(k.Pattern)
+		}
+		if k.Min != "" {
+			create.SetMin(k.Min)
+		}
+		if k.Max != "" {
+			create.SetMax(k.Max)
+		}
+		if len(k.EnumValues) > 0 {
+			create.SetEnumValues(k.EnumValues)
+		}
+
+		err := create.Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create variable '%s': %w", k.Name, err)
+		}
+	}
+
+	return unset, nil
+}
+
 func setEnvironmentMutation_(m *ent.EnvironmentMutation, cmd any) {
 }
 
@@ -0,0 +1,126 @@
+package environment
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/output"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+	varpred "github.com/kechako/envoke/ent/variable"
+	"github.com/spf13/cobra"
+)
+
+func DiffCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: GroupID,
+		Use:     "diff [flags] <name> <other-name>",
+		Short:   "Show the differences between two environments",
+		Long: `Show the differences of variables between two environments.
+
+Variables only in the first environment are reported as 'removed', variables
+only in the second environment as 'added', and variables with different
+values or expand flags as 'changed'. Values of secret variables are masked
+unless --show-secrets is given.`,
+		Example: `  # Compare staging with production
+  envoke diff staging production
+
+  # Output the differences as JSON
+  envoke diff staging production -o json`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.ExactArgs(2)(cmd, args); err != nil {
+				return err
+			}
+
+			if args[0] == "" || args[1] == "" {
+				return clierrors.Exit(errors.New("environment name cannot be empty"), 1)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			all, _ := cmd.Flags().GetBool("all")
+			showSecrets, _ := cmd.Flags().GetBool("show-secrets")
+
+			format, err := output.FromCommand(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := ent.FromContext(ctx)
+
+			env, err := util.FindEnvironment(ctx, client, util.EnvironmentName(ctx, args[0]))
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+			other, err := util.FindEnvironment(ctx, client, util.EnvironmentName(ctx, args[1]))
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			vars, err := env.QueryVariables().Order(varpred.ByName(sql.OrderAsc())).All(ctx)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+			otherVars, err := other.QueryVariables().Order(varpred.ByName(sql.OrderAsc())).All(ctx)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			type Diff struct {
+				Name       string `json:"name" yaml:"name"`
+				Status     string `json:"status" yaml:"status"`
+				Value      string `json:"value" yaml:"value"`
+				OtherValue string `json:"other_value" yaml:"other_value"`
+			}
+
+			value := func(v *ent.Variable) string {
+				if v == nil {
+					return ""
+				}
+				return util.MaskSecret(v.Value, v.Secret && !showSecrets)
+			}
+
+			var records []*Diff
+			for _, d := range util.DiffVariables(vars, otherVars) {
+				if d.Status == util.DiffUnchanged && !all {
+					continue
+				}
+				records = append(records, &Diff{
+					Name:       d.Name,
+					Status:     d.Status.String(),
+					Value:      value(d.Variable),
+					OtherValue: value(d.Other),
+				})
+			}
+
+			if len(records) == 0 && format.Kind == output.Table {
+				fmt.Println("(No differences found)")
+				return nil
+			}
+
+			err = output.Print(os.Stdout, format, records, output.Columns[*Diff]{
+				Headers: []string{"Name", "Status", env.Name, other.Name},
+				Fields:  []string{"name", "status", "value", "other_value"},
+				Row: func(d *Diff) []any {
+					return []any{d.Name, d.Status, d.Value, d.OtherValue}
+				},
+			})
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool("all", false, "Include unchanged variables (default: false)")
+	cmd.Flags().Bool("show-secrets", false, "Show the values of secret variables (default: false)")
+
+	return cmd
+}
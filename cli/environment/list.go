@@ -2,16 +2,16 @@ package environment
 
 import (
 	"fmt"
+	"os"
 	"slices"
 	"strings"
 
 	"entgo.io/ent/dialect/sql"
-	"github.com/fatih/color"
 	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/output"
 	"github.com/kechako/envoke/ent"
 	envpred "github.com/kechako/envoke/ent/environment"
 	varpred "github.com/kechako/envoke/ent/variable"
-	"github.com/rodaine/table"
 	"github.com/spf13/cobra"
 )
 
@@ -24,18 +24,23 @@ func ListCommand() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
+			format, err := output.FromCommand(cmd)
+			if err != nil {
+				return err
+			}
+
 			client := ent.FromContext(ctx)
 
 			type Env struct {
-				ID             int    `json:"id"`
-				Name           string `json:"name"`
-				Description    string `json:"description"`
-				VariablesCount int    `json:"variables_count"`
+				ID             int    `json:"id" yaml:"id"`
+				Name           string `json:"name" yaml:"name"`
+				Description    string `json:"description" yaml:"description"`
+				VariablesCount int    `json:"variables_count" yaml:"variables_count"`
 			}
 
 			var envs []*Env
 
-			err := client.Environment.Query().
+			err = client.Environment.Query().
 				Order(envpred.ByName(sql.OrderAsc())).
 				GroupBy(envpred.FieldID, envpred.FieldName, envpred.FieldDescription).
 				Aggregate(func(s *sql.Selector) string {
@@ -48,7 +53,7 @@ func ListCommand() *cobra.Command {
 				return clierrors.Exit(err, 1)
 			}
 
-			if len(envs) == 0 {
+			if len(envs) == 0 && format.Kind == output.Table {
 				fmt.Println("(No environments found)")
 				return nil
 			}
@@ -64,17 +69,20 @@ func ListCommand() *cobra.Command {
 				return strings.Compare(a.Name, b.Name)
 			})
 
-			headerFmt := color.New(color.ResetUnderline, color.Bold).SprintfFunc()
-
-			tbl := table.New("Name", "Description", "Variables")
-			tbl.WithHeaderFormatter(headerFmt)
-
-			for _, env := range envs {
-				tbl.AddRow(env.Name, env.Description, formatVariablesCount(env.VariablesCount))
+			err = output.Print(os.Stdout, format, envs, output.Columns[*Env]{
+				Headers: []string{"Name", "Description", "Variables"},
+				Fields:  []string{"name", "description", "variables_count"},
+				Row: func(env *Env) []any {
+					if format.Kind == output.Table {
+						return []any{env.Name, env.Description, formatVariablesCount(env.VariablesCount)}
+					}
+					return []any{env.Name, env.Description, env.VariablesCount}
+				},
+			})
+			if err != nil {
+				return clierrors.Exit(err, 1)
 			}
 
-			tbl.Print()
-
 			return nil
 		},
 	}
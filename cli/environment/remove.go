@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"slices"
 
 	"github.com/kechako/envoke/cli/clierrors"
 	"github.com/kechako/envoke/cli/util"
@@ -34,7 +36,7 @@ func RemoveCommand() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
-			name := args[0]
+			name := util.EnvironmentName(ctx, args[0])
 
 			client := ent.FromContext(ctx)
 
@@ -46,6 +48,18 @@ func RemoveCommand() *cobra.Command {
 					return clierrors.Exit(err, 1)
 				}
 
+				refs, err := util.ReferencingVariables(ctx, tx.Client(), name)
+				if err != nil {
+					return clierrors.Exit(err, 1)
+				}
+				refs = slices.DeleteFunc(refs, func(v *ent.Variable) bool { return v.EnvironmentID == env.ID })
+				if len(refs) > 0 {
+					fmt.Fprintf(os.Stderr, "Warning: environment '%s' is still referenced by:\n", name)
+					for _, v := range refs {
+						fmt.Fprintf(os.Stderr, "  %s (environment '%s')\n", v.Name, v.Edges.Environment.Name)
+					}
+				}
+
 				confirm, err := confirmRemoval()
 				if err != nil {
 					return clierrors.Exit(err, 1)
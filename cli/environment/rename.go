@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/util"
 	"github.com/kechako/envoke/ent"
 	envpred "github.com/kechako/envoke/ent/environment"
 	"github.com/spf13/cobra"
@@ -17,6 +18,10 @@ func RenameCommand() *cobra.Command {
 		Use:     "rename [flags] <name> <new-name>",
 		Aliases: []string{"mv"},
 		Short:   "Rename an environment",
+		Long: `Rename an environment.
+
+References to the variables of the environment in values to be expanded, of
+the form ${ENV:NAME} or ${env:ENV/NAME}, are rewritten to the new name.`,
 		Args: func(cmd *cobra.Command, args []string) error {
 			if err := cobra.ExactArgs(2)(cmd, args); err != nil {
 				return err
@@ -40,12 +45,13 @@ func RenameCommand() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
-			name := args[0]
-			newName := args[1]
+			name := util.EnvironmentName(ctx, args[0])
+			newName := util.EnvironmentName(ctx, args[1])
 
 			client := ent.FromContext(ctx)
 
 			var env *ent.Environment
+			var rewritten []*ent.Variable
 
 			err := client.DoTransaction(ctx, nil, func(ctx context.Context, tx *ent.Tx) error {
 				var err error
@@ -69,6 +75,25 @@ func RenameCommand() *cobra.Command {
 					return err
 				}
 
+				rewritten, err = util.ReferencingVariables(ctx, tx.Client(), name)
+				if err != nil {
+					return err
+				}
+				for _, v := range rewritten {
+					value := util.RewriteReferences(v.Value, func(ref string) (string, bool) {
+						if refEnv, _ := util.ParseReference(ref); refEnv != name {
+							return "", false
+						}
+						return util.RenameReferenceEnvironment(ref, newName), true
+					})
+					err = tx.Variable.UpdateOne(v).
+						SetValue(value).
+						Exec(ctx)
+					if err != nil {
+						return fmt.Errorf("failed to update variable '%s': %w", v.Name, err)
+					}
+				}
+
 				return nil
 			})
 			if err != nil {
@@ -76,6 +101,13 @@ func RenameCommand() *cobra.Command {
 			}
 
 			fmt.Printf("Environment '%s' renamed to '%s' successfully!\n", name, env.Name)
+			for _, v := range rewritten {
+				envName := v.Edges.Environment.Name
+				if envName == name {
+					envName = env.Name
+				}
+				fmt.Printf("Rewrote references in variable '%s' (environment '%s')\n", v.Name, envName)
+			}
 
 			return nil
 		},
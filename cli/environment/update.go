@@ -30,7 +30,7 @@ func UpdateCommand() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
-			name := args[0]
+			name := util.EnvironmentName(ctx, args[0])
 
 			if name == "global" {
 				return clierrors.Exit(errors.New("cannot update environment 'global' (protected)"), 1)
@@ -0,0 +1,379 @@
+package execution
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+	"github.com/spf13/cobra"
+)
+
+// activateStateName is the name of the private variable recording the
+// variables set by activate and their previous values.
+const activateStateName = "__ENVOKE_ACTIVATE"
+
+func ActivateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: GroupID,
+		Use:     "activate [flags]",
+		Short:   "Print commands to load an environment into the current shell",
+		Long: `Print shell commands that load the variables of the specified environment
+into the current shell. Evaluate the output in the shell to activate the
+environment, and run deactivate in the same way to restore the previous values.
+
+Supported shells are bash, zsh, fish and powershell. The shell is detected from
+$SHELL (powershell on Windows), and can be specified with --shell.
+
+ENVOKE_ENV is set to the name of the environment. The variables set and their
+previous values are recorded in the __ENVOKE_ACTIVATE variable. Activating
+another environment first restores the variables of the active one.`,
+		Example: `  # bash / zsh
+  eval "$(envoke activate -e development)"
+  eval "$(envoke deactivate)"
+
+  # fish
+  envoke activate -e development | source
+  envoke deactivate | source
+
+  # PowerShell
+  envoke activate -e development --shell powershell | Out-String | Invoke-Expression
+  envoke deactivate --shell powershell | Out-String | Invoke-Expression`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.ExactArgs(0)(cmd, args); err != nil {
+				return err
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			syntax, err := shellSyntaxFromCommand(cmd)
+			if err != nil {
+				return err
+			}
+
+			state, err := loadActivateState()
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			env, err := util.LoadEnvironment(ctx, cmd)
+			if err != nil {
+				return err
+			}
+
+			script, err := activateScript(ctx, syntax, state, &activateState{Env: env.Name})
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			_, err = io.WriteString(os.Stdout, script)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("env", "e", "", "Specify the environment to manage")
+	cmd.Flags().String("shell", "", "Shell to print commands for (bash, zsh, fish, powershell)")
+
+	return cmd
+}
+
+func DeactivateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: GroupID,
+		Use:     "deactivate [flags]",
+		Short:   "Print commands to restore the shell after activate",
+		Long: `Print shell commands that restore the variables set by activate to their
+previous values, or unset them if they were not set before.`,
+		Example: `  # bash / zsh
+  eval "$(envoke deactivate)"
+
+  # fish
+  envoke deactivate | source`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.ExactArgs(0)(cmd, args); err != nil {
+				return err
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			syntax, err := shellSyntaxFromCommand(cmd)
+			if err != nil {
+				return err
+			}
+
+			state, err := loadActivateState()
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+			if state == nil {
+				return clierrors.Exit(errors.New("no environment is activated"), 1)
+			}
+
+			_, err = io.WriteString(os.Stdout, deactivateScript(syntax, state))
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String("shell", "", "Shell to print commands for (bash, zsh, fish, powershell)")
+
+	return cmd
+}
+
+// activateScript returns the commands that activate the environment of
+// newState, and restore the variables of the active environment (state, if
+// not nil) that it does not set. The variables set are recorded in newState.
+func activateScript(ctx context.Context, syntax shellSyntax, state, newState *activateState) (string, error) {
+	if state != nil {
+		// Variables are resolved against the environment before activation.
+		state.restoreProcess()
+	}
+
+	client := ent.FromContext(ctx)
+
+	globalEnv, err := util.LoadGlobalEnvironment(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	env, err := util.FindEnvironment(ctx, client, newState.Env)
+	if err != nil {
+		return "", err
+	}
+
+	environ, err := makeEnviron(ctx, globalEnv, env, &environOptions{})
+	if err != nil {
+		return "", err
+	}
+	environ = setEnviron(environ, shellEnvName, env.Name)
+
+	var buf strings.Builder
+	for _, e := range environ {
+		if e.source == util.SourceOS {
+			continue
+		}
+		if !shellNamePattern.MatchString(e.name) {
+			fmt.Fprintf(os.Stderr, "Warning: skipping variable '%s': the name cannot be used in a shell\n", e.name)
+			continue
+		}
+
+		v := activateVariable{Name: e.name}
+		if prev, ok := os.LookupEnv(e.name); ok {
+			v.Previous = &prev
+		}
+		newState.Vars = append(newState.Vars, v)
+
+		buf.WriteString(syntax.set(e.name, e.value))
+	}
+
+	if state != nil {
+		for _, v := range state.Vars {
+			if !newState.has(v.Name) {
+				buf.WriteString(v.restore(syntax))
+			}
+		}
+	}
+
+	encoded, err := newState.encode()
+	if err != nil {
+		return "", err
+	}
+	buf.WriteString(syntax.set(activateStateName, encoded))
+
+	return buf.String(), nil
+}
+
+// deactivateScript returns the commands that restore the variables set by
+// activation.
+func deactivateScript(syntax shellSyntax, state *activateState) string {
+	var buf strings.Builder
+	for _, v := range state.Vars {
+		buf.WriteString(v.restore(syntax))
+	}
+	buf.WriteString(syntax.unset(activateStateName))
+
+	return buf.String()
+}
+
+// activateState is the content of the __ENVOKE_ACTIVATE variable.
+type activateState struct {
+	Env string `json:"env"`
+	// Project and Hash are the path and the content hash of the project file
+	// when the environment is activated by the shell hook.
+	Project string             `json:"project,omitempty"`
+	Hash    string             `json:"hash,omitempty"`
+	Vars    []activateVariable `json:"vars"`
+}
+
+type activateVariable struct {
+	Name string `json:"name"`
+	// Previous is the value before activation, or nil if it was not set.
+	Previous *string `json:"previous,omitempty"`
+}
+
+func loadActivateState() (*activateState, error) {
+	encoded, ok := os.LookupEnv(activateStateName)
+	if !ok || encoded == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s variable: %w", activateStateName, err)
+	}
+
+	var state activateState
+	err = json.Unmarshal(data, &state)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s variable: %w", activateStateName, err)
+	}
+
+	return &state, nil
+}
+
+func (s *activateState) encode() (string, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode %s variable: %w", activateStateName, err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func (s *activateState) has(name string) bool {
+	for _, v := range s.Vars {
+		if environKey(v.Name) == environKey(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// restoreProcess restores the previous values in the environment of the
+// current process.
+func (s *activateState) restoreProcess() {
+	for _, v := range s.Vars {
+		if v.Previous != nil {
+			os.Setenv(v.Name, *v.Previous)
+		} else {
+			os.Unsetenv(v.Name)
+		}
+	}
+}
+
+func (v activateVariable) restore(syntax shellSyntax) string {
+	if v.Previous != nil {
+		return syntax.set(v.Name, *v.Previous)
+	}
+	return syntax.unset(v.Name)
+}
+
+// shellNamePattern matches the variable names that can be set in all the
+// supported shells.
+var shellNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// shellSyntax prints the commands to set and unset variables in a shell.
+type shellSyntax interface {
+	set(name, value string) string
+	unset(name string) string
+}
+
+func shellSyntaxFromCommand(cmd *cobra.Command) (shellSyntax, error) {
+	shell, _ := cmd.Flags().GetString("shell")
+	if shell == "" {
+		if runtime.GOOS == "windows" {
+			shell = "powershell"
+		} else {
+			shell = filepath.Base(os.Getenv("SHELL"))
+		}
+	}
+
+	if shell == "" || shell == "." {
+		return nil, clierrors.Exit(errors.New("cannot detect the shell (use --shell to specify bash, zsh, fish or powershell)"), 1)
+	}
+
+	syntax, err := shellSyntaxFor(shell)
+	if err != nil {
+		return nil, clierrors.Exit(err, 1)
+	}
+
+	return syntax, nil
+}
+
+func shellSyntaxFor(shell string) (shellSyntax, error) {
+	switch strings.ToLower(shell) {
+	case "bash", "zsh", "sh":
+		return posixSyntax{}, nil
+	case "fish":
+		return fishSyntax{}, nil
+	case "powershell", "pwsh":
+		return powershellSyntax{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported shell '%s' (must be bash, zsh, fish or powershell)", shell)
+	}
+}
+
+// posixSyntax is the syntax of bash, zsh and other POSIX shells.
+type posixSyntax struct{}
+
+func (posixSyntax) set(name, value string) string {
+	return "export " + name + "=" + posixQuote(value) + ";\n"
+}
+
+func posixQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func (posixSyntax) unset(name string) string {
+	return "unset " + name + ";\n"
+}
+
+type fishSyntax struct{}
+
+var fishEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+func (fishSyntax) set(name, value string) string {
+	return "set -gx " + name + " " + fishQuote(value) + ";\n"
+}
+
+func fishQuote(s string) string {
+	return "'" + fishEscaper.Replace(s) + "'"
+}
+
+func (fishSyntax) unset(name string) string {
+	return "set -e -g " + name + ";\n"
+}
+
+type powershellSyntax struct{}
+
+// PowerShell also treats typographic single quotes as quotes, and each is
+// escaped by doubling it.
+var powershellEscaper = strings.NewReplacer(`'`, `''`, "‘", "‘‘", "’", "’’", "‚", "‚‚", "‛", "‛‛")
+
+func (powershellSyntax) set(name, value string) string {
+	return "${env:" + name + "} = '" + powershellEscaper.Replace(value) + "'\n"
+}
+
+func (powershellSyntax) unset(name string) string {
+	return "Remove-Item -LiteralPath 'env:" + name + "' -ErrorAction SilentlyContinue\n"
+}
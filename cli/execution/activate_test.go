@@ -0,0 +1,116 @@
+package execution
+
+import (
+	"os/exec"
+	"testing"
+)
+
+func TestPosixQuote(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{s: "", want: `''`},
+		{s: "plain", want: `'plain'`},
+		{s: "it's", want: `'it'\''s'`},
+		{s: `$HOME "x" \n`, want: `'$HOME "x" \n'`},
+		{s: "line 1\nline 2", want: "'line 1\nline 2'"},
+	}
+
+	for _, tt := range tests {
+		got := posixQuote(tt.s)
+		if got != tt.want {
+			t.Errorf("posixQuote(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestPosixQuoteShell(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh not found")
+	}
+
+	for _, s := range []string{"", "it's", `'';$(echo x)` + "`x`", `\'"$HOME"\`, "a\nb\tc"} {
+		out, err := exec.Command(sh, "-c", "printf '%s' "+posixQuote(s)).Output()
+		if err != nil {
+			t.Errorf("sh -c with posixQuote(%q): %v", s, err)
+			continue
+		}
+		if string(out) != s {
+			t.Errorf("sh -c with posixQuote(%q) printed %q", s, out)
+		}
+	}
+}
+
+func TestFishQuote(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{s: "", want: `''`},
+		{s: "plain", want: `'plain'`},
+		{s: "it's", want: `'it\'s'`},
+		{s: `C:\path\`, want: `'C:\\path\\'`},
+		{s: `\'`, want: `'\\\''`},
+		{s: `$HOME "x"`, want: `'$HOME "x"'`},
+	}
+
+	for _, tt := range tests {
+		got := fishQuote(tt.s)
+		if got != tt.want {
+			t.Errorf("fishQuote(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestPowershellEscaper(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{s: "plain", want: "plain"},
+		{s: "it's", want: "it''s"},
+		{s: "‘quoted’", want: "‘‘quoted’’"},
+		{s: "‚low‛", want: "‚‚low‛‛"},
+		{s: `$env:HOME "x" ` + "`n", want: `$env:HOME "x" ` + "`n"},
+	}
+
+	for _, tt := range tests {
+		got := powershellEscaper.Replace(tt.s)
+		if got != tt.want {
+			t.Errorf("powershellEscaper.Replace(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestShellSyntax(t *testing.T) {
+	tests := []struct {
+		shell     string
+		wantSet   string
+		wantUnset string
+	}{
+		{shell: "bash", wantSet: "export A='it'\\''s';\n", wantUnset: "unset A;\n"},
+		{shell: "zsh", wantSet: "export A='it'\\''s';\n", wantUnset: "unset A;\n"},
+		{shell: "fish", wantSet: "set -gx A 'it\\'s';\n", wantUnset: "set -e -g A;\n"},
+		{shell: "pwsh", wantSet: "${env:A} = 'it''s'\n", wantUnset: "Remove-Item -LiteralPath 'env:A' -ErrorAction SilentlyContinue\n"},
+	}
+
+	for _, tt := range tests {
+		syntax, err := shellSyntaxFor(tt.shell)
+		if err != nil {
+			t.Errorf("shellSyntaxFor(%q): unexpected error: %v", tt.shell, err)
+			continue
+		}
+		if got := syntax.set("A", "it's"); got != tt.wantSet {
+			t.Errorf("%s: set() = %q, want %q", tt.shell, got, tt.wantSet)
+		}
+		if got := syntax.unset("A"); got != tt.wantUnset {
+			t.Errorf("%s: unset() = %q, want %q", tt.shell, got, tt.wantUnset)
+		}
+	}
+
+	if _, err := shellSyntaxFor("csh"); err == nil {
+		t.Error("shellSyntaxFor(csh): expected an error")
+	}
+}
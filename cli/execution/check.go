@@ -0,0 +1,210 @@
+package execution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/output"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/config"
+	"github.com/kechako/envoke/ent"
+	reqpred "github.com/kechako/envoke/ent/requiredvariable"
+	"github.com/spf13/cobra"
+)
+
+func CheckCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: GroupID,
+		Use:     "check [flags]",
+		Short:   "Check that the required variables are defined",
+		Long: `Check that every required variable resolves to a non-empty value in the
+specified environment, after inheritance and expansion.
+
+Required variables are declared in the required section of the configuration
+file or the project file, and in the environment and the global environment
+with 'envoke var require'. The environment is built in the same way as with the
+run command, which performs the same check before running a command.
+
+Exits with status 1 if any required variable is missing or empty.`,
+		Example: `  # Check the production environment
+  envoke check -e production
+
+  # Check without the system environment
+  envoke check -e production --clean`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.ExactArgs(0)(cmd, args); err != nil {
+				return err
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			f, err := output.FromCommand(cmd)
+			if err != nil {
+				return err
+			}
+
+			globalEnv, err := util.LoadGlobalEnvironment(ctx)
+			if err != nil {
+				return err
+			}
+
+			env, err := util.LoadEnvironment(ctx, cmd)
+			if err != nil {
+				return err
+			}
+
+			opts, err := environOptionsFromCommand(ctx, cmd)
+			if err != nil {
+				return err
+			}
+
+			environ, err := makeEnviron(ctx, globalEnv, env, opts)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			results, err := checkRequirements(ctx, globalEnv, env, environ)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			if len(results) == 0 && f.Kind == output.Table {
+				fmt.Println("(No required variables declared)")
+				return nil
+			}
+
+			err = output.Print(os.Stdout, f, results, output.Columns[requirementResult]{
+				Headers: []string{"Name", "Status", "Source", "Description"},
+				Fields:  []string{"name", "status", "source", "description"},
+				Row: func(r requirementResult) []any {
+					return []any{r.Name, r.Status, r.Source, r.Description}
+				},
+			})
+			if err != nil {
+				return clierrors.Exit(fmt.Errorf("failed to print required variables: %w", err), 1)
+			}
+
+			failed := 0
+			for _, r := range results {
+				if r.Status != requirementOK {
+					failed++
+				}
+			}
+			if failed > 0 {
+				return clierrors.Exit(fmt.Errorf("%d of %d required variables are missing or empty", failed, len(results)), 1)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("env", "e", "", "Specify the environment to manage")
+	addEnvironFlags(cmd)
+
+	return cmd
+}
+
+// Status of a required variable.
+const (
+	requirementOK      = "ok"
+	requirementMissing = "missing"
+	requirementEmpty   = "empty"
+)
+
+type requirementResult struct {
+	Name        string `json:"name" yaml:"name"`
+	Status      string `json:"status" yaml:"status"`
+	Source      string `json:"source" yaml:"source"`
+	Description string `json:"description" yaml:"description"`
+}
+
+// checkRequirements checks the required variables of the configuration, the
+// global environment and the environment against environ. A variable declared
+// more than once is checked once, with the description of the most specific
+// declaration.
+func checkRequirements(ctx context.Context, globalEnv, env *ent.Environment, environ []environEntry) ([]requirementResult, error) {
+	var results []requirementResult
+	add := func(name, description, source string) {
+		i := slices.IndexFunc(results, func(r requirementResult) bool { return r.Name == name })
+		if i < 0 {
+			results = append(results, requirementResult{Name: name, Source: source, Description: description})
+			return
+		}
+		results[i].Source = source
+		if description != "" {
+			results[i].Description = description
+		}
+	}
+
+	if cfg := config.FromContext(ctx); cfg != nil {
+		for _, v := range cfg.Required {
+			add(v.Name, v.Description, "config")
+		}
+	}
+
+	envs := []*ent.Environment{globalEnv}
+	if env.ID != globalEnv.ID {
+		envs = append(envs, env)
+	}
+	for _, e := range envs {
+		reqs, err := e.QueryRequiredVariables().Order(reqpred.ByName(sql.OrderAsc())).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query required variables: %w", err)
+		}
+		source := util.SourceEnvironment.String()
+		if e.ID == globalEnv.ID {
+			source = util.SourceGlobal.String()
+		}
+		for _, r := range reqs {
+			add(r.Name, r.Description, source)
+		}
+	}
+
+	for i, r := range results {
+		j := slices.IndexFunc(environ, func(e environEntry) bool { return environKey(e.name) == environKey(r.Name) })
+		switch {
+		case j < 0:
+			results[i].Status = requirementMissing
+		case environ[j].value == "":
+			results[i].Status = requirementEmpty
+		default:
+			results[i].Status = requirementOK
+		}
+	}
+
+	return results, nil
+}
+
+// checkRequired returns an error listing the required variables that are
+// missing or empty in environ.
+func checkRequired(ctx context.Context, globalEnv, env *ent.Environment, environ []environEntry) error {
+	results, err := checkRequirements(ctx, globalEnv, env, environ)
+	if err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	for _, r := range results {
+		if r.Status == requirementOK {
+			continue
+		}
+		fmt.Fprintf(&b, "\n  %s (%s)", r.Name, r.Status)
+		if r.Description != "" {
+			b.WriteString(": " + r.Description)
+		}
+	}
+	if b.Len() > 0 {
+		return errors.New("required variables are missing or empty:" + b.String() + "\n(use --no-check to skip this check)")
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package execution
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/output"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/spf13/cobra"
+)
+
+type environRecord struct {
+	Name     string `json:"name" yaml:"name"`
+	Value    string `json:"value" yaml:"value"`
+	Source   string `json:"source,omitempty" yaml:"source,omitempty"`
+	Expanded *bool  `json:"expanded,omitempty" yaml:"expanded,omitempty"`
+}
+
+// printDryRun prints the environment and the resolved executable of the
+// command instead of running it.
+func printDryRun(cmd *cobra.Command, args []string, environ []environEntry) error {
+	f, err := output.FromCommand(cmd)
+	if err != nil {
+		return err
+	}
+	if cmd.Flags().Changed("format") {
+		format, _ := cmd.Flags().GetString("format")
+		f, err = output.Parse(format)
+		if err != nil {
+			return clierrors.Exit(err, 1)
+		}
+	}
+
+	explain, _ := cmd.Flags().GetBool("explain")
+	showSecrets, _ := cmd.Flags().GetBool("show-secrets")
+
+	records := make([]environRecord, 0, len(environ))
+	for _, e := range environ {
+		r := environRecord{
+			Name:  e.name,
+			Value: util.MaskSecret(e.value, e.secret && !showSecrets),
+		}
+		if explain {
+			r.Source = e.source.String()
+			r.Expanded = &e.expanded
+		}
+		records = append(records, r)
+	}
+
+	var lookErr error
+	if len(args) > 0 {
+		// The command line goes to stderr with machine-readable formats,
+		// so that stdout only contains the environment.
+		w := io.Writer(os.Stdout)
+		if f.Kind != output.Table {
+			w = os.Stderr
+		}
+
+		path, err := lookPathEnv(args[0], environStrings(environ))
+		if err != nil {
+			lookErr = fmt.Errorf("lookPath %s: %w", args[0], err)
+			path = args[0] + " (not found)"
+		}
+		fmt.Fprintf(w, "Command: %s\n", strings.Join(append([]string{path}, args[1:]...), " "))
+		if f.Kind == output.Table {
+			fmt.Fprintln(w)
+		}
+	}
+
+	columns := output.Columns[environRecord]{
+		Headers: []string{"Name", "Value"},
+		Fields:  []string{"name", "value"},
+		Row: func(r environRecord) []any {
+			return []any{r.Name, r.Value}
+		},
+	}
+	if explain {
+		columns = output.Columns[environRecord]{
+			Headers: []string{"Name", "Value", "Source", "Expanded"},
+			Fields:  []string{"name", "value", "source", "expanded"},
+			Row: func(r environRecord) []any {
+				return []any{r.Name, r.Value, r.Source, *r.Expanded}
+			},
+		}
+	}
+
+	err = output.Print(os.Stdout, f, records, columns)
+	if err != nil {
+		return clierrors.Exit(fmt.Errorf("failed to print environment: %w", err), 1)
+	}
+
+	if lookErr != nil {
+		return clierrors.Exit(lookErr, 1)
+	}
+
+	return nil
+}
@@ -0,0 +1,342 @@
+package execution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+	"github.com/kechako/envoke/ent/allowedproject"
+	"github.com/kechako/envoke/project"
+	"github.com/spf13/cobra"
+)
+
+// hookBlockedName is the name of the private variable recording the hash of
+// the project file that is not allowed, so that the warning is printed once.
+const hookBlockedName = "__ENVOKE_HOOK_BLOCKED"
+
+func HookCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: GroupID,
+		Use:     "hook <shell>",
+		Annotations: map[string]string{
+			util.NoProjectWarningAnnotation: "true",
+		},
+		Short: "Print a shell hook that activates project environments automatically",
+		Long: `Print a hook script for the shell (bash, zsh or fish) that activates the
+environment of the current project automatically.
+
+Before each prompt, the hook looks for ` + project.FileName + ` in the current directory
+and its parent directories. When the project file names an environment with
+
+  environment: development
+
+the environment is activated as with the activate command, and it is
+deactivated when leaving the project. A project file is only used after it is
+trusted with the allow command, and must be allowed again after it changes.`,
+		Example: `  # bash (~/.bashrc)
+  eval "$(envoke hook bash)"
+
+  # zsh (~/.zshrc)
+  eval "$(envoke hook zsh)"
+
+  # fish (~/.config/fish/config.fish)
+  envoke hook fish | source`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.ExactArgs(1)(cmd, args); err != nil {
+				return err
+			}
+
+			return nil
+		},
+		ValidArgs: []string{"bash", "zsh", "fish"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			shell := args[0]
+
+			export, _ := cmd.Flags().GetBool("export")
+			if export {
+				syntax, err := shellSyntaxFor(shell)
+				if err != nil {
+					return clierrors.Exit(err, 1)
+				}
+
+				script, err := hookExportScript(ctx, syntax)
+				if err != nil {
+					return clierrors.Exit(err, 1)
+				}
+
+				_, err = io.WriteString(os.Stdout, script)
+				if err != nil {
+					return clierrors.Exit(err, 1)
+				}
+
+				return nil
+			}
+
+			self, err := os.Executable()
+			if err != nil {
+				return clierrors.Exit(fmt.Errorf("unable to determine the path of envoke: %w", err), 1)
+			}
+
+			var script string
+			switch shell {
+			case "bash":
+				script = strings.ReplaceAll(bashHook, "{{envoke}}", posixQuote(self))
+			case "zsh":
+				script = strings.ReplaceAll(zshHook, "{{envoke}}", posixQuote(self))
+			case "fish":
+				script = strings.ReplaceAll(fishHook, "{{envoke}}", fishQuote(self))
+			default:
+				return clierrors.Exit(fmt.Errorf("unsupported shell '%s' (must be bash, zsh or fish)", shell), 1)
+			}
+
+			_, err = io.WriteString(os.Stdout, script)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool("export", false, "Print the commands to run before the prompt (used by the hook)")
+	cmd.Flags().MarkHidden("export")
+
+	return cmd
+}
+
+const bashHook = `_envoke_hook() {
+  local previous_exit_status=$?
+  trap -- '' SIGINT
+  eval "$({{envoke}} hook bash --export)"
+  trap - SIGINT
+  return $previous_exit_status
+}
+if [[ ";${PROMPT_COMMAND:-};" != *";_envoke_hook;"* ]]; then
+  PROMPT_COMMAND="_envoke_hook${PROMPT_COMMAND:+;$PROMPT_COMMAND}"
+fi
+`
+
+const zshHook = `_envoke_hook() {
+  trap -- '' SIGINT
+  eval "$({{envoke}} hook zsh --export)"
+  trap - SIGINT
+}
+typeset -ag precmd_functions
+if (( ! ${precmd_functions[(I)_envoke_hook]} )); then
+  precmd_functions=(_envoke_hook $precmd_functions)
+fi
+typeset -ag chpwd_functions
+if (( ! ${chpwd_functions[(I)_envoke_hook]} )); then
+  chpwd_functions=(_envoke_hook $chpwd_functions)
+fi
+`
+
+const fishHook = `function __envoke_hook --on-event fish_prompt --on-variable PWD
+    {{envoke}} hook fish --export | source
+end
+`
+
+// hookExportScript returns the commands that activate the environment of the
+// current project, or deactivate the environment of the project left.
+func hookExportScript(ctx context.Context, syntax shellSyntax) (string, error) {
+	state, err := loadActivateState()
+	if err != nil {
+		return "", err
+	}
+
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	p, err := project.Find(dir)
+	if err != nil {
+		// An invalid project file must not break the prompt.
+		fmt.Fprintf(os.Stderr, "envoke: %v\n", err)
+		p = nil
+	}
+
+	var buf strings.Builder
+
+	var desired *activateState
+	blocked := false
+	if p != nil && p.Environment != "" {
+		allowed, err := util.IsProjectAllowed(ctx, p)
+		if err != nil {
+			return "", err
+		}
+
+		if allowed {
+			desired = &activateState{Env: util.EnvironmentName(ctx, p.Environment), Project: p.Path, Hash: p.Hash}
+		} else {
+			blocked = true
+			if os.Getenv(hookBlockedName) != p.Hash {
+				fmt.Fprintf(os.Stderr, "envoke: %s is not allowed. Run 'envoke allow' to trust its content.\n", p.Path)
+				buf.WriteString(syntax.set(hookBlockedName, p.Hash))
+			}
+		}
+	}
+	if !blocked && os.Getenv(hookBlockedName) != "" {
+		buf.WriteString(syntax.unset(hookBlockedName))
+	}
+
+	switch {
+	case desired == nil:
+		// Environments activated manually are left as they are.
+		if state != nil && state.Project != "" {
+			fmt.Fprintf(os.Stderr, "envoke: unloading %s\n", state.Env)
+			buf.WriteString(deactivateScript(syntax, state))
+		}
+	case state != nil && state.Project == desired.Project && state.Hash == desired.Hash:
+		// Already activated.
+	default:
+		fmt.Fprintf(os.Stderr, "envoke: loading %s from %s\n", desired.Env, desired.Project)
+		script, err := activateScript(ctx, syntax, state, desired)
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString(script)
+	}
+
+	return buf.String(), nil
+}
+
+func AllowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: GroupID,
+		Use:     "allow [<path>]",
+		Annotations: map[string]string{
+			util.NoProjectWarningAnnotation: "true",
+		},
+		Short: "Trust the project file of a directory",
+		Long: `Trust the project file (` + project.FileName + `) in the specified directory or
+its parent directories (default: the current directory), so that its settings
+are used by envoke commands and the shell hook activates its environment.
+
+The trust is recorded against the content of the file, and the file must be
+allowed again after it changes.`,
+		Example: `  # Trust the project file of the current directory
+  envoke allow`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.MaximumNArgs(1)(cmd, args); err != nil {
+				return err
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			p, err := findProjectArg(args)
+			if err != nil {
+				return err
+			}
+
+			client := ent.FromContext(ctx)
+
+			err = client.AllowedProject.Create().
+				SetPath(p.Path).
+				SetHash(p.Hash).
+				OnConflictColumns(allowedproject.FieldPath).
+				UpdateNewValues().
+				Exec(ctx)
+			if err != nil {
+				return clierrors.Exit(fmt.Errorf("failed to allow project: %w", err), 1)
+			}
+
+			fmt.Printf("Project '%s' allowed successfully!\n", p.Path)
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func DenyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: GroupID,
+		Use:     "deny [<path>]",
+		Annotations: map[string]string{
+			util.NoProjectWarningAnnotation: "true",
+		},
+		Short: "Revoke the trust of the project file of a directory",
+		Example: `  # Revoke the trust of the project file of the current directory
+  envoke deny`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.MaximumNArgs(1)(cmd, args); err != nil {
+				return err
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			name, err := findProjectPathArg(args)
+			if err != nil {
+				return err
+			}
+
+			client := ent.FromContext(ctx)
+
+			n, err := client.AllowedProject.Delete().
+				Where(allowedproject.Path(name)).
+				Exec(ctx)
+			if err != nil {
+				return clierrors.Exit(fmt.Errorf("failed to deny project: %w", err), 1)
+			}
+			if n == 0 {
+				return clierrors.Exit(fmt.Errorf("project '%s' is not allowed", name), 1)
+			}
+
+			fmt.Printf("Project '%s' denied successfully!\n", name)
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// findProjectArg finds and loads the project file from the directory in args,
+// or the current directory.
+func findProjectArg(args []string) (*project.Project, error) {
+	name, err := findProjectPathArg(args)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := project.Load(name)
+	if err != nil {
+		return nil, clierrors.Exit(err, 1)
+	}
+
+	return p, nil
+}
+
+// findProjectPathArg finds the project file from the directory in args, or
+// the current directory, and returns its absolute path without loading it.
+func findProjectPathArg(args []string) (string, error) {
+	dir := "."
+	if len(args) > 0 {
+		dir = args[0]
+	}
+
+	name, err := project.FindPath(dir)
+	if err != nil {
+		return "", clierrors.Exit(err, 1)
+	}
+	if name == "" {
+		return "", clierrors.Exit(errors.New("no "+project.FileName+" found"), 1)
+	}
+
+	return name, nil
+}
@@ -5,17 +5,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"slices"
+	"strings"
 	"syscall"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/envfile"
 	"github.com/kechako/envoke/cli/clierrors"
 	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/config"
 	"github.com/kechako/envoke/ent"
 	varpred "github.com/kechako/envoke/ent/variable"
+	"github.com/kechako/envoke/vartype"
 	"github.com/spf13/cobra"
 )
 
@@ -33,7 +39,34 @@ Variables are loaded in the following order of precedence:
 2. Global environment variables
 3. Environment-specific variables (highest priority)
 
-Variable expansion is performed for variables with the expand flag enabled.`,
+Each variable is passed to the command exactly once. With --system-precedence,
+system environment variables take the highest priority instead, which is
+useful for overrides from CI.
+
+Variables can be overridden for a single run with --env-file and --set, which
+are layered on top of all of the above (later ones win) and are never saved.
+Values given with --set are expanded, and both can be referenced from
+expanded variables.
+
+Variable expansion is performed for variables with the expand flag enabled.
+
+Before running the command, every required variable (see 'envoke check') must
+resolve to a non-empty value, and the values of typed variables are validated
+after expansion, unless --no-check is given.
+
+With --clean, the command does not inherit the system environment. Only the
+variables listed in the clean_keep setting of the run section of the
+configuration file (default: PATH, HOME, TERM) and those given with --keep
+are passed through, and expansion fails if a referenced variable is not
+defined in these layers.
+
+With --dry-run (or --print-env), the environment that would be passed to the
+command is printed, sorted by name and with secret values masked, along with
+the resolved path of the command, and nothing is run. The command is resolved
+with the PATH of the printed environment, as it is when the command is run.
+The format is selected with --format, or with the global --output flag.
+--explain also prints the layer each variable comes from (os, global,
+environment or override) and whether it was expanded.`,
 		Example: `  # Run a Node.js application
   envoke run -e development npm start
 
@@ -44,8 +77,21 @@ Variable expansion is performed for variables with the expand flag enabled.`,
   envoke run -e testing python manage.py test
 
   # Run with the global environment (no -e flag needed)
-  envoke run python scripts/backup.py`,
+  envoke run python scripts/backup.py
+
+  # Override variables for this run only
+  envoke run -e development --set LOG_LEVEL=debug --env-file .env.local npm start
+
+  # Run without inheriting the system environment, keeping only some variables
+  envoke run -e production --clean --keep PATH,HOME,TERM ./server
+
+  # Show what would be run, and where each variable comes from
+  envoke run -e production --explain ./server`,
 		Args: func(cmd *cobra.Command, args []string) error {
+			if isDryRun(cmd) && len(args) == 0 {
+				return nil
+			}
+
 			if err := cobra.MinimumNArgs(1)(cmd, args); err != nil {
 				return err
 			}
@@ -69,15 +115,43 @@ Variable expansion is performed for variables with the expand flag enabled.`,
 				return err
 			}
 
-			environ, err := makeEnviron(ctx, globalEnv, env)
+			opts, err := environOptionsFromCommand(ctx, cmd)
+			if err != nil {
+				return err
+			}
+
+			environ, err := makeEnviron(ctx, globalEnv, env, opts)
 			if err != nil {
 				return clierrors.Exit(err, 1)
 			}
 
+			var checkErr error
+			noCheck, _ := cmd.Flags().GetBool("no-check")
+			if !noCheck {
+				checkErr = errors.Join(
+					checkRequired(ctx, globalEnv, env, environ),
+					validateEnviron(environ),
+				)
+			}
+
+			if isDryRun(cmd) {
+				// The environment is printed even if the checks fail, since
+				// a dry run is how such failures are investigated.
+				err = printDryRun(cmd, args, environ)
+				if checkErr != nil {
+					fmt.Fprintf(os.Stderr, "Warning: %v\n", checkErr)
+				}
+				return err
+			}
+
+			if checkErr != nil {
+				return clierrors.Exit(checkErr, 1)
+			}
+
 			cmdName := args[0]
 			cmdArgs := args[1:]
 
-			err = execCommand(ctx, cmdName, cmdArgs, environ)
+			err = execCommand(ctx, cmdName, cmdArgs, environStrings(environ))
 			if err != nil {
 				return err
 			}
@@ -87,11 +161,202 @@ Variable expansion is performed for variables with the expand flag enabled.`,
 	}
 
 	cmd.Flags().StringP("env", "e", "", "Specify the environment to manage")
+	addEnvironFlags(cmd)
+	cmd.Flags().Bool("no-check", false, "Do not check the required variables and the values of typed variables (default: false)")
+	cmd.Flags().Bool("dry-run", false, "Print the environment and the command instead of running it (default: false)")
+	cmd.Flags().Bool("print-env", false, "Same as --dry-run (default: false)")
+	cmd.Flags().Bool("explain", false, "Print the source of each variable and whether it was expanded, implies --dry-run (default: false)")
+	cmd.Flags().Bool("show-secrets", false, "Show the values of secret variables with --dry-run (default: false)")
+	cmd.Flags().String("format", "", "Output format of --dry-run, same as --output (table, json, yaml, tsv, template=<go-template>)")
 
 	return cmd
 }
 
-func makeEnviron(ctx context.Context, globalEnv, env *ent.Environment) ([]string, error) {
+// isDryRun reports whether the command should only be printed, not run.
+func isDryRun(cmd *cobra.Command) bool {
+	for _, name := range []string{"dry-run", "print-env", "explain"} {
+		if v, _ := cmd.Flags().GetBool(name); v {
+			return true
+		}
+	}
+	return false
+}
+
+// addEnvironFlags adds the flags read by environOptionsFromCommand to cmd.
+func addEnvironFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("clean", false, "Do not inherit the system environment (default: false)")
+	cmd.Flags().StringSlice("keep", nil, "System environment variables to keep with --clean (comma separated)")
+	cmd.Flags().Bool("system-precedence", false, "Give system environment variables precedence over envoke variables (default: false)")
+	cmd.Flags().StringArray("set", nil, "Override a variable for this run (KEY=VALUE, can be repeated)")
+	cmd.Flags().StringArray("env-file", nil, "Load variables from a .env file for this run (can be repeated)")
+}
+
+// environOptions controls how the environment of the command is built.
+type environOptions struct {
+	// clean starts from an empty environment instead of os.Environ().
+	clean bool
+	// keep is the list of system environment variables kept when clean is set.
+	keep []string
+	// systemPrecedence gives system environment variables precedence over
+	// the variables defined in envoke.
+	systemPrecedence bool
+	// overrides are variables given on the command line, layered on top of
+	// the environment. They are never saved.
+	overrides []*ent.Variable
+}
+
+func environOptionsFromCommand(ctx context.Context, cmd *cobra.Command) (*environOptions, error) {
+	clean, _ := cmd.Flags().GetBool("clean")
+	keep, _ := cmd.Flags().GetStringSlice("keep")
+	systemPrecedence, _ := cmd.Flags().GetBool("system-precedence")
+	sets, _ := cmd.Flags().GetStringArray("set")
+	envFiles, _ := cmd.Flags().GetStringArray("env-file")
+
+	overrides, err := loadOverrides(envFiles, sets)
+	if err != nil {
+		return nil, clierrors.Exit(err, 1)
+	}
+
+	opts := &environOptions{
+		clean:            clean,
+		systemPrecedence: systemPrecedence,
+		overrides:        overrides,
+	}
+
+	if !clean {
+		if len(keep) > 0 {
+			return nil, clierrors.Exit(errors.New("--keep can only be used with --clean"), 1)
+		}
+		return opts, nil
+	}
+
+	if cfg := config.FromContext(ctx); cfg != nil {
+		opts.keep = append(slices.Clone(cfg.GetCleanKeep()), keep...)
+	} else {
+		opts.keep = append(slices.Clone(config.DefaultCleanKeep), keep...)
+	}
+
+	return opts, nil
+}
+
+// loadOverrides loads the variables of the env files and the KEY=VALUE pairs
+// in order. Later definitions replace earlier ones.
+func loadOverrides(envFiles, sets []string) ([]*ent.Variable, error) {
+	var overrides []*ent.Variable
+	add := func(v *ent.Variable) {
+		i := slices.IndexFunc(overrides, func(o *ent.Variable) bool { return o.Name == v.Name })
+		if i >= 0 {
+			overrides[i] = v
+		} else {
+			overrides = append(overrides, v)
+		}
+	}
+
+	for _, name := range envFiles {
+		file, err := os.Open(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open environment file '%s': %w", name, err)
+		}
+		envs, err := envfile.Parse(file)
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse environment file '%s': %w", name, err)
+		}
+
+		for key, value := range envs.Envs() {
+			add(&ent.Variable{Name: key, Value: value})
+		}
+	}
+
+	for _, s := range sets {
+		key, value, ok := strings.Cut(s, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid value for --set: '%s' (must be KEY=VALUE)", s)
+		}
+		add(&ent.Variable{Name: key, Value: value, Expand: true})
+	}
+
+	return overrides, nil
+}
+
+// withOverrides returns vars with the overrides replacing or added to them.
+func withOverrides(vars, overrides []*ent.Variable) []*ent.Variable {
+	if len(overrides) == 0 {
+		return vars
+	}
+
+	overridden := util.MakeVariableMap(overrides)
+	vars = slices.DeleteFunc(vars, func(v *ent.Variable) bool {
+		_, ok := overridden[v.Name]
+		return ok
+	})
+
+	return append(vars, overrides...)
+}
+
+// systemEnviron returns the system environment variables the command starts
+// from, keyed by environKey.
+func (opts *environOptions) systemEnviron() map[string]environEntry {
+	system := map[string]environEntry{}
+
+	if !opts.clean {
+		for _, kv := range os.Environ() {
+			// On Windows, names of hidden variables start with '=' (e.g. "=C:=C:\").
+			if kv == "" {
+				continue
+			}
+			i := strings.IndexByte(kv[1:], '=')
+			if i < 0 {
+				continue
+			}
+			name, value := kv[:i+1], kv[i+2:]
+			system[environKey(name)] = environEntry{name: name, value: value, source: util.SourceOS}
+		}
+		return system
+	}
+
+	for _, name := range opts.keep {
+		if value, ok := os.LookupEnv(name); ok {
+			system[environKey(name)] = environEntry{name: name, value: value, source: util.SourceOS}
+		}
+	}
+	return system
+}
+
+// environEntry is a variable in the environment of the command.
+type environEntry struct {
+	name  string
+	value string
+	// source is the layer from which the value is resolved.
+	source util.Source
+	// expanded reports whether variable expansion was performed on the value.
+	expanded bool
+	// secret reports whether the value must be masked when displayed.
+	secret bool
+	// rule is the validation rule of the variable, if defined in envoke.
+	rule *vartype.Rule
+}
+
+// environKey returns the key identifying a variable name in the environment.
+// Names are case-insensitive on Windows.
+func environKey(name string) string {
+	if runtime.GOOS == "windows" {
+		return strings.ToUpper(name)
+	}
+	return name
+}
+
+// makeEnviron builds the environment of the command. Each variable appears
+// exactly once, and is resolved in the following order of precedence:
+//
+//  1. Overrides given with --env-file and --set (highest priority)
+//  2. Environment-specific variables
+//  3. Global environment variables
+//  4. System environment variables (lowest priority)
+//
+// With systemPrecedence, system environment variables take precedence over
+// environment-specific and global variables. The entries are sorted by name.
+func makeEnviron(ctx context.Context, globalEnv, env *ent.Environment, opts *environOptions) ([]environEntry, error) {
 	globalVars, err := globalEnv.QueryVariables().Order(varpred.ByName(sql.OrderAsc())).All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query environment variables: %w", err)
@@ -102,21 +367,102 @@ func makeEnviron(ctx context.Context, globalEnv, env *ent.Environment) ([]string
 		return nil, fmt.Errorf("failed to query environment variables: %w", err)
 	}
 
+	system := opts.systemEnviron()
+
+	if opts.systemPrecedence {
+		// Drop the variables overridden by the system environment, so that
+		// expansion also resolves them from the system environment.
+		overridden := func(v *ent.Variable) bool {
+			_, ok := system[environKey(v.Name)]
+			return ok
+		}
+		globalVars = slices.DeleteFunc(globalVars, overridden)
+		vars = slices.DeleteFunc(vars, overridden)
+	}
+
+	// Overrides of variables are kept masked if secret, and are validated
+	// with the rule of the overridden variable.
+	defined := util.MakeVariableMap(slices.Concat(globalVars, vars))
+
+	vars = withOverrides(vars, opts.overrides)
+
 	globalEnvMap := util.MakeVariableMap(globalVars)
 	envMap := util.MakeVariableMap(vars)
 
-	environ := os.Environ()
+	lookupEnv := func(name string) (string, bool) {
+		e, ok := system[environKey(name)]
+		return e.value, ok
+	}
+
+	entries := maps.Clone(system)
+
+	var errs []error
 	for _, v := range util.MergeVariables(globalVars, vars) {
 		value := v.Value
 		if v.Expand {
-			value, _ = util.ExpandVariable(value, globalEnvMap, envMap, false)
+			var err error
+			value, err = util.ExpandVariableWith(ctx, value, globalEnvMap, envMap, lookupEnv, opts.clean)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to expand variable '%s': %w", v.Name, err))
+			}
+		}
+
+		source := util.SourceEnvironment
+		switch {
+		case slices.Contains(opts.overrides, v):
+			source = util.SourceOverride
+		case globalEnvMap[v.Name] == v:
+			source = util.SourceGlobal
 		}
-		environ = append(environ, v.Name+"="+value)
+
+		e := environEntry{
+			name:     v.Name,
+			value:    value,
+			source:   source,
+			expanded: v.Expand,
+			secret:   v.Secret,
+		}
+		if d, ok := defined[v.Name]; ok {
+			e.secret = e.secret || d.Secret
+			e.rule = util.VariableRule(d)
+		}
+		entries[environKey(v.Name)] = e
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	environ := make([]environEntry, 0, len(entries))
+	for _, key := range slices.Sorted(maps.Keys(entries)) {
+		environ = append(environ, entries[key])
 	}
 
 	return environ, nil
 }
 
+// validateEnviron validates the values of the variables against their rules.
+func validateEnviron(environ []environEntry) error {
+	var errs []error
+	for _, e := range environ {
+		if e.rule == nil {
+			continue
+		}
+		if err := e.rule.Validate(e.value); err != nil {
+			errs = append(errs, fmt.Errorf("invalid value of variable '%s': %w", e.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// environStrings returns the entries in the form "key=value".
+func environStrings(entries []environEntry) []string {
+	environ := make([]string, len(entries))
+	for i, e := range entries {
+		environ[i] = e.name + "=" + e.value
+	}
+	return environ
+}
+
 func execCommand(ctx context.Context, name string, args []string, env []string) error {
 	// On Windows, there is no syscall.Exec, so the best we can do
 	// is run a subprocess and exit with the same status.
@@ -124,8 +470,18 @@ func execCommand(ctx context.Context, name string, args []string, env []string)
 	// propagate signals and such, but there are no signals on Windows.
 	// We also use the exec case when ENVOKE_DEBUG_EXEC=0,
 	// to allow testing this code even when not on Windows.
+	path := name
+	if filepath.Base(name) == name {
+		lp, err := lookPathEnv(name, env)
+		if err != nil {
+			return clierrors.Exit(fmt.Errorf("lookPath %s: %w", name, err), 1)
+		}
+		path = lp
+	}
+
 	if os.Getenv("ENVOKE_DEBUG_EXEC") == "0" || runtime.GOOS == "windows" {
-		command := exec.CommandContext(ctx, name, args...)
+		command := exec.CommandContext(ctx, path, args...)
+		command.Args[0] = name
 		command.Stdin = os.Stdin
 		command.Stdout = os.Stdout
 		command.Stderr = os.Stderr
@@ -151,15 +507,6 @@ func execCommand(ctx context.Context, name string, args []string, env []string)
 		}
 	}
 
-	path := name
-	if filepath.Base(name) == name {
-		lp, err := exec.LookPath(name)
-		if err != nil {
-			return clierrors.Exit(fmt.Errorf("lookPath %s: %w", name, err), 1)
-		}
-		path = lp
-	}
-
 	argv := append([]string{name}, args...)
 	err := syscall.Exec(path, argv, env)
 	if err != nil {
@@ -168,3 +515,36 @@ func execCommand(ctx context.Context, name string, args []string, env []string)
 
 	return nil
 }
+
+// lookPathEnv is like exec.LookPath, but searches the PATH of env, the
+// environment of the command, instead of the PATH of envoke, which differs
+// with --clean or --set PATH=... On Windows, where executables are also
+// searched with PATHEXT, exec.LookPath is used as is.
+func lookPathEnv(name string, env []string) (string, error) {
+	if runtime.GOOS == "windows" || strings.Contains(name, "/") {
+		return exec.LookPath(name)
+	}
+
+	var pathEnv string
+	for _, kv := range env {
+		if v, ok := strings.CutPrefix(kv, "PATH="); ok {
+			pathEnv = v
+		}
+	}
+
+	for _, dir := range filepath.SplitList(pathEnv) {
+		if dir == "" {
+			// Unix shell semantics: an empty element means the current directory.
+			dir = "."
+		}
+		path := filepath.Join(dir, name)
+		if fi, err := os.Stat(path); err == nil && !fi.IsDir() && fi.Mode()&0111 != 0 {
+			if !filepath.IsAbs(path) {
+				return path, &exec.Error{Name: name, Err: exec.ErrDot}
+			}
+			return path, nil
+		}
+	}
+
+	return "", &exec.Error{Name: name, Err: exec.ErrNotFound}
+}
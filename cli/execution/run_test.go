@@ -0,0 +1,69 @@
+package execution
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestLookPathEnv(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("lookPathEnv uses exec.LookPath on Windows")
+	}
+
+	dir := t.TempDir()
+	bin := filepath.Join(dir, "bin")
+	other := filepath.Join(dir, "other")
+	for _, d := range []string{bin, other} {
+		if err := os.Mkdir(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(bin, "tool"), []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(other, "tool"), []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(other, "data"), []byte("data\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		env     []string
+		want    string
+		wantErr error
+	}{
+		{name: "found", file: "tool", env: []string{"PATH=" + bin}, want: filepath.Join(bin, "tool")},
+		{name: "first in path", file: "tool", env: []string{"PATH=" + other + string(filepath.ListSeparator) + bin}, want: filepath.Join(other, "tool")},
+		{name: "last PATH wins", file: "tool", env: []string{"PATH=" + other, "PATH=" + bin}, want: filepath.Join(bin, "tool")},
+		{name: "not executable", file: "data", env: []string{"PATH=" + other}, wantErr: exec.ErrNotFound},
+		{name: "directory", file: "bin", env: []string{"PATH=" + dir}, wantErr: exec.ErrNotFound},
+		{name: "no PATH", file: "tool", env: []string{"HOME=" + dir}, wantErr: exec.ErrNotFound},
+		{name: "relative directory", file: "tool", env: []string{"PATH=" + filepath.Join(".", "no-such-dir") + string(filepath.ListSeparator) + "."}, wantErr: exec.ErrNotFound},
+		{name: "path with separator", file: filepath.Join(bin, "tool"), env: nil, want: filepath.Join(bin, "tool")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := lookPathEnv(tt.file, tt.env)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("lookPathEnv(%q): error = %v, want %v", tt.file, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("lookPathEnv(%q): unexpected error: %v", tt.file, err)
+			}
+			if got != tt.want {
+				t.Errorf("lookPathEnv(%q) = %q, want %q", tt.file, got, tt.want)
+			}
+		})
+	}
+}
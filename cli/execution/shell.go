@@ -0,0 +1,203 @@
+package execution
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// shellEnvName is the name of the variable holding the name of the
+	// environment loaded in an envoke shell.
+	shellEnvName = "ENVOKE_ENV"
+	// shellMarkerName is the name of the variable holding the name of the
+	// environment of an envoke shell. Unlike shellEnvName, which is also set
+	// by activate and the shell hook, it is only set by the shell command.
+	shellMarkerName = "ENVOKE_SHELL"
+	// shellPromptName is the name of the variable holding the prompt prefix.
+	shellPromptName = "ENVOKE_PROMPT"
+
+	defaultShellPrompt = "({env}) "
+)
+
+func ShellCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: GroupID,
+		Use:     "shell [flags]",
+		Short:   "Start an interactive shell in the specified environment",
+		Long: `Start an interactive shell with environment variables loaded from the
+specified environment.
+
+The shell is taken from $SHELL (%COMSPEC% on Windows), and its environment is
+built in the same way as with the run command. ENVOKE_ENV and ENVOKE_SHELL are
+set to the name of the environment, so that scripts and prompts can tell which
+environment is loaded, and that envoke can tell it runs in an envoke shell.
+Exit the shell to return to the original environment.
+
+With --prompt, a prefix is added to the prompt of the shell ("({env}) " by
+default, where {env} is replaced with the name of the environment). The prefix
+is applied automatically to bash, sh and cmd, and is available to other
+shells in ENVOKE_PROMPT, e.g. for zsh:
+
+  PROMPT="${ENVOKE_PROMPT}${PROMPT}"
+
+Starting a shell from another envoke shell is refused unless --nest is given.`,
+		Example: `  # Start a shell in the development environment
+  envoke shell -e development
+
+  # Show the environment name in the prompt
+  envoke shell -e production --prompt
+
+  # Use a custom prompt prefix
+  envoke shell -e production --prompt='[{env}] '`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.ExactArgs(0)(cmd, args); err != nil {
+				return err
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			nest, _ := cmd.Flags().GetBool("nest")
+			if current := os.Getenv(shellMarkerName); current != "" && !nest {
+				return clierrors.Exit(fmt.Errorf("already in an envoke shell for environment '%s' (use --nest to start a nested shell)", current), 1)
+			}
+
+			globalEnv, err := util.LoadGlobalEnvironment(ctx)
+			if err != nil {
+				return err
+			}
+
+			env, err := util.LoadEnvironment(ctx, cmd)
+			if err != nil {
+				return err
+			}
+
+			opts, err := environOptionsFromCommand(ctx, cmd)
+			if err != nil {
+				return err
+			}
+
+			environ, err := makeEnviron(ctx, globalEnv, env, opts)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			noCheck, _ := cmd.Flags().GetBool("no-check")
+			if !noCheck {
+				err = checkRequired(ctx, globalEnv, env, environ)
+				if err != nil {
+					return clierrors.Exit(err, 1)
+				}
+				err = validateEnviron(environ)
+				if err != nil {
+					return clierrors.Exit(err, 1)
+				}
+			}
+
+			shell := userShell()
+
+			environ = setEnviron(environ, shellEnvName, env.Name)
+			environ = setEnviron(environ, shellMarkerName, env.Name)
+			if cmd.Flags().Changed("prompt") {
+				prompt, _ := cmd.Flags().GetString("prompt")
+				prompt = strings.ReplaceAll(prompt, "{env}", env.Name)
+				environ = setShellPrompt(environ, shell, prompt)
+			}
+
+			err = execCommand(ctx, shell, nil, environStrings(environ))
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("env", "e", "", "Specify the environment to manage")
+	cmd.Flags().String("prompt", "", `Add a prefix to the prompt of the shell (default: "`+defaultShellPrompt+`")`)
+	cmd.Flags().Lookup("prompt").NoOptDefVal = defaultShellPrompt
+	cmd.Flags().Bool("nest", false, "Allow starting a shell from another envoke shell (default: false)")
+	cmd.Flags().Bool("no-check", false, "Do not check the required variables and the values of typed variables (default: false)")
+	addEnvironFlags(cmd)
+
+	return cmd
+}
+
+// userShell returns the shell of the user.
+func userShell() string {
+	if runtime.GOOS == "windows" {
+		if shell := os.Getenv("COMSPEC"); shell != "" {
+			return shell
+		}
+		return "cmd.exe"
+	}
+
+	if shell := os.Getenv("SHELL"); shell != "" {
+		return shell
+	}
+	return "/bin/sh"
+}
+
+// setShellPrompt sets the variables that add prompt to the prompt of shell.
+func setShellPrompt(environ []environEntry, shell, prompt string) []environEntry {
+	environ = setEnviron(environ, shellPromptName, prompt)
+
+	lookup := func(name string) string {
+		i := slices.IndexFunc(environ, func(e environEntry) bool { return environKey(e.name) == environKey(name) })
+		if i < 0 {
+			return ""
+		}
+		return environ[i].value
+	}
+
+	name := strings.ToLower(strings.TrimSuffix(filepath.Base(shell), filepath.Ext(shell)))
+	switch name {
+	case "bash":
+		// bash overwrites PS1 in its startup files, so the prefix is added
+		// before each prompt instead.
+		command := `[[ $PS1 == "$` + shellPromptName + `"* ]] || PS1="$` + shellPromptName + `$PS1"`
+		if current := lookup("PROMPT_COMMAND"); current != "" {
+			command = current + ";" + command
+		}
+		environ = setEnviron(environ, "PROMPT_COMMAND", command)
+	case "sh", "dash", "ksh", "mksh":
+		ps1 := lookup("PS1")
+		if ps1 == "" {
+			ps1 = "$ "
+		}
+		environ = setEnviron(environ, "PS1", prompt+ps1)
+	case "cmd":
+		current := lookup("PROMPT")
+		if current == "" {
+			current = "$P$G"
+		}
+		environ = setEnviron(environ, "PROMPT", prompt+current)
+	}
+
+	return environ
+}
+
+// setEnviron sets a variable in the environment, keeping the entries sorted.
+func setEnviron(environ []environEntry, name, value string) []environEntry {
+	key := environKey(name)
+	i, found := slices.BinarySearchFunc(environ, key, func(e environEntry, key string) int {
+		return strings.Compare(environKey(e.name), key)
+	})
+
+	e := environEntry{name: name, value: value, source: util.SourceOverride}
+	if found {
+		environ[i] = e
+		return environ
+	}
+	return slices.Insert(environ, i, e)
+}
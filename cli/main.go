@@ -5,20 +5,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/url"
 	"os"
 	"os/exec"
 	"os/signal"
 	"path/filepath"
 
-	"entgo.io/ent/dialect/sql/schema"
 	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/database"
 	"github.com/kechako/envoke/cli/environment"
 	"github.com/kechako/envoke/cli/execution"
+	"github.com/kechako/envoke/cli/output"
+	"github.com/kechako/envoke/cli/schema"
+	"github.com/kechako/envoke/cli/tui"
+	"github.com/kechako/envoke/cli/util"
 	"github.com/kechako/envoke/cli/variable"
 	"github.com/kechako/envoke/config"
 	"github.com/kechako/envoke/ent"
 	envpred "github.com/kechako/envoke/ent/environment"
+	_ "github.com/kechako/envoke/ent/runtime"
+	"github.com/kechako/envoke/project"
+	"github.com/kechako/envoke/varname"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/spf13/cobra"
 )
@@ -66,8 +72,6 @@ Features:
 			if err != nil {
 				return clierrors.Exit(err, 1)
 			}
-			ctx = config.NewContext(ctx, cfg)
-
 			dbPath, err := cfg.GetDBPath()
 			if err != nil {
 				return clierrors.Exit(err, 1)
@@ -76,13 +80,13 @@ Features:
 			if err != nil {
 				return clierrors.Exit(err, 1)
 			}
-			client, err := ent.Open("sqlite3", buildDataSourceName(dbPath))
+			client, err := ent.Open("sqlite3", util.BuildDataSourceName(dbPath))
 			if err != nil {
 				return clierrors.Exit(err, 1)
 			}
 			ctx = ent.NewContext(ctx, client)
 
-			err = migrateDatabase(ctx, client)
+			err = util.MigrateDatabase(ctx, client)
 			if err != nil {
 				return clierrors.Exit(err, 1)
 			}
@@ -91,6 +95,13 @@ Features:
 				return clierrors.Exit(err, 1)
 			}
 
+			cfg, err = mergeProject(ctx, cmd, cfg)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+			ctx = config.NewContext(ctx, cfg)
+			varname.SetPolicy(cfg.GetNamePolicy())
+
 			cmd.SetContext(ctx)
 
 			return nil
@@ -121,6 +132,7 @@ Features:
 	}
 
 	cmd.PersistentFlags().StringP("config", "c", "", "Path to the configuration file")
+	cmd.PersistentFlags().StringP(output.FlagName, "o", "table", "Output format of listing commands (table, json, yaml, tsv, template=<go-template>)")
 
 	cmd.AddGroup(&cobra.Group{
 		ID:    environment.GroupID,
@@ -129,6 +141,7 @@ Features:
 	cmd.AddCommand(
 		environment.CopyCommand(),
 		environment.CreateCommand(),
+		environment.DiffCommand(),
 		environment.ListCommand(),
 		environment.RemoveCommand(),
 		environment.RenameCommand(),
@@ -141,6 +154,18 @@ Features:
 	})
 	cmd.AddCommand(
 		variable.Command(),
+		variable.DoctorCommand(),
+		variable.EditCommand(),
+		variable.GetCommand(),
+		variable.SyncCommand(),
+	)
+
+	cmd.AddGroup(&cobra.Group{
+		ID:    schema.GroupID,
+		Title: "Schema Management:",
+	})
+	cmd.AddCommand(
+		schema.Command(),
 	)
 
 	cmd.AddGroup(&cobra.Group{
@@ -148,7 +173,30 @@ Features:
 		Title: "Execution:",
 	})
 	cmd.AddCommand(
+		execution.ActivateCommand(),
+		execution.AllowCommand(),
+		execution.CheckCommand(),
 		execution.Command(),
+		execution.DeactivateCommand(),
+		execution.DenyCommand(),
+		execution.HookCommand(),
+		execution.ShellCommand(),
+	)
+
+	cmd.AddGroup(&cobra.Group{
+		ID:    tui.GroupID,
+		Title: "Interactive:",
+	})
+	cmd.AddCommand(
+		tui.Command(),
+	)
+
+	cmd.AddGroup(&cobra.Group{
+		ID:    database.GroupID,
+		Title: "Database Management:",
+	})
+	cmd.AddCommand(
+		database.Command(),
 	)
 
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
@@ -187,31 +235,35 @@ func makeDataDir(dbPath string) error {
 	return nil
 }
 
-func buildDataSourceName(dbPath string) string {
-	if dbPath == "" {
-		return "file::memory:?cache=shared"
-	}
-
-	query := url.Values{}
-	query.Set("cache", "shared")
-	query.Set("_fk", "1")
+// mergeProject returns cfg merged with the project file of the current
+// directory if the project file is allowed. Project files that are invalid or
+// not allowed are ignored with a warning.
+func mergeProject(ctx context.Context, cmd *cobra.Command, cfg *config.Config) (*config.Config, error) {
+	quiet := cmd.Annotations[util.NoProjectWarningAnnotation] != ""
 
-	sdn := &url.URL{
-		Scheme:   "file",
-		Opaque:   dbPath,
-		RawQuery: query.Encode(),
+	p, err := project.Find(".")
+	if err != nil {
+		if !quiet {
+			fmt.Fprintf(os.Stderr, "Warning: project file ignored: %v\n", err)
+		}
+		return cfg, nil
+	}
+	if p == nil {
+		return cfg, nil
 	}
 
-	return sdn.String()
-}
-
-func migrateDatabase(ctx context.Context, client *ent.Client) error {
-	err := client.Schema.Create(ctx, schema.WithDropIndex(true), schema.WithDropColumn(true), schema.WithForeignKeys(true))
+	allowed, err := util.IsProjectAllowed(ctx, p)
 	if err != nil {
-		return fmt.Errorf("failed to migrate database schema: %w", err)
+		return nil, err
+	}
+	if !allowed {
+		if !quiet {
+			fmt.Fprintf(os.Stderr, "Warning: project file '%s' is not allowed, its settings are ignored (run 'envoke allow' to trust it)\n", p.Path)
+		}
+		return cfg, nil
 	}
 
-	return nil
+	return p.Merge(cfg), nil
 }
 
 func kitDatabase(ctx context.Context, client *ent.Client) error {
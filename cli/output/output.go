@@ -0,0 +1,182 @@
+// Package output provides machine-readable output formats for listing commands.
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/fatih/color"
+	"github.com/goccy/go-yaml"
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+)
+
+// FlagName is the name of the global output format flag.
+const FlagName = "output"
+
+type Kind int
+
+const (
+	Table Kind = iota
+	JSON
+	YAML
+	TSV
+	Template
+)
+
+// Format is an output format selected by the --output flag.
+type Format struct {
+	Kind     Kind
+	template *template.Template
+}
+
+// Parse parses an output format: table, json, yaml, tsv or template=<go-template>.
+func Parse(s string) (*Format, error) {
+	switch s {
+	case "", "table":
+		return &Format{Kind: Table}, nil
+	case "json":
+		return &Format{Kind: JSON}, nil
+	case "yaml":
+		return &Format{Kind: YAML}, nil
+	case "tsv":
+		return &Format{Kind: TSV}, nil
+	}
+
+	if text, ok := strings.CutPrefix(s, "template="); ok {
+		if text == "" {
+			return nil, errors.New("template cannot be empty")
+		}
+		tmpl, err := template.New("output").Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template: %w", err)
+		}
+		return &Format{Kind: Template, template: tmpl}, nil
+	}
+
+	return nil, fmt.Errorf("invalid output format '%s' (must be table, json, yaml, tsv or template=<go-template>)", s)
+}
+
+// FromCommand returns the output format selected for cmd.
+func FromCommand(cmd *cobra.Command) (*Format, error) {
+	s, _ := cmd.Flags().GetString(FlagName)
+
+	f, err := Parse(s)
+	if err != nil {
+		return nil, clierrors.Exit(err, 1)
+	}
+
+	return f, nil
+}
+
+// Columns describes how records are rendered as table and TSV rows.
+// Fields are the stable field names used as the TSV header, and must match
+// the json and yaml tags of the record.
+type Columns[T any] struct {
+	Headers []string
+	Fields  []string
+	Row     func(T) []any
+}
+
+// Print writes records in the format f.
+func Print[T any](w io.Writer, f *Format, records []T, columns Columns[T]) error {
+	if records == nil {
+		records = []T{}
+	}
+
+	switch f.Kind {
+	case JSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(records)
+	case YAML:
+		data, err := yaml.Marshal(records)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	case TSV:
+		return printTSV(w, records, columns)
+	case Template:
+		return printTemplate(w, f.template, records)
+	default:
+		printTable(w, records, columns)
+		return nil
+	}
+}
+
+func printTable[T any](w io.Writer, records []T, columns Columns[T]) {
+	headerFmt := color.New(color.ResetUnderline, color.Bold).SprintfFunc()
+
+	headers := make([]any, len(columns.Headers))
+	for i, h := range columns.Headers {
+		headers[i] = h
+	}
+
+	tbl := table.New(headers...)
+	tbl.WithHeaderFormatter(headerFmt)
+	tbl.WithWriter(w)
+
+	for _, r := range records {
+		tbl.AddRow(columns.Row(r)...)
+	}
+
+	tbl.Print()
+}
+
+var tsvEscaper = strings.NewReplacer(`\`, `\\`, "\t", `\t`, "\n", `\n`, "\r", `\r`)
+
+func printTSV[T any](w io.Writer, records []T, columns Columns[T]) error {
+	var buf bytes.Buffer
+
+	buf.WriteString(strings.Join(columns.Fields, "\t"))
+	buf.WriteByte('\n')
+
+	for _, r := range records {
+		for i, v := range columns.Row(r) {
+			if i > 0 {
+				buf.WriteByte('\t')
+			}
+			buf.WriteString(tsvEscaper.Replace(fmt.Sprint(v)))
+		}
+		buf.WriteByte('\n')
+	}
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// printTemplate executes the template for each record. Records are converted
+// through JSON so that the template refers to the same field names as the
+// JSON output (e.g. {{.name}}).
+func printTemplate[T any](w io.Writer, tmpl *template.Template, records []T) error {
+	data, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+
+	var values []map[string]any
+	err = json.Unmarshal(data, &values)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	for _, v := range values {
+		err := tmpl.Execute(&buf, v)
+		if err != nil {
+			return fmt.Errorf("failed to execute template: %w", err)
+		}
+		buf.WriteByte('\n')
+	}
+
+	_, err = w.Write(buf.Bytes())
+	return err
+}
@@ -0,0 +1,124 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+type testRecord struct {
+	Name   string `json:"name" yaml:"name"`
+	Value  string `json:"value" yaml:"value"`
+	Secret bool   `json:"secret" yaml:"secret"`
+}
+
+var testColumns = Columns[*testRecord]{
+	Headers: []string{"Name", "Value", "Secret"},
+	Fields:  []string{"name", "value", "secret"},
+	Row: func(r *testRecord) []any {
+		return []any{r.Name, r.Value, r.Secret}
+	},
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    Kind
+		wantErr bool
+	}{
+		{s: "", want: Table},
+		{s: "table", want: Table},
+		{s: "json", want: JSON},
+		{s: "yaml", want: YAML},
+		{s: "tsv", want: TSV},
+		{s: "template={{.name}}", want: Template},
+		{s: "template=", wantErr: true},
+		{s: "template={{.name", wantErr: true},
+		{s: "csv", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		f, err := Parse(tt.s)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Parse(%q): error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			continue
+		}
+		if err == nil && f.Kind != tt.want {
+			t.Errorf("Parse(%q).Kind = %v, want %v", tt.s, f.Kind, tt.want)
+		}
+	}
+}
+
+func TestPrint(t *testing.T) {
+	records := []*testRecord{
+		{Name: "HOST", Value: "example.com"},
+		{Name: "MOTD", Value: "line 1\nline 2\tend \\o/", Secret: true},
+	}
+
+	tests := []struct {
+		format  string
+		records []*testRecord
+		want    string
+		wantErr string
+	}{
+		{
+			format:  "tsv",
+			records: records,
+			want:    "name\tvalue\tsecret\nHOST\texample.com\tfalse\nMOTD\tline 1\\nline 2\\tend \\\\o/\ttrue\n",
+		},
+		{
+			format:  "tsv",
+			records: nil,
+			want:    "name\tvalue\tsecret\n",
+		},
+		{
+			format:  "template={{.name}}={{.value}}",
+			records: records[:1],
+			want:    "HOST=example.com\n",
+		},
+		{
+			format:  "template={{if .secret}}{{.name}}{{end}}",
+			records: records,
+			want:    "\nMOTD\n",
+		},
+		{
+			format:  "template={{.name}}",
+			records: nil,
+			want:    "",
+		},
+		{
+			format:  "template={{.name.first}}",
+			records: records,
+			wantErr: "failed to execute template",
+		},
+		{
+			format:  "json",
+			records: nil,
+			want:    "[]\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			f, err := Parse(tt.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buf bytes.Buffer
+			err = Print(&buf, f, tt.records, testColumns)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Print(): error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Print(): unexpected error: %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("Print() = %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+	"github.com/spf13/cobra"
+)
+
+func attachCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attach [flags] <schema> <environment>...",
+		Short: "Attach environments to a schema",
+		Long: `Attach environments to a schema, so that they are checked against it with
+'envoke schema check'. An environment is attached to one schema at most, and is
+moved from the schema it was attached to.`,
+		Example: `  # Check the environments of myapp against the same schema
+  envoke schema attach myapp dev staging prod`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.MinimumNArgs(2)(cmd, args); err != nil {
+				return err
+			}
+
+			if args[0] == "" {
+				return clierrors.Exit(errors.New("schema name cannot be empty"), 1)
+			}
+			for _, name := range args[1:] {
+				if name == "" {
+					return clierrors.Exit(errors.New("environment name cannot be empty"), 1)
+				}
+				if name == "global" {
+					return clierrors.Exit(errors.New("cannot attach environment 'global' to a schema"), 1)
+				}
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			schemaName := args[0]
+			names := make([]string, len(args)-1)
+			for i, name := range args[1:] {
+				names[i] = util.EnvironmentName(ctx, name)
+			}
+
+			client := ent.FromContext(ctx)
+
+			err := client.DoTransaction(ctx, nil, func(ctx context.Context, tx *ent.Tx) error {
+				schema, err := util.FindSchema(ctx, tx.Client(), schemaName)
+				if err != nil {
+					return clierrors.Exit(err, 1)
+				}
+
+				for _, name := range names {
+					env, err := util.FindEnvironment(ctx, tx.Client(), name)
+					if err != nil {
+						return clierrors.Exit(err, 1)
+					}
+
+					err = tx.Environment.UpdateOne(env).
+						SetSchema(schema).
+						Exec(ctx)
+					if err != nil {
+						return clierrors.Exit(fmt.Errorf("failed to attach environment '%s': %w", name, err), 1)
+					}
+				}
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, name := range names {
+				fmt.Printf("Environment '%s' attached to schema '%s' successfully!\n", name, schemaName)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func detachCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "detach [flags] <environment>...",
+		Short: "Detach environments from their schema",
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.MinimumNArgs(1)(cmd, args); err != nil {
+				return err
+			}
+
+			for _, name := range args {
+				if name == "" {
+					return clierrors.Exit(errors.New("environment name cannot be empty"), 1)
+				}
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			names := make([]string, len(args))
+			for i, name := range args {
+				names[i] = util.EnvironmentName(ctx, name)
+			}
+
+			client := ent.FromContext(ctx)
+
+			err := client.DoTransaction(ctx, nil, func(ctx context.Context, tx *ent.Tx) error {
+				for _, name := range names {
+					env, err := util.FindEnvironment(ctx, tx.Client(), name)
+					if err != nil {
+						return clierrors.Exit(err, 1)
+					}
+					if env.SchemaID == nil {
+						return clierrors.Exit(fmt.Errorf("environment '%s' is not attached to a schema", name), 1)
+					}
+
+					err = tx.Environment.UpdateOne(env).
+						ClearSchema().
+						Exec(ctx)
+					if err != nil {
+						return clierrors.Exit(fmt.Errorf("failed to detach environment '%s': %w", name, err), 1)
+					}
+				}
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, name := range names {
+				fmt.Printf("Environment '%s' detached successfully!\n", name)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,226 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/output"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+	envpred "github.com/kechako/envoke/ent/environment"
+	schemapred "github.com/kechako/envoke/ent/environmentschema"
+	keypred "github.com/kechako/envoke/ent/schemakey"
+	varpred "github.com/kechako/envoke/ent/variable"
+	"github.com/spf13/cobra"
+)
+
+// Status of a key of a schema in an environment.
+const (
+	keyMissing    = "missing"
+	keyUnexpected = "unexpected"
+	keyInvalid    = "invalid"
+	keyNotSecret  = "not secret"
+)
+
+type keyProblem struct {
+	Schema      string `json:"schema" yaml:"schema"`
+	Environment string `json:"environment" yaml:"environment"`
+	Key         string `json:"key" yaml:"key"`
+	Status      string `json:"status" yaml:"status"`
+	Detail      string `json:"detail" yaml:"detail"`
+}
+
+func checkCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check [flags] [<schema>...]",
+		Short: "Check environments against their schemas",
+		Long: `Check that the environments attached to the schemas have the shape declared
+by their schema, and report, per environment:
+
+  missing     keys of the schema not defined in the environment or the global
+              environment, except optional keys
+  unexpected  variables of the environment that are not keys of the schema
+  invalid     values that do not satisfy the type and the constraints of the
+              key (values to be expanded are checked after expansion)
+  not secret  variables of secret keys that are not marked as secret
+
+All schemas are checked if none is specified. Exits with status 1 if any
+problem is found.`,
+		Example: `  # Check all environments attached to a schema
+  envoke schema check
+
+  # Check the production environment only
+  envoke schema check -e prod`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			for _, name := range args {
+				if name == "" {
+					return clierrors.Exit(errors.New("schema name cannot be empty"), 1)
+				}
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			format, err := output.FromCommand(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := ent.FromContext(ctx)
+
+			globalEnv, err := util.LoadGlobalEnvironment(ctx)
+			if err != nil {
+				return err
+			}
+			globalVars, err := globalEnv.QueryVariables().All(ctx)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			query := client.Environment.Query().
+				Where(envpred.HasSchema()).
+				Order(envpred.ByName(sql.OrderAsc())).
+				WithSchema().
+				WithVariables(func(q *ent.VariableQuery) {
+					q.Order(varpred.ByName(sql.OrderAsc()))
+				})
+			if len(args) > 0 {
+				for _, name := range args {
+					if _, err := util.FindSchema(ctx, client, name); err != nil {
+						return clierrors.Exit(err, 1)
+					}
+				}
+				query.Where(envpred.HasSchemaWith(schemapred.NameIn(args...)))
+			}
+			if name, _ := cmd.Flags().GetString("env"); name != "" {
+				name = util.EnvironmentName(ctx, name)
+				env, err := util.FindEnvironment(ctx, client, name)
+				if err != nil {
+					return clierrors.Exit(err, 1)
+				}
+				if env.SchemaID == nil {
+					return clierrors.Exit(fmt.Errorf("environment '%s' is not attached to a schema", name), 1)
+				}
+				query.Where(envpred.ID(env.ID))
+			}
+
+			envs, err := query.All(ctx)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+			if len(envs) == 0 {
+				return clierrors.Exit(errors.New("no environments attached to a schema (use 'envoke schema attach')"), 1)
+			}
+
+			problems := []*keyProblem{}
+			failed := 0
+			for _, env := range envs {
+				ps, err := checkEnvironment(ctx, globalVars, env)
+				if err != nil {
+					return clierrors.Exit(err, 1)
+				}
+				if len(ps) > 0 {
+					failed++
+				}
+				problems = append(problems, ps...)
+			}
+
+			if len(problems) == 0 {
+				if format.Kind == output.Table {
+					fmt.Printf("All %d environments match their schemas\n", len(envs))
+					return nil
+				}
+			}
+
+			err = output.Print(os.Stdout, format, problems, output.Columns[*keyProblem]{
+				Headers: []string{"Schema", "Environment", "Key", "Status", "Detail"},
+				Fields:  []string{"schema", "environment", "key", "status", "detail"},
+				Row: func(p *keyProblem) []any {
+					return []any{p.Schema, p.Environment, p.Key, p.Status, p.Detail}
+				},
+			})
+			if err != nil {
+				return clierrors.Exit(fmt.Errorf("failed to print problems: %w", err), 1)
+			}
+
+			if failed > 0 {
+				return clierrors.Exit(fmt.Errorf("%d of %d environments do not match their schemas", failed, len(envs)), 1)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("env", "e", "", "Check only the specified environment")
+
+	return cmd
+}
+
+// checkEnvironment checks env, loaded with its schema and its variables,
+// against the keys of its schema.
+func checkEnvironment(ctx context.Context, globalVars []*ent.Variable, env *ent.Environment) ([]*keyProblem, error) {
+	schema := env.Edges.Schema
+	keys, err := schema.QueryKeys().
+		Order(keypred.ByName(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query keys of schema '%s': %w", schema.Name, err)
+	}
+
+	globalEnvMap := util.MakeVariableMap(globalVars)
+	envMap := util.MakeVariableMap(env.Edges.Variables)
+
+	var problems []*keyProblem
+	add := func(key, status, detail string) {
+		problems = append(problems, &keyProblem{
+			Schema:      schema.Name,
+			Environment: env.Name,
+			Key:         key,
+			Status:      status,
+			Detail:      detail,
+		})
+	}
+
+	for _, k := range keys {
+		v, ok := envMap[k.Name]
+		if !ok {
+			v, ok = globalEnvMap[k.Name]
+		}
+		if !ok {
+			if !k.Optional {
+				add(k.Name, keyMissing, k.Description)
+			}
+			continue
+		}
+
+		value := v.Value
+		if v.Expand {
+			value, err = util.ExpandVariable(ctx, v.Value, globalEnvMap, envMap, true)
+			if err != nil {
+				add(k.Name, keyInvalid, err.Error())
+				continue
+			}
+		}
+		if err := util.SchemaKeyRule(k).Validate(value); err != nil {
+			add(k.Name, keyInvalid, err.Error())
+		}
+		if k.Secret && !v.Secret {
+			add(k.Name, keyNotSecret, "the key is secret, but the variable is not marked as secret")
+		}
+	}
+
+	for _, v := range env.Edges.Variables {
+		if !slices.ContainsFunc(keys, func(k *ent.SchemaKey) bool { return k.Name == v.Name }) {
+			add(v.Name, keyUnexpected, "not a key of the schema")
+		}
+	}
+
+	return problems, nil
+}
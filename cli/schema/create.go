@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/ent"
+	"github.com/spf13/cobra"
+)
+
+func createCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [flags] <name>",
+		Short: "Create a new schema",
+		Example: `  # Create a schema for the environments of an application
+  envoke schema create myapp --description "Environments of myapp"`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.ExactArgs(1)(cmd, args); err != nil {
+				return err
+			}
+
+			if args[0] == "" {
+				return clierrors.Exit(errors.New("schema name cannot be empty"), 1)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			name := args[0]
+
+			client := ent.FromContext(ctx)
+
+			create := client.EnvironmentSchema.Create().
+				SetName(name)
+			if description, _ := cmd.Flags().GetString("description"); description != "" {
+				create.SetDescription(description)
+			}
+
+			schema, err := create.Save(ctx)
+			if err != nil {
+				if ent.IsConstraintError(err) {
+					return clierrors.Exit(fmt.Errorf("schema '%s' already exists", name), 1)
+				}
+				return clierrors.Exit(err, 1)
+			}
+
+			fmt.Printf("Schema '%s' created successfully!\n", schema.Name)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("description", "d", "", "Description of the schema")
+
+	return cmd
+}
@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+	keypred "github.com/kechako/envoke/ent/schemakey"
+	"github.com/spf13/cobra"
+)
+
+func exportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [flags] <schema> [<file>]",
+		Short: "Export a schema as a JSON Schema",
+		Long: `Export a schema as a JSON Schema object describing environment variables,
+which can be validated by applications at boot.
+
+Types of envoke without an equivalent in JSON Schema (port, duration and json)
+are kept in the x-envoke-type keyword, so that the schema can be imported
+again with 'envoke schema import'. Secret keys are exported as writeOnly
+properties, and keys that are not optional are listed in required.
+
+The JSON Schema is written to the standard output if no file is given, or if
+the file is "-".`,
+		Example: `  # Export the schema of an application
+  envoke schema export myapp env.schema.json`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.RangeArgs(1, 2)(cmd, args); err != nil {
+				return err
+			}
+
+			if args[0] == "" {
+				return clierrors.Exit(errors.New("schema name cannot be empty"), 1)
+			}
+			if len(args) > 1 && args[1] == "" {
+				return clierrors.Exit(errors.New("schema file path cannot be empty"), 1)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client := ent.FromContext(ctx)
+
+			schema, err := util.FindSchema(ctx, client, args[0])
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			keys, err := schema.QueryKeys().
+				Order(keypred.ByName(sql.OrderAsc())).
+				All(ctx)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			data, err := json.MarshalIndent(newJSONSchema(schema, keys), "", "  ")
+			if err != nil {
+				return clierrors.Exit(fmt.Errorf("failed to encode schema: %w", err), 1)
+			}
+			data = append(data, '\n')
+
+			if len(args) < 2 || args[1] == "-" {
+				if _, err := os.Stdout.Write(data); err != nil {
+					return clierrors.Exit(fmt.Errorf("failed to write schema: %w", err), 1)
+				}
+				return nil
+			}
+
+			fileName := args[1]
+			if err := os.WriteFile(fileName, data, 0644); err != nil {
+				return clierrors.Exit(fmt.Errorf("failed to write schema file '%s': %w", fileName, err), 1)
+			}
+
+			fmt.Printf("Exported %d keys of schema '%s' to '%s'.\n", len(keys), schema.Name, fileName)
+
+			return nil
+		},
+	}
+
+	return cmd
+}
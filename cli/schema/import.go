@@ -0,0 +1,181 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/ent"
+	schemapred "github.com/kechako/envoke/ent/environmentschema"
+	keypred "github.com/kechako/envoke/ent/schemakey"
+	"github.com/spf13/cobra"
+)
+
+func importCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [flags] [<file>]",
+		Short: "Import a schema from a JSON Schema",
+		Long: `Import a schema from a JSON Schema object describing environment variables,
+such as the schemas validated by applications at boot.
+
+Each property becomes a key of the schema. The type, format, pattern, enum,
+default, description, minimum, maximum, minLength and maxLength keywords are
+converted to the type, the constraints and the default value of the key.
+Properties not listed in required become optional keys, and writeOnly
+properties become secret keys.
+
+The name of the schema is taken from --name, the title of the JSON Schema,
+or the name of the file, and the schema is created if it does not exist. The
+keys of an existing schema are replaced unless --merge is given. Environments
+attached to the schema are checked against it with 'envoke schema check'.`,
+		Example: `  # Import the schema of an application
+  envoke schema import --name myapp env.schema.json
+  envoke schema attach myapp dev staging prod
+  envoke schema check myapp`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.RangeArgs(0, 1)(cmd, args); err != nil {
+				return err
+			}
+
+			if len(args) > 0 && args[0] == "" {
+				return clierrors.Exit(errors.New("schema file path cannot be empty"), 1)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			merge, _ := cmd.Flags().GetBool("merge")
+			name, _ := cmd.Flags().GetString("name")
+
+			var fileName string
+			var r io.Reader
+			if len(args) == 0 || args[0] == "-" {
+				fileName = "<stdin>"
+				r = os.Stdin
+			} else {
+				fileName = args[0]
+				file, err := os.Open(fileName)
+				if err != nil {
+					return clierrors.Exit(fmt.Errorf("failed to open schema file '%s': %w", fileName, err), 1)
+				}
+				defer file.Close()
+				r = file
+			}
+
+			data, err := io.ReadAll(r)
+			if err != nil {
+				return clierrors.Exit(fmt.Errorf("failed to read schema file '%s': %w", fileName, err), 1)
+			}
+
+			s, err := parseJSONSchema(data)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+			keys, err := s.keys()
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			if name == "" {
+				name = s.Title
+			}
+			if name == "" && len(args) > 0 && args[0] != "-" {
+				name = strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+				name = strings.TrimSuffix(name, ".schema")
+			}
+			if name == "" {
+				return clierrors.Exit(errors.New("schema name cannot be empty (specify --name)"), 1)
+			}
+
+			client := ent.FromContext(ctx)
+
+			err = client.DoTransaction(ctx, nil, func(ctx context.Context, tx *ent.Tx) error {
+				schema, err := tx.EnvironmentSchema.Query().
+					Where(schemapred.Name(name)).
+					Only(ctx)
+				if err != nil {
+					if !ent.IsNotFound(err) {
+						return err
+					}
+
+					create := tx.EnvironmentSchema.Create().
+						SetName(name)
+					if s.Description != "" {
+						create.SetDescription(s.Description)
+					}
+					schema, err = create.Save(ctx)
+					if err != nil {
+						return fmt.Errorf("failed to create schema '%s': %w", name, err)
+					}
+				}
+
+				deleteKeys := tx.SchemaKey.Delete().
+					Where(keypred.SchemaID(schema.ID))
+				if merge {
+					names := make([]string, len(keys))
+					for i, k := range keys {
+						names[i] = k.Name
+					}
+					deleteKeys.Where(keypred.NameIn(names...))
+				}
+				if _, err := deleteKeys.Exec(ctx); err != nil {
+					return fmt.Errorf("failed to remove existing keys: %w", err)
+				}
+
+				for _, k := range keys {
+					create := tx.SchemaKey.Create().
+						SetSchema(schema).
+						SetName(k.Name).
+						SetType(k.Type).
+						SetNillableDefaultValue(k.DefaultValue)
+					if k.Description != "" {
+						create.SetDescription(k.Description)
+					}
+					if k.Secret {
+						create.SetSecret(true)
+					}
+					if k.Optional {
+						create.SetOptional(true)
+					}
+					if k.Pattern != "" {
+						create.SetPattern(k.Pattern)
+					}
+					if k.Min != "" {
+						create.SetMin(k.Min)
+					}
+					if k.Max != "" {
+						create.SetMax(k.Max)
+					}
+					if len(k.EnumValues) > 0 {
+						create.SetEnumValues(k.EnumValues)
+					}
+
+					if err := create.Exec(ctx); err != nil {
+						return fmt.Errorf("failed to import key '%s': %w", k.Name, err)
+					}
+				}
+
+				return nil
+			})
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			fmt.Printf("Imported %d keys from '%s' into schema '%s'.\n", len(keys), fileName, name)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("name", "n", "", "Name of the schema (default: the title of the JSON Schema or the file name)")
+	cmd.Flags().Bool("merge", false, "Merge with existing keys (default: false)")
+
+	return cmd
+}
@@ -0,0 +1,402 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kechako/envoke/ent"
+	"github.com/kechako/envoke/vartype"
+)
+
+// jsonSchemaDialect is the dialect of exported JSON Schemas.
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonSchema is a JSON Schema object describing environment variables.
+type jsonSchema struct {
+	Schema      string                   `json:"$schema,omitempty"`
+	Title       string                   `json:"title,omitempty"`
+	Description string                   `json:"description,omitempty"`
+	Type        jsonType                 `json:"type,omitempty"`
+	Properties  map[string]*jsonProperty `json:"properties"`
+	Required    []string                 `json:"required,omitempty"`
+}
+
+// jsonProperty is the JSON Schema of an environment variable. Types of envoke
+// that have no equivalent in JSON Schema are kept in x-envoke-type, and the
+// bounds of durations in x-envoke-min and x-envoke-max.
+type jsonProperty struct {
+	Type        jsonType          `json:"type,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Format      string            `json:"format,omitempty"`
+	Pattern     string            `json:"pattern,omitempty"`
+	Enum        []json.RawMessage `json:"enum,omitempty"`
+	Default     json.RawMessage   `json:"default,omitempty"`
+	Minimum     *json.Number      `json:"minimum,omitempty"`
+	Maximum     *json.Number      `json:"maximum,omitempty"`
+	MinLength   *int              `json:"minLength,omitempty"`
+	MaxLength   *int              `json:"maxLength,omitempty"`
+	WriteOnly   bool              `json:"writeOnly,omitempty"`
+
+	EnvokeType string `json:"x-envoke-type,omitempty"`
+	EnvokeMin  string `json:"x-envoke-min,omitempty"`
+	EnvokeMax  string `json:"x-envoke-max,omitempty"`
+}
+
+// jsonType is the type keyword of JSON Schema, which is either a type name or
+// a list of type names.
+type jsonType []string
+
+func (t jsonType) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+func (t *jsonType) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*t = jsonType{name}
+		return nil
+	}
+
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return errors.New("type must be a string or an array of strings")
+	}
+	*t = names
+	return nil
+}
+
+// name returns the type name, ignoring "null" in a list of types.
+func (t jsonType) name() string {
+	for _, name := range t {
+		if name != "null" {
+			return name
+		}
+	}
+	return ""
+}
+
+// parseJSONSchema parses a JSON Schema object describing environment
+// variables.
+func parseJSONSchema(data []byte) (*jsonSchema, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var s jsonSchema
+	if err := dec.Decode(&s); err != nil {
+		return nil, fmt.Errorf("invalid JSON Schema: %w", err)
+	}
+	if name := s.Type.name(); name != "" && name != "object" {
+		return nil, fmt.Errorf("invalid JSON Schema: type must be 'object', not '%s'", name)
+	}
+	if len(s.Properties) == 0 {
+		return nil, errors.New("invalid JSON Schema: no properties found")
+	}
+	for _, name := range s.Required {
+		if _, ok := s.Properties[name]; !ok {
+			return nil, fmt.Errorf("invalid JSON Schema: required property '%s' is not defined", name)
+		}
+	}
+
+	return &s, nil
+}
+
+// keys returns the schema keys described by the properties of s, sorted by
+// name.
+func (s *jsonSchema) keys() ([]*ent.SchemaKey, error) {
+	keys := make([]*ent.SchemaKey, 0, len(s.Properties))
+	for name, p := range s.Properties {
+		k, err := p.key(name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid property '%s': %w", name, err)
+		}
+		k.Optional = !slices.Contains(s.Required, name)
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(a, b *ent.SchemaKey) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return keys, nil
+}
+
+// key converts p to the schema key name.
+func (p *jsonProperty) key(name string) (*ent.SchemaKey, error) {
+	k := &ent.SchemaKey{
+		Name:        name,
+		Description: p.Description,
+		Secret:      p.WriteOnly,
+		Type:        string(vartype.String),
+	}
+
+	switch p.Type.name() {
+	case "", "string":
+		switch p.Format {
+		case "uri", "url":
+			k.Type = string(vartype.URL)
+		case "email":
+			k.Type = string(vartype.Email)
+		}
+	case "integer":
+		k.Type = string(vartype.Int)
+	case "number":
+		k.Pattern = `-?(?:0|[1-9][0-9]*)(?:\.[0-9]+)?(?:[eE][+-]?[0-9]+)?`
+	case "boolean":
+		k.Type = string(vartype.Bool)
+	case "object", "array":
+		k.Type = string(vartype.JSON)
+	default:
+		return nil, fmt.Errorf("unsupported type '%s'", p.Type.name())
+	}
+	if p.EnvokeType != "" {
+		if err := vartype.ValidateType(p.EnvokeType); err != nil {
+			return nil, err
+		}
+		k.Type = p.EnvokeType
+	}
+
+	if len(p.Enum) > 0 {
+		k.Type = string(vartype.Enum)
+		for _, v := range p.Enum {
+			k.EnumValues = append(k.EnumValues, jsonString(v))
+		}
+	}
+
+	if p.Pattern != "" {
+		k.Pattern = importPattern(p.Pattern)
+	}
+
+	switch vartype.Type(k.Type) {
+	case vartype.Int, vartype.Port:
+		var err error
+		if k.Min, err = jsonInteger(p.Minimum); err != nil {
+			return nil, fmt.Errorf("invalid minimum: %w", err)
+		}
+		if k.Max, err = jsonInteger(p.Maximum); err != nil {
+			return nil, fmt.Errorf("invalid maximum: %w", err)
+		}
+		if vartype.Type(k.Type) == vartype.Port {
+			// The bounds of a port number are implied by the type.
+			if k.Min == "1" {
+				k.Min = ""
+			}
+			if k.Max == "65535" {
+				k.Max = ""
+			}
+		}
+	case vartype.String:
+		if p.MinLength != nil {
+			k.Min = strconv.Itoa(*p.MinLength)
+		}
+		if p.MaxLength != nil {
+			k.Max = strconv.Itoa(*p.MaxLength)
+		}
+	case vartype.Duration:
+		k.Min = p.EnvokeMin
+		k.Max = p.EnvokeMax
+	}
+
+	if len(p.Default) > 0 && string(p.Default) != "null" {
+		def := jsonString(p.Default)
+		k.DefaultValue = &def
+	}
+
+	return k, nil
+}
+
+// newJSONSchema returns the JSON Schema describing the keys of schema.
+func newJSONSchema(schema *ent.EnvironmentSchema, keys []*ent.SchemaKey) *jsonSchema {
+	s := &jsonSchema{
+		Schema:      jsonSchemaDialect,
+		Title:       schema.Name,
+		Description: schema.Description,
+		Type:        jsonType{"object"},
+		Properties:  make(map[string]*jsonProperty, len(keys)),
+	}
+	for _, k := range keys {
+		s.Properties[k.Name] = newJSONProperty(k)
+		if !k.Optional {
+			s.Required = append(s.Required, k.Name)
+		}
+	}
+
+	return s
+}
+
+// newJSONProperty returns the JSON Schema describing the key k.
+func newJSONProperty(k *ent.SchemaKey) *jsonProperty {
+	p := &jsonProperty{
+		Description: k.Description,
+		WriteOnly:   k.Secret,
+	}
+	if k.Pattern != "" {
+		p.Pattern = exportPattern(k.Pattern)
+	}
+
+	jsonValue := func(s string) json.RawMessage {
+		data, _ := json.Marshal(s)
+		return data
+	}
+
+	switch vartype.Type(k.Type) {
+	case vartype.Int, vartype.Port:
+		p.Type = jsonType{"integer"}
+		if k.Min != "" {
+			n := json.Number(k.Min)
+			p.Minimum = &n
+		}
+		if k.Max != "" {
+			n := json.Number(k.Max)
+			p.Maximum = &n
+		}
+		if vartype.Type(k.Type) == vartype.Port {
+			p.EnvokeType = k.Type
+			if p.Minimum == nil {
+				n := json.Number("1")
+				p.Minimum = &n
+			}
+			if p.Maximum == nil {
+				n := json.Number("65535")
+				p.Maximum = &n
+			}
+		}
+		jsonValue = func(s string) json.RawMessage {
+			if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+				return json.RawMessage(s)
+			}
+			data, _ := json.Marshal(s)
+			return data
+		}
+	case vartype.Bool:
+		p.Type = jsonType{"boolean"}
+		jsonValue = func(s string) json.RawMessage {
+			if b, err := strconv.ParseBool(s); err == nil {
+				return json.RawMessage(strconv.FormatBool(b))
+			}
+			data, _ := json.Marshal(s)
+			return data
+		}
+	case vartype.URL:
+		p.Type = jsonType{"string"}
+		p.Format = "uri"
+	case vartype.Email:
+		p.Type = jsonType{"string"}
+		p.Format = "email"
+	case vartype.Duration:
+		p.Type = jsonType{"string"}
+		p.EnvokeType = k.Type
+		p.EnvokeMin = k.Min
+		p.EnvokeMax = k.Max
+	case vartype.JSON:
+		p.EnvokeType = k.Type
+	case vartype.Enum:
+		p.Type = jsonType{"string"}
+		for _, v := range k.EnumValues {
+			p.Enum = append(p.Enum, jsonValue(v))
+		}
+	default:
+		p.Type = jsonType{"string"}
+		if k.Min != "" {
+			n, _ := strconv.Atoi(k.Min)
+			p.MinLength = &n
+		}
+		if k.Max != "" {
+			n, _ := strconv.Atoi(k.Max)
+			p.MaxLength = &n
+		}
+	}
+
+	if k.DefaultValue != nil {
+		p.Default = jsonValue(*k.DefaultValue)
+	}
+
+	return p
+}
+
+// jsonString returns a JSON value as the value of a variable: strings are
+// unquoted, and other values are kept in their JSON representation.
+func jsonString(v json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(v, &s); err == nil {
+		return s
+	}
+	return string(v)
+}
+
+// jsonInteger returns n as an integer bound, or "" if n is nil.
+func jsonInteger(n *json.Number) (string, error) {
+	if n == nil {
+		return "", nil
+	}
+	f, err := n.Float64()
+	if err != nil || f != math.Trunc(f) {
+		return "", fmt.Errorf("'%s' is not an integer", n.String())
+	}
+	return strconv.FormatInt(int64(f), 10), nil
+}
+
+// importPattern converts a pattern of JSON Schema, which matches anywhere in
+// the value unless anchored, to a pattern matching the whole value.
+func importPattern(pattern string) string {
+	if hasAlternation(pattern) {
+		return ".*(?:" + pattern + ").*"
+	}
+
+	if p, ok := strings.CutPrefix(pattern, "^"); ok {
+		pattern = p
+	} else {
+		pattern = ".*" + pattern
+	}
+	if p, ok := strings.CutSuffix(pattern, "$"); ok && !strings.HasSuffix(p, `\`) {
+		pattern = p
+	} else {
+		pattern = pattern + ".*"
+	}
+	return pattern
+}
+
+// exportPattern converts a pattern matching the whole value to a pattern of
+// JSON Schema.
+func exportPattern(pattern string) string {
+	if hasAlternation(pattern) {
+		return "^(?:" + pattern + ")$"
+	}
+	return "^" + pattern + "$"
+}
+
+// hasAlternation reports whether pattern has an alternation outside of any
+// group, which would be split by anchors added around the pattern.
+func hasAlternation(pattern string) bool {
+	depth := 0
+	class := false
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\':
+			i++
+		case class:
+			if c == ']' {
+				class = false
+			}
+		case c == '[':
+			class = true
+			if i+1 < len(pattern) && pattern[i+1] == ']' {
+				i++
+			}
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == '|' && depth == 0:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/output"
+	"github.com/kechako/envoke/ent"
+	envpred "github.com/kechako/envoke/ent/environment"
+	schemapred "github.com/kechako/envoke/ent/environmentschema"
+	"github.com/spf13/cobra"
+)
+
+func listCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List all schemas",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			format, err := output.FromCommand(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := ent.FromContext(ctx)
+
+			schemas, err := client.EnvironmentSchema.Query().
+				Order(schemapred.ByName(sql.OrderAsc())).
+				WithKeys().
+				WithEnvironments(func(q *ent.EnvironmentQuery) {
+					q.Order(envpred.ByName(sql.OrderAsc()))
+				}).
+				All(ctx)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			if len(schemas) == 0 && format.Kind == output.Table {
+				fmt.Println("(No schemas found)")
+				return nil
+			}
+
+			type Schema struct {
+				Name         string   `json:"name" yaml:"name"`
+				Description  string   `json:"description" yaml:"description"`
+				KeysCount    int      `json:"keys_count" yaml:"keys_count"`
+				Environments []string `json:"environments" yaml:"environments"`
+			}
+
+			records := make([]*Schema, len(schemas))
+			for i, s := range schemas {
+				envs := make([]string, len(s.Edges.Environments))
+				for j, env := range s.Edges.Environments {
+					envs[j] = env.Name
+				}
+				records[i] = &Schema{
+					Name:         s.Name,
+					Description:  s.Description,
+					KeysCount:    len(s.Edges.Keys),
+					Environments: envs,
+				}
+			}
+
+			err = output.Print(os.Stdout, format, records, output.Columns[*Schema]{
+				Headers: []string{"Name", "Description", "Keys", "Environments"},
+				Fields:  []string{"name", "description", "keys_count", "environments"},
+				Row: func(s *Schema) []any {
+					return []any{s.Name, s.Description, s.KeysCount, strings.Join(s.Environments, ", ")}
+				},
+			})
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
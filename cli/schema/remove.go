@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+	"github.com/spf13/cobra"
+)
+
+func removeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove [flags] <name>",
+		Aliases: []string{"rm"},
+		Short:   "Remove a schema",
+		Long: `Remove a schema and its keys. Environments attached to the schema are
+detached, and their variables are kept.`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.ExactArgs(1)(cmd, args); err != nil {
+				return err
+			}
+
+			if args[0] == "" {
+				return clierrors.Exit(errors.New("schema name cannot be empty"), 1)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			name := args[0]
+
+			client := ent.FromContext(ctx)
+
+			err := client.DoTransaction(ctx, nil, func(ctx context.Context, tx *ent.Tx) error {
+				schema, err := util.FindSchema(ctx, tx.Client(), name)
+				if err != nil {
+					return clierrors.Exit(err, 1)
+				}
+
+				confirm, err := util.ConfirmPrompt("Are you sure to remove this schema?")
+				if err != nil {
+					return clierrors.Exit(err, 1)
+				}
+				if !confirm {
+					return clierrors.Exit(errors.New("schema removal cancelled"), 0)
+				}
+
+				if err := tx.EnvironmentSchema.DeleteOne(schema).Exec(ctx); err != nil {
+					return clierrors.Exit(err, 1)
+				}
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Schema '%s' removed successfully!\n", name)
+
+			return nil
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,38 @@
+// Package schema provides functionality to manage environment schemas.
+package schema
+
+import (
+	"github.com/spf13/cobra"
+)
+
+const GroupID = "schema"
+
+func Command() *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: GroupID,
+		Use:     "schema",
+		Short:   "Manage environment schemas",
+		Long: `Manage environment schemas.
+
+A schema lists the keys expected in a group of environments, such as the
+development, staging and production environments of an application, with
+their types, default values and whether they are secret. Environments are
+attached to a schema, and checked against it with 'envoke schema check'.`,
+	}
+
+	cmd.AddCommand(
+		attachCommand(),
+		checkCommand(),
+		createCommand(),
+		detachCommand(),
+		exportCommand(),
+		importCommand(),
+		listCommand(),
+		removeCommand(),
+		setCommand(),
+		showCommand(),
+		unsetCommand(),
+	)
+
+	return cmd
+}
@@ -0,0 +1,220 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+	keypred "github.com/kechako/envoke/ent/schemakey"
+	"github.com/spf13/cobra"
+)
+
+func setCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set [flags] <schema> <key>",
+		Short: "Add or update a key of a schema",
+		Long: `Add a key to a schema, or update it if it already exists. Properties not
+given are left unchanged when a key is updated.
+
+The type and the constraints are the same as those of typed variables, and the
+default value must satisfy them. The default value is used when an environment
+is created with 'envoke create --from-schema'.`,
+		Example: `  # Expect a port number, 8080 by default
+  envoke schema set myapp PORT --type port --default 8080
+
+  # Expect a secret with no default value
+  envoke schema set myapp API_KEY --secret -d "Key of the payment API"
+
+  # Remove the default value of a key
+  envoke schema set myapp PORT --no-default`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.ExactArgs(2)(cmd, args); err != nil {
+				return err
+			}
+
+			if args[0] == "" {
+				return clierrors.Exit(errors.New("schema name cannot be empty"), 1)
+			}
+			if args[1] == "" {
+				return clierrors.Exit(errors.New("key name cannot be empty"), 1)
+			}
+			if cmd.Flags().Changed("default") && cmd.Flags().Changed("no-default") {
+				return clierrors.Exit(errors.New("cannot use --default with --no-default"), 1)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			schemaName := args[0]
+			name := args[1]
+
+			client := ent.FromContext(ctx)
+
+			var created bool
+			err := client.DoTransaction(ctx, nil, func(ctx context.Context, tx *ent.Tx) error {
+				schema, err := util.FindSchema(ctx, tx.Client(), schemaName)
+				if err != nil {
+					return clierrors.Exit(err, 1)
+				}
+
+				key, err := tx.SchemaKey.Query().
+					Where(
+						keypred.SchemaID(schema.ID),
+						keypred.Name(name),
+					).
+					Only(ctx)
+				if err != nil && !ent.IsNotFound(err) {
+					return clierrors.Exit(err, 1)
+				}
+
+				if key == nil {
+					created = true
+					create := tx.SchemaKey.Create().
+						SetSchema(schema).
+						SetName(name)
+					setKeyMutation(create.Mutation(), cmd)
+					err = create.Exec(ctx)
+				} else {
+					update := tx.SchemaKey.UpdateOne(key)
+					setKeyMutation(update.Mutation(), cmd)
+					err = update.Exec(ctx)
+				}
+				if err != nil {
+					return clierrors.Exit(fmt.Errorf("failed to set key '%s': %w", name, err), 1)
+				}
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			if created {
+				fmt.Printf("Key '%s' added to schema '%s' successfully!\n", name, schemaName)
+			} else {
+				fmt.Printf("Key '%s' of schema '%s' updated successfully!\n", name, schemaName)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String("default", "", "Default value of the key")
+	cmd.Flags().Bool("no-default", false, "Remove the default value of the key (default: false)")
+	cmd.Flags().Bool("secret", false, "Mark the key as secret (default: false)")
+	cmd.Flags().Bool("optional", false, "Mark the key as optional, so that it may be missing (default: false)")
+	cmd.Flags().StringP("description", "d", "", "Description of the key")
+	util.AddRuleFlags(cmd)
+
+	return cmd
+}
+
+func setKeyMutation(m *ent.SchemaKeyMutation, cmd *cobra.Command) {
+	if cmd.Flags().Changed("default") {
+		def, _ := cmd.Flags().GetString("default")
+		m.SetDefaultValue(def)
+	}
+	if noDefault, _ := cmd.Flags().GetBool("no-default"); noDefault {
+		m.ClearDefaultValue()
+	}
+
+	if cmd.Flags().Changed("secret") {
+		secret, _ := cmd.Flags().GetBool("secret")
+		if secret {
+			m.SetSecret(true)
+		} else {
+			m.ClearSecret()
+		}
+	}
+
+	if cmd.Flags().Changed("optional") {
+		optional, _ := cmd.Flags().GetBool("optional")
+		if optional {
+			m.SetOptional(true)
+		} else {
+			m.ClearOptional()
+		}
+	}
+
+	if cmd.Flags().Changed("description") {
+		description, _ := cmd.Flags().GetString("description")
+		if description != "" {
+			m.SetDescription(description)
+		} else {
+			m.ClearDescription()
+		}
+	}
+
+	util.SetRuleFromFlags(m, cmd)
+}
+
+func unsetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unset [flags] <schema> <key>...",
+		Short: "Remove keys from a schema",
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.MinimumNArgs(2)(cmd, args); err != nil {
+				return err
+			}
+
+			if args[0] == "" {
+				return clierrors.Exit(errors.New("schema name cannot be empty"), 1)
+			}
+			for _, name := range args[1:] {
+				if name == "" {
+					return clierrors.Exit(errors.New("key name cannot be empty"), 1)
+				}
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			schemaName := args[0]
+			names := args[1:]
+
+			client := ent.FromContext(ctx)
+
+			err := client.DoTransaction(ctx, nil, func(ctx context.Context, tx *ent.Tx) error {
+				schema, err := util.FindSchema(ctx, tx.Client(), schemaName)
+				if err != nil {
+					return clierrors.Exit(err, 1)
+				}
+
+				for _, name := range names {
+					n, err := tx.SchemaKey.Delete().
+						Where(
+							keypred.SchemaID(schema.ID),
+							keypred.Name(name),
+						).
+						Exec(ctx)
+					if err != nil {
+						return clierrors.Exit(fmt.Errorf("failed to remove key '%s': %w", name, err), 1)
+					}
+					if n == 0 {
+						return clierrors.Exit(fmt.Errorf("key '%s' not found in schema '%s'", name, schemaName), 1)
+					}
+				}
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, name := range names {
+				fmt.Printf("Key '%s' removed from schema '%s' successfully!\n", name, schemaName)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
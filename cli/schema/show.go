@@ -0,0 +1,108 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/output"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+	keypred "github.com/kechako/envoke/ent/schemakey"
+	"github.com/spf13/cobra"
+)
+
+func showCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show [flags] <name>",
+		Short: "List the keys of a schema",
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.ExactArgs(1)(cmd, args); err != nil {
+				return err
+			}
+
+			if args[0] == "" {
+				return clierrors.Exit(errors.New("schema name cannot be empty"), 1)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			format, err := output.FromCommand(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := ent.FromContext(ctx)
+
+			schema, err := util.FindSchema(ctx, client, args[0])
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			keys, err := schema.QueryKeys().
+				Order(keypred.ByName(sql.OrderAsc())).
+				All(ctx)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			if len(keys) == 0 && format.Kind == output.Table {
+				fmt.Println("(No keys found)")
+				return nil
+			}
+
+			type Key struct {
+				Name        string   `json:"name" yaml:"name"`
+				Type        string   `json:"type" yaml:"type"`
+				Default     *string  `json:"default" yaml:"default"`
+				Secret      bool     `json:"secret" yaml:"secret"`
+				Optional    bool     `json:"optional" yaml:"optional"`
+				Description string   `json:"description" yaml:"description"`
+				Pattern     string   `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+				Min         string   `json:"min,omitempty" yaml:"min,omitempty"`
+				Max         string   `json:"max,omitempty" yaml:"max,omitempty"`
+				Values      []string `json:"values,omitempty" yaml:"values,omitempty"`
+			}
+
+			records := make([]*Key, len(keys))
+			for i, k := range keys {
+				records[i] = &Key{
+					Name:        k.Name,
+					Type:        k.Type,
+					Default:     k.DefaultValue,
+					Secret:      k.Secret,
+					Optional:    k.Optional,
+					Description: k.Description,
+					Pattern:     k.Pattern,
+					Min:         k.Min,
+					Max:         k.Max,
+					Values:      k.EnumValues,
+				}
+			}
+
+			err = output.Print(os.Stdout, format, records, output.Columns[*Key]{
+				Headers: []string{"Name", "Type", "Default", "Secret", "Optional", "Description"},
+				Fields:  []string{"name", "type", "default", "secret", "optional", "description"},
+				Row: func(k *Key) []any {
+					def := ""
+					if k.Default != nil {
+						def = *k.Default
+					}
+					return []any{k.Name, k.Type, def, k.Secret, k.Optional, k.Description}
+				},
+			})
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,606 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+	envpred "github.com/kechako/envoke/ent/environment"
+	varpred "github.com/kechako/envoke/ent/variable"
+)
+
+type pane int
+
+const (
+	paneEnvs pane = iota
+	paneVars
+)
+
+type mode int
+
+const (
+	modeNormal mode = iota
+	modeSearch
+	modePrompt
+	modeConfirm
+)
+
+// item is a row of the variables pane. In diff mode, other holds the
+// variable of the environment compared against.
+type item struct {
+	v     *ent.Variable
+	other *ent.Variable
+	mark  byte
+}
+
+func (it *item) name() string {
+	if it.v != nil {
+		return it.v.Name
+	}
+	return it.other.Name
+}
+
+type app struct {
+	ctx    context.Context
+	client *ent.Client
+	term   *terminal
+
+	envs []*ent.Environment
+
+	focus     pane
+	envCursor int
+	varCursor int
+	envFilter string
+	varFilter string
+	revealed  map[int]bool
+	diffEnv   *ent.Environment
+
+	mode      mode
+	prompt    string
+	input     string
+	onSubmit  func(string)
+	onConfirm func()
+	message   string
+
+	quit bool
+}
+
+func newApp(ctx context.Context, client *ent.Client) *app {
+	return &app{
+		ctx:      ctx,
+		client:   client,
+		revealed: map[int]bool{},
+	}
+}
+
+func (a *app) run() error {
+	t, err := openTerminal()
+	if err != nil {
+		return err
+	}
+	defer t.Close()
+	a.term = t
+
+	for !a.quit {
+		a.render()
+
+		keys, err := t.ReadKeys()
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			a.handleKey(k)
+			if a.quit {
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// reload loads all environments with their variables, keeping the selection.
+func (a *app) reload() error {
+	var selected, diff string
+	if env := a.selectedEnv(); env != nil {
+		selected = env.Name
+	}
+	if a.diffEnv != nil {
+		diff = a.diffEnv.Name
+	}
+
+	envs, err := a.client.Environment.Query().
+		Order(envpred.ByName(sql.OrderAsc())).
+		WithVariables(func(q *ent.VariableQuery) {
+			q.Order(varpred.ByName(sql.OrderAsc()))
+		}).
+		All(a.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load environments: %w", err)
+	}
+
+	slices.SortStableFunc(envs, func(a, b *ent.Environment) int {
+		if a.Name == "global" {
+			return -1 // Ensure 'global' is always first
+		}
+		if b.Name == "global" {
+			return 1 // Ensure 'global' is always first
+		}
+
+		return strings.Compare(a.Name, b.Name)
+	})
+	a.envs = envs
+
+	a.diffEnv = nil
+	for _, env := range envs {
+		if env.Name == diff {
+			a.diffEnv = env
+		}
+	}
+
+	if selected != "" {
+		if i := slices.IndexFunc(a.filteredEnvs(), func(env *ent.Environment) bool { return env.Name == selected }); i >= 0 {
+			a.envCursor = i
+		}
+	}
+	a.clampCursors()
+
+	return nil
+}
+
+func (a *app) filteredEnvs() []*ent.Environment {
+	if a.envFilter == "" {
+		return a.envs
+	}
+
+	filter := strings.ToLower(a.envFilter)
+
+	var envs []*ent.Environment
+	for _, env := range a.envs {
+		if strings.Contains(strings.ToLower(env.Name), filter) {
+			envs = append(envs, env)
+		}
+	}
+	return envs
+}
+
+func (a *app) selectedEnv() *ent.Environment {
+	envs := a.filteredEnvs()
+	if a.envCursor < 0 || a.envCursor >= len(envs) {
+		return nil
+	}
+	return envs[a.envCursor]
+}
+
+func (a *app) items() []*item {
+	env := a.selectedEnv()
+	if env == nil {
+		return nil
+	}
+
+	var items []*item
+	if a.diffEnv == nil {
+		for _, v := range env.Edges.Variables {
+			items = append(items, &item{v: v})
+		}
+	} else {
+		items = diffItems(env.Edges.Variables, a.diffEnv.Edges.Variables)
+	}
+
+	if a.varFilter == "" {
+		return items
+	}
+
+	filter := strings.ToLower(a.varFilter)
+
+	var filtered []*item
+	for _, it := range items {
+		if strings.Contains(strings.ToLower(it.name()), filter) ||
+			(it.v != nil && !it.v.Secret && strings.Contains(strings.ToLower(it.v.Value), filter)) {
+			filtered = append(filtered, it)
+		}
+	}
+	return filtered
+}
+
+func diffItems(vars, otherVars []*ent.Variable) []*item {
+	var items []*item
+	for _, d := range util.DiffVariables(vars, otherVars) {
+		it := &item{v: d.Variable, other: d.Other}
+		switch d.Status {
+		case util.DiffRemoved:
+			it.mark = '-'
+		case util.DiffAdded:
+			it.mark = '+'
+		case util.DiffChanged:
+			it.mark = '~'
+		default:
+			it.mark = ' '
+		}
+		items = append(items, it)
+	}
+
+	return items
+}
+
+func (a *app) selectedItem() *item {
+	items := a.items()
+	if a.varCursor < 0 || a.varCursor >= len(items) {
+		return nil
+	}
+	return items[a.varCursor]
+}
+
+func (a *app) clampCursors() {
+	a.envCursor = max(0, min(a.envCursor, len(a.filteredEnvs())-1))
+	a.varCursor = max(0, min(a.varCursor, len(a.items())-1))
+}
+
+func (a *app) handleKey(k key) {
+	switch a.mode {
+	case modeSearch:
+		a.handleSearchKey(k)
+	case modePrompt:
+		a.handlePromptKey(k)
+	case modeConfirm:
+		a.handleConfirmKey(k)
+	default:
+		a.handleNormalKey(k)
+	}
+}
+
+func (a *app) handleNormalKey(k key) {
+	a.message = ""
+
+	switch k.code {
+	case keyCtrlC:
+		a.quit = true
+	case keyUp:
+		a.moveCursor(-1)
+	case keyDown:
+		a.moveCursor(1)
+	case keyTab:
+		a.switchFocus()
+	case keyLeft:
+		a.focus = paneEnvs
+	case keyRight:
+		a.focus = paneVars
+	case keyEnter:
+		if a.focus == paneEnvs {
+			a.focus = paneVars
+		} else {
+			a.editVariable()
+		}
+	case keyEsc:
+		switch {
+		case a.diffEnv != nil:
+			a.diffEnv = nil
+			a.clampCursors()
+		case a.focus == paneVars && a.varFilter != "":
+			a.varFilter = ""
+		case a.focus == paneEnvs && a.envFilter != "":
+			a.envFilter = ""
+		}
+	case keyRune:
+		switch k.r {
+		case 'q':
+			a.quit = true
+		case 'k':
+			a.moveCursor(-1)
+		case 'j':
+			a.moveCursor(1)
+		case 'h':
+			a.focus = paneEnvs
+		case 'l':
+			a.focus = paneVars
+		case '/':
+			a.mode = modeSearch
+			if a.focus == paneEnvs {
+				a.input = a.envFilter
+			} else {
+				a.input = a.varFilter
+			}
+		case 'r', ' ':
+			a.toggleReveal()
+		case 'e':
+			a.editVariable()
+		case 'a':
+			a.addVariable()
+		case 'd':
+			a.removeVariable()
+		case 'c':
+			a.copyVariable()
+		case 'D':
+			a.startDiff()
+		}
+	}
+}
+
+func (a *app) handleSearchKey(k key) {
+	switch k.code {
+	case keyEnter:
+		a.mode = modeNormal
+		return
+	case keyEsc, keyCtrlC:
+		a.input = ""
+		a.mode = modeNormal
+	case keyBackspace:
+		a.input = dropLastRune(a.input)
+	case keyRune:
+		a.input += string(k.r)
+	default:
+		return
+	}
+
+	if a.focus == paneEnvs {
+		a.envFilter = a.input
+		a.envCursor = 0
+		a.varCursor = 0
+	} else {
+		a.varFilter = a.input
+		a.varCursor = 0
+	}
+}
+
+func (a *app) handlePromptKey(k key) {
+	switch k.code {
+	case keyEnter:
+		a.mode = modeNormal
+		onSubmit := a.onSubmit
+		a.onSubmit = nil
+		onSubmit(a.input)
+	case keyEsc, keyCtrlC:
+		a.mode = modeNormal
+		a.onSubmit = nil
+		a.message = "Cancelled"
+	case keyBackspace:
+		a.input = dropLastRune(a.input)
+	case keyRune:
+		a.input += string(k.r)
+	}
+}
+
+func (a *app) handleConfirmKey(k key) {
+	a.mode = modeNormal
+	onConfirm := a.onConfirm
+	a.onConfirm = nil
+
+	if k.code == keyRune && (k.r == 'y' || k.r == 'Y') {
+		onConfirm()
+		return
+	}
+	a.message = "Cancelled"
+}
+
+func (a *app) startPrompt(prompt, input string, onSubmit func(string)) {
+	a.mode = modePrompt
+	a.prompt = prompt
+	a.input = input
+	a.onSubmit = onSubmit
+}
+
+func (a *app) startConfirm(prompt string, onConfirm func()) {
+	a.mode = modeConfirm
+	a.prompt = prompt
+	a.onConfirm = onConfirm
+}
+
+func (a *app) moveCursor(delta int) {
+	if a.focus == paneEnvs {
+		prev := a.envCursor
+		a.envCursor += delta
+		a.clampCursors()
+		if a.envCursor != prev {
+			a.varCursor = 0
+			a.varFilter = ""
+		}
+	} else {
+		a.varCursor += delta
+		a.clampCursors()
+	}
+}
+
+func (a *app) switchFocus() {
+	if a.focus == paneEnvs {
+		a.focus = paneVars
+	} else {
+		a.focus = paneEnvs
+	}
+}
+
+func (a *app) toggleReveal() {
+	it := a.selectedItem()
+	if it == nil {
+		return
+	}
+	for _, v := range []*ent.Variable{it.v, it.other} {
+		if v != nil && v.Secret {
+			a.revealed[v.ID] = !a.revealed[v.ID]
+		}
+	}
+}
+
+func (a *app) setError(err error) {
+	a.message = "Error: " + err.Error()
+}
+
+func (a *app) reloadAfter(message string) {
+	if err := a.reload(); err != nil {
+		a.setError(err)
+		return
+	}
+	a.message = message
+}
+
+func (a *app) editVariable() {
+	it := a.selectedItem()
+	if it == nil || it.v == nil {
+		return
+	}
+	v := it.v
+
+	input := v.Value
+	prompt := fmt.Sprintf("New value for %s: ", v.Name)
+	// A secret that is not revealed is edited from an empty input, which
+	// keeps the value when submitted as is.
+	hidden := v.Secret && !a.revealed[v.ID]
+	if hidden {
+		input = ""
+		prompt = fmt.Sprintf("New value for %s (secret, empty to keep): ", v.Name)
+	}
+
+	a.startPrompt(prompt, input, func(value string) {
+		if hidden && value == "" {
+			a.message = fmt.Sprintf("Variable '%s' unchanged (reveal it with r to clear it)", v.Name)
+			return
+		}
+
+		err := a.client.Variable.UpdateOne(v).
+			SetValue(value).
+			Exec(a.ctx)
+		if err != nil {
+			a.setError(err)
+			return
+		}
+		a.reloadAfter(fmt.Sprintf("Variable '%s' updated", v.Name))
+	})
+}
+
+func (a *app) addVariable() {
+	env := a.selectedEnv()
+	if env == nil {
+		return
+	}
+
+	a.startPrompt(fmt.Sprintf("New variable name in %s: ", env.Name), "", func(name string) {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			a.message = "Variable name cannot be empty"
+			return
+		}
+
+		a.startPrompt(fmt.Sprintf("Value for %s: ", name), "", func(value string) {
+			err := a.client.Variable.Create().
+				SetEnvironment(env).
+				SetName(name).
+				SetValue(value).
+				Exec(a.ctx)
+			if err != nil {
+				if ent.IsConstraintError(err) {
+					a.message = fmt.Sprintf("Variable '%s' already exists in environment '%s'", name, env.Name)
+					return
+				}
+				a.setError(err)
+				return
+			}
+			a.reloadAfter(fmt.Sprintf("Variable '%s' added", name))
+		})
+	})
+}
+
+func (a *app) removeVariable() {
+	env := a.selectedEnv()
+	it := a.selectedItem()
+	if env == nil || it == nil || it.v == nil {
+		return
+	}
+	v := it.v
+
+	a.startConfirm(fmt.Sprintf("Remove variable '%s' from '%s'? (y/N)", v.Name, env.Name), func() {
+		err := a.client.Variable.DeleteOne(v).Exec(a.ctx)
+		if err != nil {
+			a.setError(err)
+			return
+		}
+		a.reloadAfter(fmt.Sprintf("Variable '%s' removed", v.Name))
+	})
+}
+
+func (a *app) copyVariable() {
+	it := a.selectedItem()
+	if it == nil || it.v == nil {
+		return
+	}
+	v := it.v
+
+	a.startPrompt(fmt.Sprintf("Copy %s to environment: ", v.Name), "", func(name string) {
+		target, err := util.FindEnvironment(a.ctx, a.client, strings.TrimSpace(name))
+		if err != nil {
+			a.setError(err)
+			return
+		}
+
+		existing, err := util.FindVariable(a.ctx, a.client, target.ID, v.Name)
+		if err == nil {
+			a.startConfirm(fmt.Sprintf("Overwrite '%s' in '%s'? (y/N)", v.Name, target.Name), func() {
+				update := a.client.Variable.UpdateOne(existing).
+					SetValue(v.Value).
+					SetExpand(v.Expand).
+					SetSecret(v.Secret)
+				if v.Comment != "" {
+					update.SetComment(v.Comment)
+				} else {
+					update.ClearComment()
+				}
+				util.SetVariableRule(update.Mutation(), v)
+				if err := update.Exec(a.ctx); err != nil {
+					a.setError(err)
+					return
+				}
+				a.reloadAfter(fmt.Sprintf("Variable '%s' copied to '%s'", v.Name, target.Name))
+			})
+			return
+		}
+
+		create := a.client.Variable.Create().
+			SetEnvironment(target).
+			SetName(v.Name).
+			SetValue(v.Value)
+		if v.Expand {
+			create.SetExpand(true)
+		}
+		if v.Secret {
+			create.SetSecret(true)
+		}
+		if v.Comment != "" {
+			create.SetComment(v.Comment)
+		}
+		util.SetVariableRule(create.Mutation(), v)
+		if err := create.Exec(a.ctx); err != nil {
+			a.setError(err)
+			return
+		}
+		a.reloadAfter(fmt.Sprintf("Variable '%s' copied to '%s'", v.Name, target.Name))
+	})
+}
+
+func (a *app) startDiff() {
+	env := a.selectedEnv()
+	if env == nil {
+		return
+	}
+
+	a.startPrompt(fmt.Sprintf("Diff %s against environment: ", env.Name), "", func(name string) {
+		name = strings.TrimSpace(name)
+		i := slices.IndexFunc(a.envs, func(env *ent.Environment) bool { return env.Name == name })
+		if i < 0 {
+			a.message = fmt.Sprintf("Environment '%s' not found", name)
+			return
+		}
+		a.diffEnv = a.envs[i]
+		a.focus = paneVars
+		a.varCursor = 0
+	})
+}
+
+func dropLastRune(s string) string {
+	r := []rune(s)
+	if len(r) == 0 {
+		return s
+	}
+	return string(r[:len(r)-1])
+}
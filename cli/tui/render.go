@@ -0,0 +1,241 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+)
+
+const (
+	styleReset   = "\x1b[0m"
+	styleReverse = "\x1b[7m"
+	styleBold    = "\x1b[1m"
+	styleDim     = "\x1b[2m"
+	styleRed     = "\x1b[31m"
+	styleGreen   = "\x1b[32m"
+	styleYellow  = "\x1b[33m"
+)
+
+const helpText = "↑↓ move  Tab switch  / search  r reveal  e edit  a add  d remove  c copy  D diff  q quit"
+
+func (a *app) render() {
+	width, height := a.term.Size()
+	out := a.term.out
+
+	out.WriteString("\x1b[H\x1b[2J")
+
+	leftWidth := min(30, width/3)
+	rightWidth := max(0, width-leftWidth-1)
+	rows := max(0, height-3)
+
+	// Title bar
+	title := " envoke"
+	if env := a.selectedEnv(); env != nil {
+		title += "  " + env.Name
+		if env.Description != "" {
+			title += " - " + env.Description
+		}
+	}
+	if a.diffEnv != nil {
+		title += "  [diff: " + a.diffEnv.Name + "]"
+	}
+	out.WriteString(styleReverse + fit(title, width) + styleReset + "\r\n")
+
+	left := a.renderEnvs(leftWidth, rows)
+	right := a.renderVars(rightWidth, rows)
+	for i := range rows {
+		out.WriteString(left[i])
+		out.WriteString(styleDim + "│" + styleReset)
+		out.WriteString(right[i])
+		out.WriteString("\r\n")
+	}
+
+	// Filter line
+	var filters []string
+	if a.envFilter != "" {
+		filters = append(filters, "environments: /"+a.envFilter)
+	}
+	if a.varFilter != "" {
+		filters = append(filters, "variables: /"+a.varFilter)
+	}
+	if len(filters) > 0 {
+		out.WriteString(styleDim + fit(" filter "+strings.Join(filters, "  "), width) + styleReset + "\r\n")
+	} else {
+		out.WriteString(styleDim + fit(" "+helpText, width) + styleReset + "\r\n")
+	}
+
+	// Status line
+	switch a.mode {
+	case modeSearch:
+		out.WriteString(fit("/"+a.input+"█", width))
+	case modePrompt:
+		out.WriteString(fit(a.prompt+a.input+"█", width))
+	case modeConfirm:
+		out.WriteString(styleBold + fit(a.prompt, width) + styleReset)
+	default:
+		out.WriteString(fit(a.message, width))
+	}
+
+	out.Flush()
+}
+
+func (a *app) renderEnvs(width, rows int) []string {
+	lines := make([]string, rows)
+	if rows == 0 {
+		return lines
+	}
+
+	header := "Environments"
+	lines[0] = styleBold + fit(header, width) + styleReset
+
+	envs := a.filteredEnvs()
+	start := scrollStart(a.envCursor, len(envs), rows-1)
+	for i := 1; i < rows; i++ {
+		idx := start + i - 1
+		if idx >= len(envs) {
+			lines[i] = fit("", width)
+			continue
+		}
+
+		env := envs[idx]
+		count := fmt.Sprintf("%d", len(env.Edges.Variables))
+		name := fit(" "+env.Name, max(0, width-len(count)-1))
+		line := name + count + " "
+
+		lines[i] = a.highlight(line, idx == a.envCursor, a.focus == paneEnvs)
+	}
+
+	return lines
+}
+
+func (a *app) renderVars(width, rows int) []string {
+	lines := make([]string, rows)
+	if rows == 0 {
+		return lines
+	}
+
+	env := a.selectedEnv()
+	header := "Variables"
+	if env != nil {
+		header = "Variables: " + env.Name
+		if a.diffEnv != nil {
+			header = fmt.Sprintf("Diff: %s → %s", env.Name, a.diffEnv.Name)
+		}
+	}
+	lines[0] = styleBold + fit(" "+header, width) + styleReset
+
+	items := a.items()
+
+	nameWidth := 0
+	for _, it := range items {
+		nameWidth = max(nameWidth, utf8.RuneCountInString(it.name()))
+	}
+	nameWidth = min(nameWidth, max(8, width/3))
+
+	start := scrollStart(a.varCursor, len(items), rows-1)
+	for i := 1; i < rows; i++ {
+		idx := start + i - 1
+		if idx >= len(items) {
+			lines[i] = fit("", width)
+			continue
+		}
+
+		it := items[idx]
+
+		var b strings.Builder
+		if a.diffEnv != nil {
+			b.WriteByte(' ')
+			b.WriteByte(it.mark)
+		}
+		b.WriteByte(' ')
+		b.WriteString(fit(it.name(), nameWidth))
+		b.WriteString(" = ")
+		switch {
+		case it.v == nil:
+			b.WriteString("(none) → " + a.displayValue(it.other))
+		case it.mark == '~':
+			b.WriteString(a.displayValue(it.v) + " → " + a.displayValue(it.other))
+		default:
+			b.WriteString(a.displayValue(it.v))
+		}
+
+		line := a.highlight(fit(b.String(), width), idx == a.varCursor, a.focus == paneVars)
+		switch it.mark {
+		case '+':
+			line = styleGreen + line
+		case '-':
+			line = styleRed + line
+		case '~':
+			line = styleYellow + line
+		}
+		lines[i] = line + styleReset
+	}
+
+	return lines
+}
+
+func (a *app) displayValue(v *ent.Variable) string {
+	value := util.MaskSecret(v.Value, v.Secret && !a.revealed[v.ID])
+
+	var flags []string
+	if v.Expand {
+		flags = append(flags, "expand")
+	}
+	if v.Secret {
+		flags = append(flags, "secret")
+	}
+	if len(flags) > 0 {
+		value += "  [" + strings.Join(flags, ",") + "]"
+	}
+
+	return value
+}
+
+func (a *app) highlight(line string, selected, focused bool) string {
+	switch {
+	case selected && focused:
+		return styleReverse + line + styleReset
+	case selected:
+		return styleBold + line + styleReset
+	default:
+		return line
+	}
+}
+
+// scrollStart returns the first index to display so that cursor is visible.
+func scrollStart(cursor, count, rows int) int {
+	if rows <= 0 || count <= rows {
+		return 0
+	}
+	return max(0, min(cursor-rows+1, count-rows))
+}
+
+// fit truncates or pads s to exactly width runes.
+func fit(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+
+	s = strings.Map(func(r rune) rune {
+		switch r {
+		case '\n', '\r':
+			return '↵'
+		case '\t':
+			return ' '
+		}
+		if r < 0x20 {
+			return -1
+		}
+		return r
+	}, s)
+
+	n := utf8.RuneCountInString(s)
+	if n > width {
+		r := []rune(s)
+		return string(r[:width-1]) + "…"
+	}
+	return s + strings.Repeat(" ", width-n)
+}
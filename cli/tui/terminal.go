@@ -0,0 +1,133 @@
+package tui
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"unicode/utf8"
+
+	"golang.org/x/term"
+)
+
+type keyCode int
+
+const (
+	keyRune keyCode = iota
+	keyEnter
+	keyEsc
+	keyBackspace
+	keyTab
+	keyUp
+	keyDown
+	keyLeft
+	keyRight
+	keyCtrlC
+)
+
+type key struct {
+	code keyCode
+	r    rune
+}
+
+// terminal puts the terminal into raw mode on the alternate screen.
+type terminal struct {
+	in    *os.File
+	out   *bufio.Writer
+	state *term.State
+	buf   [256]byte
+}
+
+func openTerminal() (*terminal, error) {
+	in := os.Stdin
+	state, err := term.MakeRaw(int(in.Fd()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize terminal: %w", err)
+	}
+
+	t := &terminal{
+		in:    in,
+		out:   bufio.NewWriter(os.Stdout),
+		state: state,
+	}
+
+	// Switch to the alternate screen and hide the cursor.
+	t.out.WriteString("\x1b[?1049h\x1b[?25l")
+	t.out.Flush()
+
+	return t, nil
+}
+
+func (t *terminal) Close() error {
+	t.out.WriteString("\x1b[?25h\x1b[?1049l")
+	t.out.Flush()
+
+	return term.Restore(int(t.in.Fd()), t.state)
+}
+
+func (t *terminal) Size() (width, height int) {
+	width, height, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || width <= 0 || height <= 0 {
+		return 80, 24
+	}
+	return width, height
+}
+
+// ReadKeys blocks until input is available and returns the keys read.
+func (t *terminal) ReadKeys() ([]key, error) {
+	n, err := t.in.Read(t.buf[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return parseKeys(t.buf[:n]), nil
+}
+
+func parseKeys(b []byte) []key {
+	var keys []key
+	for len(b) > 0 {
+		switch c := b[0]; {
+		case c == 0x1b:
+			if len(b) >= 3 && (b[1] == '[' || b[1] == 'O') {
+				switch b[2] {
+				case 'A':
+					keys = append(keys, key{code: keyUp})
+				case 'B':
+					keys = append(keys, key{code: keyDown})
+				case 'C':
+					keys = append(keys, key{code: keyRight})
+				case 'D':
+					keys = append(keys, key{code: keyLeft})
+				}
+				// Skip the rest of the escape sequence.
+				i := 2
+				for i < len(b) && (b[i] < 0x40 || b[i] > 0x7e) {
+					i++
+				}
+				b = b[min(i+1, len(b)):]
+				continue
+			}
+			keys = append(keys, key{code: keyEsc})
+			b = b[1:]
+		case c == '\r' || c == '\n':
+			keys = append(keys, key{code: keyEnter})
+			b = b[1:]
+		case c == 0x7f || c == 0x08:
+			keys = append(keys, key{code: keyBackspace})
+			b = b[1:]
+		case c == '\t':
+			keys = append(keys, key{code: keyTab})
+			b = b[1:]
+		case c == 0x03:
+			keys = append(keys, key{code: keyCtrlC})
+			b = b[1:]
+		case c < 0x20:
+			// Ignore other control characters.
+			b = b[1:]
+		default:
+			r, size := utf8.DecodeRune(b)
+			keys = append(keys, key{code: keyRune, r: r})
+			b = b[size:]
+		}
+	}
+	return keys
+}
@@ -0,0 +1,69 @@
+// Package tui provides an interactive terminal user interface to browse and edit environments.
+package tui
+
+import (
+	"errors"
+	"os"
+
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/ent"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+const GroupID = "interactive"
+
+func Command() *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: GroupID,
+		Use:     "tui",
+		Short:   "Browse and edit environments in a terminal UI",
+		Long: `Browse and edit environments in a full-screen terminal user interface.
+
+Environments are listed in the left pane, and the variables of the selected
+environment in the right pane.
+
+Keys:
+  ↑/k ↓/j    Move the cursor
+  Tab ←/h →/l  Switch between the panes
+  /          Search in the focused pane (Enter to keep, Esc to clear)
+  r, Space   Reveal or hide the value of a secret variable
+  e, Enter   Edit the value of the selected variable
+  a          Add a variable to the selected environment
+  d          Remove the selected variable (with confirmation)
+  c          Copy the selected variable to another environment
+  D          Show a live diff against another environment (Esc to close)
+  q, Ctrl-C  Quit`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.ExactArgs(0)(cmd, args); err != nil {
+				return err
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if !term.IsTerminal(int(os.Stdin.Fd())) || !term.IsTerminal(int(os.Stdout.Fd())) {
+				return clierrors.Exit(errors.New("tui requires an interactive terminal"), 1)
+			}
+
+			client := ent.FromContext(ctx)
+
+			a := newApp(ctx, client)
+			err := a.reload()
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			err = a.run()
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
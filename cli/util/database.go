@@ -0,0 +1,37 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/kechako/envoke/ent"
+)
+
+func BuildDataSourceName(dbPath string) string {
+	if dbPath == "" {
+		return "file::memory:?cache=shared"
+	}
+
+	query := url.Values{}
+	query.Set("cache", "shared")
+	query.Set("_fk", "1")
+
+	sdn := &url.URL{
+		Scheme:   "file",
+		Opaque:   dbPath,
+		RawQuery: query.Encode(),
+	}
+
+	return sdn.String()
+}
+
+func MigrateDatabase(ctx context.Context, client *ent.Client) error {
+	err := client.Schema.Create(ctx, schema.WithDropIndex(true), schema.WithDropColumn(true), schema.WithForeignKeys(true))
+	if err != nil {
+		return fmt.Errorf("failed to migrate database schema: %w", err)
+	}
+
+	return nil
+}
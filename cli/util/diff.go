@@ -0,0 +1,70 @@
+package util
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/kechako/envoke/ent"
+)
+
+type DiffStatus int
+
+const (
+	DiffUnchanged DiffStatus = iota
+	DiffRemoved
+	DiffAdded
+	DiffChanged
+)
+
+func (s DiffStatus) String() string {
+	switch s {
+	case DiffRemoved:
+		return "removed"
+	case DiffAdded:
+		return "added"
+	case DiffChanged:
+		return "changed"
+	default:
+		return "unchanged"
+	}
+}
+
+// VariableDiff is the difference of a variable between two environments.
+// Variable is nil when the variable is added, and Other is nil when it is removed.
+type VariableDiff struct {
+	Name     string
+	Status   DiffStatus
+	Variable *ent.Variable
+	Other    *ent.Variable
+}
+
+// DiffVariables compares the variables of an environment with the variables of
+// another environment, and returns the differences sorted by name.
+func DiffVariables(vars, otherVars []*ent.Variable) []*VariableDiff {
+	envMap := MakeVariableMap(vars)
+	otherMap := MakeVariableMap(otherVars)
+
+	var diffs []*VariableDiff
+	for _, v := range vars {
+		other, ok := otherMap[v.Name]
+		switch {
+		case !ok:
+			diffs = append(diffs, &VariableDiff{Name: v.Name, Status: DiffRemoved, Variable: v})
+		case other.Value != v.Value || other.Expand != v.Expand:
+			diffs = append(diffs, &VariableDiff{Name: v.Name, Status: DiffChanged, Variable: v, Other: other})
+		default:
+			diffs = append(diffs, &VariableDiff{Name: v.Name, Status: DiffUnchanged, Variable: v, Other: other})
+		}
+	}
+	for _, other := range otherVars {
+		if _, ok := envMap[other.Name]; !ok {
+			diffs = append(diffs, &VariableDiff{Name: other.Name, Status: DiffAdded, Other: other})
+		}
+	}
+
+	slices.SortStableFunc(diffs, func(a, b *VariableDiff) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return diffs
+}
@@ -0,0 +1,33 @@
+package util
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kechako/envoke/ent"
+	"github.com/kechako/envoke/ent/allowedproject"
+	"github.com/kechako/envoke/project"
+)
+
+// NoProjectWarningAnnotation is the annotation of commands that do not warn
+// about project files that are invalid or not allowed, because they deal with
+// the trust of project files themselves.
+const NoProjectWarningAnnotation = "envoke:no-project-warning"
+
+// IsProjectAllowed reports whether the project file p was trusted with the
+// allow command, and has not changed since.
+func IsProjectAllowed(ctx context.Context, p *project.Project) (bool, error) {
+	client := ent.FromContext(ctx)
+
+	allowed, err := client.AllowedProject.Query().
+		Where(
+			allowedproject.Path(p.Path),
+			allowedproject.Hash(p.Hash),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check if project is allowed: %w", err)
+	}
+
+	return allowed, nil
+}
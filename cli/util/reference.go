@@ -0,0 +1,200 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/envoke/ent"
+	varpred "github.com/kechako/envoke/ent/variable"
+	"github.com/kechako/envoke/varname"
+)
+
+// VariableReferences returns the references to variables in value, of the
+// form $NAME, ${NAME}, ${ENV:NAME} or ${env:ENV/NAME}, in order of first
+// appearance. References are returned as written between the braces, and can
+// be parsed with ParseReference.
+func VariableReferences(value string) []string {
+	var names []string
+	os.Expand(value, func(name string) string {
+		if name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+		return ""
+	})
+	return names
+}
+
+// ParseReference parses a reference returned by VariableReferences, and
+// returns the name of the referenced environment and variable. env is empty
+// for a reference to a variable of the current environment, which includes
+// references such as ${NAME:-default} whose parts are not valid environment
+// and variable names.
+func ParseReference(ref string) (env, name string) {
+	i := strings.LastIndex(ref, ":")
+	if i < 0 {
+		return "", ref
+	}
+	env, name = ref[:i], ref[i+1:]
+	if env == "env" {
+		if j := strings.LastIndex(name, "/"); j >= 0 {
+			env, name = name[:j], name[j+1:]
+		}
+	}
+	if !isEnvironmentName(env) || !isVariableName(name) {
+		return "", ref
+	}
+	return env, name
+}
+
+// isEnvironmentName reports whether s can be the name of an environment in a
+// reference.
+func isEnvironmentName(s string) bool {
+	return s != "" && !strings.ContainsFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '{' || r == '}'
+	})
+}
+
+// isVariableName reports whether s can be the name of a variable in a
+// reference. The most permissive policy is used, so that references to
+// variables created under another policy are still recognized.
+func isVariableName(s string) bool {
+	return varname.AllowDots.Validate(s) == nil
+}
+
+// FormatReference returns the reference to the variable name of the
+// environment env, or to name in the current environment if env is empty.
+func FormatReference(env, name string) string {
+	if env == "" {
+		return name
+	}
+	return env + ":" + name
+}
+
+// ReferencingVariables returns the variables to be expanded that reference a
+// variable of the environment envName with ${ENV:NAME} or ${env:ENV/NAME},
+// loaded with their environment.
+func ReferencingVariables(ctx context.Context, client *ent.Client, envName string) ([]*ent.Variable, error) {
+	vars, err := client.Variable.Query().
+		Where(
+			varpred.Expand(true),
+			varpred.ValueContains(envName),
+		).
+		Order(varpred.ByName(sql.OrderAsc())).
+		WithEnvironment().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query variables: %w", err)
+	}
+
+	return slices.DeleteFunc(vars, func(v *ent.Variable) bool {
+		return !slices.ContainsFunc(VariableReferences(v.Value), func(ref string) bool {
+			env, _ := ParseReference(ref)
+			return env == envName
+		})
+	}), nil
+}
+
+// RewriteReferences returns value with the references to variables rewritten
+// by rewrite, which is called with each reference as returned by
+// VariableReferences, and returns the new reference and whether to rewrite
+// it. References keep their form, except that $NAME is written as ${NAME} if
+// the new reference is not a plain variable name.
+func RewriteReferences(value string, rewrite func(ref string) (string, bool)) string {
+	var b strings.Builder
+	i := 0
+	for j := 0; j < len(value); j++ {
+		if value[j] != '$' || j+1 >= len(value) {
+			continue
+		}
+
+		ref, w, braced := shellName(value[j+1:])
+		if ref != "" {
+			if newRef, ok := rewrite(ref); ok {
+				b.WriteString(value[i:j])
+				if braced || !isPlainName(newRef) {
+					b.WriteString("${" + newRef + "}")
+				} else {
+					b.WriteString("$" + newRef)
+				}
+				i = j + 1 + w
+			}
+		}
+		j += w
+	}
+	if i == 0 {
+		return value
+	}
+	b.WriteString(value[i:])
+	return b.String()
+}
+
+// RenameReference returns ref, a reference returned by VariableReferences,
+// with the name of the referenced variable replaced by name.
+func RenameReference(ref, name string) string {
+	_, old := ParseReference(ref)
+	return ref[:len(ref)-len(old)] + name
+}
+
+// RenameReferenceEnvironment returns ref, a reference returned by
+// VariableReferences to a variable of another environment, with the name of
+// the referenced environment replaced by env.
+func RenameReferenceEnvironment(ref, env string) string {
+	oldEnv, name := ParseReference(ref)
+	if ref == "env:"+oldEnv+"/"+name {
+		return "env:" + env + "/" + name
+	}
+	return FormatReference(env, name)
+}
+
+// shellName returns the reference at the beginning of s, which follows a '$',
+// the number of bytes it occupies and whether it is enclosed in braces, in the
+// same way as os.Expand.
+func shellName(s string) (ref string, w int, braced bool) {
+	if s[0] == '{' {
+		if len(s) > 2 && isShellSpecialVar(s[1]) && s[2] == '}' {
+			return s[1:2], 3, true
+		}
+		for i := 1; i < len(s); i++ {
+			if s[i] == '}' {
+				if i == 1 {
+					return "", 2, true
+				}
+				return s[1:i], i + 1, true
+			}
+		}
+		return "", 1, true
+	}
+	if isShellSpecialVar(s[0]) {
+		return s[0:1], 1, false
+	}
+	var i int
+	for i = 0; i < len(s) && isAlphaNum(s[i]); i++ {
+	}
+	return s[:i], i, false
+}
+
+func isPlainName(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isAlphaNum(s[i]) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func isShellSpecialVar(c uint8) bool {
+	switch c {
+	case '*', '#', '$', '@', '!', '?', '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		return true
+	}
+	return false
+}
+
+func isAlphaNum(c uint8) bool {
+	return c == '_' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
@@ -0,0 +1,144 @@
+package util
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestVariableReferences(t *testing.T) {
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{value: "plain", want: nil},
+		{value: "$A and ${B}", want: []string{"A", "B"}},
+		{value: "${A}/${A}", want: []string{"A"}},
+		{value: "${dev:HOST}:${env:prod/api/PORT}", want: []string{"dev:HOST", "env:prod/api/PORT"}},
+		{value: "${} $", want: nil},
+	}
+
+	for _, tt := range tests {
+		got := VariableReferences(tt.value)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("VariableReferences(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		ref      string
+		wantEnv  string
+		wantName string
+	}{
+		{ref: "HOST", wantEnv: "", wantName: "HOST"},
+		{ref: "dev:HOST", wantEnv: "dev", wantName: "HOST"},
+		{ref: "env:prod/HOST", wantEnv: "prod", wantName: "HOST"},
+		{ref: "env:prod/api/HOST", wantEnv: "prod/api", wantName: "HOST"},
+		{ref: "env:HOST", wantEnv: "env", wantName: "HOST"},
+		{ref: "a:b:HOST", wantEnv: "a:b", wantName: "HOST"},
+		{ref: "HOST:-localhost", wantEnv: "", wantName: "HOST:-localhost"},
+		{ref: "HOST:=localhost", wantEnv: "", wantName: "HOST:=localhost"},
+		{ref: "HOST:+set", wantEnv: "", wantName: "HOST:+set"},
+		{ref: "HOST:1", wantEnv: "", wantName: "HOST:1"},
+		{ref: ":HOST", wantEnv: "", wantName: ":HOST"},
+		{ref: "my env:HOST", wantEnv: "", wantName: "my env:HOST"},
+		{ref: "env:dev/", wantEnv: "", wantName: "env:dev/"},
+	}
+
+	for _, tt := range tests {
+		env, name := ParseReference(tt.ref)
+		if env != tt.wantEnv || name != tt.wantName {
+			t.Errorf("ParseReference(%q) = (%q, %q), want (%q, %q)", tt.ref, env, name, tt.wantEnv, tt.wantName)
+		}
+	}
+}
+
+func TestRewriteReferences(t *testing.T) {
+	rename := func(from, to string) func(string) (string, bool) {
+		return func(ref string) (string, bool) {
+			if _, name := ParseReference(ref); name == from {
+				return RenameReference(ref, to), true
+			}
+			return "", false
+		}
+	}
+
+	tests := []struct {
+		name    string
+		value   string
+		rewrite func(string) (string, bool)
+		want    string
+	}{
+		{
+			name:    "unchanged",
+			value:   "${HOST}:$PORT",
+			rewrite: rename("USER", "LOGIN"),
+			want:    "${HOST}:$PORT",
+		},
+		{
+			name:    "braced",
+			value:   "http://${HOST}:${PORT}",
+			rewrite: rename("HOST", "DB_HOST"),
+			want:    "http://${DB_HOST}:${PORT}",
+		},
+		{
+			name:    "unbraced",
+			value:   "$HOST/$HOSTNAME",
+			rewrite: rename("HOST", "DB_HOST"),
+			want:    "$DB_HOST/$HOSTNAME",
+		},
+		{
+			name:    "unbraced to braced",
+			value:   "$HOST.local",
+			rewrite: func(ref string) (string, bool) { return "dev:" + ref, true },
+			want:    "${dev:HOST}.local",
+		},
+		{
+			name:    "cross environment",
+			value:   "${dev:HOST} ${env:prod/HOST} ${HOST}",
+			rewrite: rename("HOST", "DB_HOST"),
+			want:    "${dev:DB_HOST} ${env:prod/DB_HOST} ${DB_HOST}",
+		},
+		{
+			name:    "special and malformed",
+			value:   "$1 $$ ${} ${HOST",
+			rewrite: rename("HOST", "DB_HOST"),
+			want:    "$1 $$ ${} ${HOST",
+		},
+		{
+			name:    "trailing dollar",
+			value:   "${HOST}$",
+			rewrite: rename("HOST", "DB_HOST"),
+			want:    "${DB_HOST}$",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RewriteReferences(tt.value, tt.rewrite)
+			if got != tt.want {
+				t.Errorf("RewriteReferences(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenameReferenceEnvironment(t *testing.T) {
+	tests := []struct {
+		ref  string
+		env  string
+		want string
+	}{
+		{ref: "dev:HOST", env: "development", want: "development:HOST"},
+		{ref: "env:dev/HOST", env: "development", want: "env:development/HOST"},
+		{ref: "env:dev/HOST", env: "prod/api", want: "env:prod/api/HOST"},
+	}
+
+	for _, tt := range tests {
+		got := RenameReferenceEnvironment(tt.ref, tt.env)
+		if got != tt.want {
+			t.Errorf("RenameReferenceEnvironment(%q, %q) = %q, want %q", tt.ref, tt.env, got, tt.want)
+		}
+	}
+}
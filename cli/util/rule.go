@@ -0,0 +1,138 @@
+package util
+
+import (
+	"slices"
+
+	"github.com/kechako/envoke/ent"
+	"github.com/kechako/envoke/vartype"
+	"github.com/spf13/cobra"
+)
+
+// RuleMutation is a mutation of an entity with a type and constraints, such
+// as a variable or a schema key.
+type RuleMutation interface {
+	SetType(string)
+	SetPattern(string)
+	ClearPattern()
+	SetMin(string)
+	ClearMin()
+	SetMax(string)
+	ClearMax()
+	SetEnumValues([]string)
+	ClearEnumValues()
+}
+
+// VariableRule returns the validation rule of v.
+func VariableRule(v *ent.Variable) *vartype.Rule {
+	return &vartype.Rule{
+		Type:    vartype.Type(v.Type),
+		Pattern: v.Pattern,
+		Min:     v.Min,
+		Max:     v.Max,
+		Values:  v.EnumValues,
+	}
+}
+
+// SchemaKeyRule returns the validation rule of k.
+func SchemaKeyRule(k *ent.SchemaKey) *vartype.Rule {
+	return &vartype.Rule{
+		Type:    vartype.Type(k.Type),
+		Pattern: k.Pattern,
+		Min:     k.Min,
+		Max:     k.Max,
+		Values:  k.EnumValues,
+	}
+}
+
+// SetVariableRule sets the type and the constraints of v to m.
+func SetVariableRule(m RuleMutation, v *ent.Variable) {
+	if v.Type != "" {
+		m.SetType(v.Type)
+	}
+	if v.Pattern != "" {
+		m.SetPattern(v.Pattern)
+	} else {
+		m.ClearPattern()
+	}
+	if v.Min != "" {
+		m.SetMin(v.Min)
+	} else {
+		m.ClearMin()
+	}
+	if v.Max != "" {
+		m.SetMax(v.Max)
+	} else {
+		m.ClearMax()
+	}
+	if len(v.EnumValues) > 0 {
+		m.SetEnumValues(v.EnumValues)
+	} else {
+		m.ClearEnumValues()
+	}
+}
+
+// EqualVariableRule reports whether a and b have the same type and constraints.
+func EqualVariableRule(a, b *ent.Variable) bool {
+	return a.Type == b.Type &&
+		a.Pattern == b.Pattern &&
+		a.Min == b.Min &&
+		a.Max == b.Max &&
+		slices.Equal(a.EnumValues, b.EnumValues)
+}
+
+// AddRuleFlags adds the flags of the type and the constraints of a value.
+func AddRuleFlags(cmd *cobra.Command) {
+	cmd.Flags().String("type", "", "Type of the value (string, int, bool, url, port, duration, email, json, enum) (default: string)")
+	cmd.Flags().String("pattern", "", "Regular expression that the whole value must match")
+	cmd.Flags().String("min", "", "Minimum length of a string, value of an int or a port, or duration")
+	cmd.Flags().String("max", "", "Maximum length of a string, value of an int or a port, or duration")
+	cmd.Flags().StringSlice("values", nil, "Allowed values of an enum (comma separated)")
+}
+
+// SetRuleFromFlags sets the type and the constraints given with the flags
+// added by AddRuleFlags to m. Constraints not given are left unchanged.
+func SetRuleFromFlags(m RuleMutation, cmd *cobra.Command) {
+	if cmd.Flags().Changed("type") {
+		t, _ := cmd.Flags().GetString("type")
+		m.SetType(t)
+		if t != string(vartype.Enum) && !cmd.Flags().Changed("values") {
+			m.ClearEnumValues()
+		}
+	}
+
+	if cmd.Flags().Changed("pattern") {
+		pattern, _ := cmd.Flags().GetString("pattern")
+		if pattern != "" {
+			m.SetPattern(pattern)
+		} else {
+			m.ClearPattern()
+		}
+	}
+
+	if cmd.Flags().Changed("min") {
+		min, _ := cmd.Flags().GetString("min")
+		if min != "" {
+			m.SetMin(min)
+		} else {
+			m.ClearMin()
+		}
+	}
+
+	if cmd.Flags().Changed("max") {
+		max, _ := cmd.Flags().GetString("max")
+		if max != "" {
+			m.SetMax(max)
+		} else {
+			m.ClearMax()
+		}
+	}
+
+	if cmd.Flags().Changed("values") {
+		values, _ := cmd.Flags().GetStringSlice("values")
+		if len(values) > 0 {
+			m.SetEnumValues(values)
+		} else {
+			m.ClearEnumValues()
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package util
+
+// SecretMask is displayed in place of the values of secret variables.
+const SecretMask = "********"
+
+// MaskSecret returns value, or SecretMask if mask is true, typically when the
+// variable is secret and its values are not requested to be shown.
+func MaskSecret(value string, mask bool) string {
+	if mask {
+		return SecretMask
+	}
+	return value
+}
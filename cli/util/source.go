@@ -0,0 +1,50 @@
+package util
+
+import (
+	"os"
+
+	"github.com/kechako/envoke/ent"
+)
+
+// Source is the layer from which the value of a variable is resolved.
+type Source int
+
+const (
+	SourceNone Source = iota
+	SourceEnvironment
+	SourceGlobal
+	SourceOS
+	// SourceOverride is a value given for a single run (e.g. run --set).
+	SourceOverride
+)
+
+func (s Source) String() string {
+	switch s {
+	case SourceEnvironment:
+		return "environment"
+	case SourceGlobal:
+		return "global"
+	case SourceOS:
+		return "os"
+	case SourceOverride:
+		return "override"
+	default:
+		return "none"
+	}
+}
+
+// LookupVariable looks up a variable in the same order as ExpandVariable:
+// the environment, the global environment and the OS environment.
+// The returned variable is nil when the value comes from the OS environment.
+func LookupVariable(name string, globalEnvMap, envMap map[string]*ent.Variable) (*ent.Variable, string, Source) {
+	if v, ok := envMap[name]; ok {
+		return v, v.Value, SourceEnvironment
+	}
+	if v, ok := globalEnvMap[name]; ok {
+		return v, v.Value, SourceGlobal
+	}
+	if value, ok := os.LookupEnv(name); ok {
+		return nil, value, SourceOS
+	}
+	return nil, "", SourceNone
+}
@@ -8,11 +8,16 @@ import (
 	"fmt"
 	"iter"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/config"
 	"github.com/kechako/envoke/ent"
 	envpred "github.com/kechako/envoke/ent/environment"
+	schemapred "github.com/kechako/envoke/ent/environmentschema"
 	varpred "github.com/kechako/envoke/ent/variable"
+	"github.com/kechako/envoke/project"
 	"github.com/spf13/cobra"
 )
 
@@ -27,18 +32,25 @@ func LoadGlobalEnvironment(ctx context.Context) (*ent.Environment, error) {
 	return env, nil
 }
 
+// LoadEnvironment loads the environment specified with the -e flag, or the
+// default environment of the configuration.
 func LoadEnvironment(ctx context.Context, cmd *cobra.Command) (*ent.Environment, error) {
 	name, err := cmd.Flags().GetString("env")
 	if err != nil {
 		return nil, clierrors.Exit(errors.New("environment flag (-e) is required"), 1)
 	}
 	if name == "" {
-		return nil, clierrors.Exit(errors.New("environment name cannot be empty"), 1)
+		if cfg := config.FromContext(ctx); cfg != nil {
+			name = cfg.Environment
+		}
+	}
+	if name == "" {
+		return nil, clierrors.Exit(errors.New("environment name cannot be empty (specify -e, or set environment in "+project.FileName+")"), 1)
 	}
 
 	client := ent.FromContext(ctx)
 
-	env, err := FindEnvironment(ctx, client, name)
+	env, err := FindEnvironment(ctx, client, EnvironmentName(ctx, name))
 	if err != nil {
 		return nil, clierrors.Exit(err, 1)
 	}
@@ -46,6 +58,16 @@ func LoadEnvironment(ctx context.Context, cmd *cobra.Command) (*ent.Environment,
 	return env, nil
 }
 
+// EnvironmentName returns the name of the environment given on the command
+// line, with the environment name prefix of the configuration prepended.
+func EnvironmentName(ctx context.Context, name string) string {
+	cfg := config.FromContext(ctx)
+	if cfg == nil {
+		return name
+	}
+	return cfg.EnvironmentName(name)
+}
+
 func MakeVariableMap(vars []*ent.Variable) map[string]*ent.Variable {
 	var envMap = map[string]*ent.Variable{}
 	for _, v := range vars {
@@ -79,50 +101,151 @@ func MergeVariables(globalVars, vars []*ent.Variable) iter.Seq2[int, *ent.Variab
 	}
 }
 
-func ExpandVariable(value string, globalEnvMap, envMap map[string]*ent.Variable, errorUndefined bool) (string, error) {
-	var errs []error
-	value = os.Expand(value, func(name string) string {
-		if v, ok := envMap[name]; ok {
-			if v.Expand {
-				v, err := ExpandVariable(v.Value, globalEnvMap, envMap, errorUndefined)
-				if err != nil {
-					errs = append(errs, err)
-					return ""
-				}
-				return v
-			}
+// ExpandVariable expands the references to variables in value. A reference
+// is looked up in the environment, the global environment and the OS
+// environment, in this order. A reference of the form ${ENV:NAME} or
+// ${env:ENV/NAME} is looked up in the environment ENV and the global
+// environment, loaded with the transaction of ctx if any, or the client of
+// ctx.
+//
+// Undefined references are replaced with an empty string, and are errors if
+// errorUndefined is true. Reference cycles are always errors.
+func ExpandVariable(ctx context.Context, value string, globalEnvMap, envMap map[string]*ent.Variable, errorUndefined bool) (string, error) {
+	return ExpandVariableWith(ctx, value, globalEnvMap, envMap, os.LookupEnv, errorUndefined)
+}
 
-			return v.Value
+// ExpandVariableWith is like ExpandVariable, but looks up variables that are
+// not defined in the environments with lookupEnv instead of os.LookupEnv.
+func ExpandVariableWith(ctx context.Context, value string, globalEnvMap, envMap map[string]*ent.Variable, lookupEnv func(string) (string, bool), errorUndefined bool) (string, error) {
+	e := &expander{
+		ctx:          ctx,
+		globalEnvMap: globalEnvMap,
+		lookupEnv:    lookupEnv,
+		envMaps:      map[string]map[string]*ent.Variable{},
+	}
+	value = e.expand(value, "", envMap)
+	if len(e.cycles) > 0 {
+		return "", errors.Join(e.cycles...)
+	}
+	if len(e.errs) > 0 && errorUndefined {
+		if len(e.errs) == 1 {
+			return "", e.errs[0]
 		}
+		return "", errors.Join(e.errs...)
+	}
 
-		if v, ok := globalEnvMap[name]; ok {
-			if v.Expand {
-				v, err := ExpandVariable(v.Value, globalEnvMap, envMap, errorUndefined)
-				if err != nil {
-					errs = append(errs, err)
-					return ""
-				}
-				return v
+	return value, nil
+}
+
+// expander expands references to variables, keeping track of the variables
+// being expanded to detect reference cycles.
+type expander struct {
+	ctx          context.Context
+	globalEnvMap map[string]*ent.Variable
+	lookupEnv    func(string) (string, bool)
+	// envMaps caches the variables of the referenced environments.
+	envMaps map[string]map[string]*ent.Variable
+	// stack is the list of the references being expanded.
+	stack  []string
+	errs   []error
+	cycles []error
+}
+
+// expand expands value in the environment envName, whose variables are
+// envMap. envName is empty for the environment of the command.
+func (e *expander) expand(value, envName string, envMap map[string]*ent.Variable) string {
+	return os.Expand(value, func(name string) string {
+		refEnv, refName := ParseReference(name)
+		if refEnv != "" {
+			m, err := e.loadEnvironment(refEnv)
+			if err != nil {
+				e.errs = append(e.errs, err)
+				return ""
 			}
+			if v, ok := m[refName]; ok {
+				return e.value(v, refEnv, m)
+			}
+			if v, ok := e.globalEnvMap[refName]; ok {
+				return e.value(v, refEnv, m)
+			}
+			e.errs = append(e.errs, fmt.Errorf("undefined variable '%s' in environment '%s'", refName, refEnv))
+			return ""
+		}
 
-			return v.Value
+		if v, ok := envMap[name]; ok {
+			return e.value(v, envName, envMap)
+		}
+		if v, ok := e.globalEnvMap[name]; ok {
+			return e.value(v, envName, envMap)
+		}
+		if envName == "" {
+			if v, ok := e.lookupEnv(name); ok {
+				return v
+			}
 		}
 
-		v, ok := os.LookupEnv(name)
-		if !ok {
-			errs = append(errs, fmt.Errorf("undefined variable '%s'", name))
-			return ""
+		if envName != "" {
+			e.errs = append(e.errs, fmt.Errorf("undefined variable '%s' in environment '%s'", name, envName))
+		} else {
+			e.errs = append(e.errs, fmt.Errorf("undefined variable '%s'", name))
 		}
-		return v
+		return ""
 	})
-	if len(errs) > 0 && errorUndefined {
-		if len(errs) == 1 {
-			return "", errs[0]
-		}
-		return "", errors.Join(errs...)
+}
+
+// value returns the value of v, expanded in the environment envName if v has
+// the expand flag.
+func (e *expander) value(v *ent.Variable, envName string, envMap map[string]*ent.Variable) string {
+	if !v.Expand {
+		return v.Value
 	}
 
-	return value, nil
+	ref := v.Name
+	if envName != "" {
+		ref = envName + ":" + v.Name
+	}
+	if i := slices.Index(e.stack, ref); i >= 0 {
+		e.cycles = append(e.cycles, fmt.Errorf("reference cycle: %s", strings.Join(append(slices.Clone(e.stack[i:]), ref), " -> ")))
+		return ""
+	}
+
+	e.stack = append(e.stack, ref)
+	value := e.expand(v.Value, envName, envMap)
+	e.stack = e.stack[:len(e.stack)-1]
+
+	return value
+}
+
+// loadEnvironment returns the variables of the environment name.
+func (e *expander) loadEnvironment(name string) (map[string]*ent.Variable, error) {
+	if m, ok := e.envMaps[name]; ok {
+		return m, nil
+	}
+
+	// Expansion in a transaction must read through it, since reads outside
+	// of it may block on the lock held by the transaction.
+	client := ent.FromContext(e.ctx)
+	if tx := ent.TxFromContext(e.ctx); tx != nil {
+		client = tx.Client()
+	}
+	if client == nil {
+		return nil, fmt.Errorf("cannot resolve references to environment '%s'", name)
+	}
+	env, err := FindEnvironment(e.ctx, client, name)
+	if err != nil {
+		return nil, err
+	}
+	vars, err := env.QueryVariables().All(e.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query variables of environment '%s': %w", name, err)
+	}
+
+	m := MakeVariableMap(vars)
+	if name == "global" {
+		m = e.globalEnvMap
+	}
+	e.envMaps[name] = m
+	return m, nil
 }
 
 func FindEnvironment(ctx context.Context, client *ent.Client, name string) (*ent.Environment, error) {
@@ -138,6 +261,19 @@ func FindEnvironment(ctx context.Context, client *ent.Client, name string) (*ent
 	return environment, nil
 }
 
+func FindSchema(ctx context.Context, client *ent.Client, name string) (*ent.EnvironmentSchema, error) {
+	schema, err := client.EnvironmentSchema.Query().
+		Where(schemapred.Name(name)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("schema '%s' not found", name)
+		}
+		return nil, err
+	}
+	return schema, nil
+}
+
 func FindVariable(ctx context.Context, client *ent.Client, environmentID int, name string) (*ent.Variable, error) {
 	variable, err := client.Variable.Query().
 		Where(
@@ -0,0 +1,143 @@
+package util
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kechako/envoke/ent"
+	_ "github.com/kechako/envoke/ent/runtime"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func noEnv(string) (string, bool) { return "", false }
+
+func TestExpandVariable(t *testing.T) {
+	globalEnvMap := MakeVariableMap([]*ent.Variable{
+		{Name: "DOMAIN", Value: "example.com"},
+		{Name: "URL", Value: "https://${HOST}", Expand: true},
+	})
+	envMap := MakeVariableMap([]*ent.Variable{
+		{Name: "HOST", Value: "api.${DOMAIN}", Expand: true},
+		{Name: "RAW", Value: "${HOST}"},
+		{Name: "A", Value: "${B}", Expand: true},
+		{Name: "B", Value: "${A}", Expand: true},
+		{Name: "SELF", Value: "x${SELF}", Expand: true},
+	})
+
+	tests := []struct {
+		name           string
+		value          string
+		errorUndefined bool
+		want           string
+		wantErr        string
+	}{
+		{name: "nested", value: "${URL}/v1", want: "https://api.example.com/v1"},
+		{name: "not expanded", value: "${RAW}", want: "${HOST}"},
+		{name: "undefined", value: "[${MISSING}]", want: "[]"},
+		{name: "undefined error", value: "${MISSING}", errorUndefined: true, wantErr: "undefined variable 'MISSING'"},
+		{name: "cycle", value: "${A}", wantErr: "reference cycle: A -> B -> A"},
+		{name: "self cycle", value: "${SELF}", wantErr: "reference cycle: SELF -> SELF"},
+		{name: "shell default is not a reference", value: "[${HOST:-localhost}]", want: "[]"},
+		{name: "cross environment without client", value: "${dev:HOST}", errorUndefined: true, wantErr: "cannot resolve references to environment 'dev'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExpandVariableWith(context.Background(), tt.value, globalEnvMap, envMap, noEnv, tt.errorUndefined)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ExpandVariableWith(%q): error = %v, want %q", tt.value, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ExpandVariableWith(%q): unexpected error: %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Errorf("ExpandVariableWith(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandVariableCrossEnvironment(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := ent.Open("sqlite3", BuildDataSourceName(filepath.Join(t.TempDir(), "envoke.db")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	if err := MigrateDatabase(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+
+	vars := map[string][]*ent.Variable{
+		"global": {
+			{Name: "DOMAIN", Value: "example.com"},
+		},
+		"dev": {
+			{Name: "HOST", Value: "dev.${DOMAIN}", Expand: true},
+			{Name: "LOOP", Value: "${prod:LOOP}", Expand: true},
+		},
+		"prod": {
+			{Name: "URL", Value: "https://${dev:HOST}", Expand: true},
+			{Name: "LOOP", Value: "${dev:LOOP}", Expand: true},
+		},
+	}
+	envMaps := map[string]map[string]*ent.Variable{}
+	for name, vs := range vars {
+		env, err := client.Environment.Create().SetName(name).Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, v := range vs {
+			_, err := client.Variable.Create().
+				SetEnvironment(env).
+				SetName(v.Name).
+				SetValue(v.Value).
+				SetExpand(v.Expand).
+				Save(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		envMaps[name] = MakeVariableMap(vs)
+	}
+
+	ctx = ent.NewContext(ctx, client)
+
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr string
+	}{
+		{name: "reference", value: "${prod:URL}", want: "https://dev.example.com"},
+		{name: "env form", value: "${env:dev/HOST}", want: "dev.example.com"},
+		{name: "global through environment", value: "${dev:DOMAIN}", want: "example.com"},
+		{name: "undefined", value: "${dev:MISSING}", wantErr: "undefined variable 'MISSING' in environment 'dev'"},
+		{name: "unknown environment", value: "${qa:HOST}", wantErr: "qa"},
+		{name: "cycle", value: "${LOOP}", wantErr: "reference cycle: prod:LOOP -> dev:LOOP -> prod:LOOP"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExpandVariableWith(ctx, tt.value, envMaps["global"], envMaps["dev"], noEnv, true)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ExpandVariableWith(%q): error = %v, want %q", tt.value, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ExpandVariableWith(%q): unexpected error: %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Errorf("ExpandVariableWith(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
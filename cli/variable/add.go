@@ -8,6 +8,8 @@ import (
 	"github.com/kechako/envoke/cli/clierrors"
 	"github.com/kechako/envoke/cli/util"
 	"github.com/kechako/envoke/ent"
+	varpred "github.com/kechako/envoke/ent/variable"
+	"github.com/kechako/envoke/varname"
 	"github.com/spf13/cobra"
 )
 
@@ -19,18 +21,33 @@ func addCommand() *cobra.Command {
 		Long: `Add a new environment variable to the specified environment.
 
 If no value is provided, an empty string will be used as the value.
-Use --expand flag to enable variable expansion with ${VAR} syntax.`,
+Use --expand flag to enable variable expansion with ${VAR} syntax.
+
+Use --type to give the variable a type (string, int, bool, url, port, duration,
+email, json or enum), and --pattern, --min, --max and --values to constrain
+its value further. --pattern is a regular expression that must match the whole
+value. --min and --max bound the length of a string, the value of an int or a
+port, and a duration (e.g. 30s). --values lists the allowed values of an enum.
+Values are validated when they are written, and expanded values before run.`,
 		Example: `  # Add a simple variable
   envoke var add -e development DATABASE_URL "postgres://localhost/myapp_dev"
 
   # Add a variable with comment
   envoke var add -e development API_KEY "dev-key-123" --comment "Development API key"
 
+  # Add a secret variable
+  envoke var add -e development API_SECRET "s3cr3t" --secret
+
   # Add an expandable variable
   envoke var add -e development API_URL '${BASE_URL}/api/v1' --expand
 
   # Add or update a variable
-  envoke var add -e development DEBUG "true" --update`,
+  envoke var add -e development DEBUG "true" --update
+
+  # Add a typed variable, validated when it is written and before run
+  envoke var add -e development PORT 8080 --type port --min 1024
+  envoke var add -e development API_URL "https://api.example.com" --type url --pattern 'https://.*'
+  envoke var add -e development LOG_LEVEL info --type enum --values debug,info,warn,error`,
 		Args: func(cmd *cobra.Command, args []string) error {
 			if err := cobra.RangeArgs(1, 2)(cmd, args); err != nil {
 				return err
@@ -46,6 +63,9 @@ Use --expand flag to enable variable expansion with ${VAR} syntax.`,
 			ctx := cmd.Context()
 
 			name := args[0]
+			if err := varname.Validate(name); err != nil {
+				return clierrors.Exit(err, 1)
+			}
 
 			update, _ := cmd.Flags().GetBool("update")
 
@@ -56,21 +76,36 @@ Use --expand flag to enable variable expansion with ${VAR} syntax.`,
 
 			client := ent.FromContext(ctx)
 
-			create := client.Variable.Create().
-				SetEnvironment(env).
-				SetName(name)
-			setVariableMutation(create.Mutation(), cmd, args)
-
-			var executor interface {
-				Exec(context.Context) error
-			} = create
-			if update {
-				executor = create.
-					OnConflict().
-					UpdateNewValues()
-			}
+			err = client.DoTransaction(ctx, nil, func(ctx context.Context, tx *ent.Tx) error {
+				if update {
+					v, err := tx.Variable.Query().
+						Where(
+							varpred.EnvironmentID(env.ID),
+							varpred.Name(name),
+						).
+						Only(ctx)
+					if err == nil {
+						// Update the existing variable, so that it is validated
+						// against its current type.
+						m := tx.Variable.UpdateOne(v)
+						setVariableMutation(m.Mutation(), cmd, args)
+						if len(args) < 2 {
+							m.SetValue("")
+						}
+						return m.Exec(ctx)
+					}
+					if !ent.IsNotFound(err) {
+						return err
+					}
+				}
 
-			err = executor.Exec(ctx)
+				create := tx.Variable.Create().
+					SetEnvironment(env).
+					SetName(name)
+				setVariableMutation(create.Mutation(), cmd, args)
+
+				return create.Exec(ctx)
+			})
 			if err != nil {
 				if ent.IsConstraintError(err) {
 					return clierrors.Exit(fmt.Errorf("variable '%s' already exists in environment '%s' (use --update to modify)", name, env.Name), 1)
@@ -86,7 +121,9 @@ Use --expand flag to enable variable expansion with ${VAR} syntax.`,
 
 	cmd.Flags().String("comment", "", "Comment for the variable")
 	cmd.Flags().Bool("expand", false, "Expand the variable (default: false)")
+	cmd.Flags().Bool("secret", false, "Mark the variable as secret (default: false)")
 	cmd.Flags().Bool("update", false, "Update the variable if it already exists (default: false)")
+	util.AddRuleFlags(cmd)
 
 	return cmd
 }
@@ -117,4 +154,17 @@ func setVariableMutation(m *ent.VariableMutation, cmd *cobra.Command, args []str
 			m.ClearExpand()
 		}
 	}
+
+	// The secret mark is kept unless --secret is given, so that updating the
+	// value of a secret does not reveal it.
+	secret, err := cmd.Flags().GetBool("secret")
+	if err == nil && cmd.Flags().Changed("secret") {
+		if secret {
+			m.SetSecret(true)
+		} else {
+			m.ClearSecret()
+		}
+	}
+
+	util.SetRuleFromFlags(m, cmd)
 }
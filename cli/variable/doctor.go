@@ -0,0 +1,554 @@
+package variable
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/output"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+	"github.com/kechako/envoke/varname"
+	"github.com/spf13/cobra"
+)
+
+// Severity of a finding.
+const (
+	severityWarning = "warning"
+	severityError   = "error"
+)
+
+// Checks of the doctor command.
+const (
+	checkInvalidName         = "invalid-name"
+	checkUndefinedReference  = "undefined-reference"
+	checkReferenceCycle      = "reference-cycle"
+	checkUnexpandedReference = "unexpanded-reference"
+	checkShadowedGlobal      = "shadowed-global"
+	checkWhitespace          = "whitespace"
+	checkDuplicateSecret     = "duplicate-secret"
+)
+
+var (
+	// bracedReferencePattern matches ${NAME}, ${ENV:NAME} and
+	// ${env:ENV/NAME} references.
+	bracedReferencePattern = regexp.MustCompile(`\$\{(?:[^${}:]+:)?(?:[^${}/]+/)?[A-Za-z_][A-Za-z0-9_.]*\}`)
+	// referencePattern matches $NAME references.
+	referencePattern = regexp.MustCompile(`\$([A-Za-z_][A-Za-z0-9_]*)`)
+)
+
+type finding struct {
+	Environment string `json:"environment" yaml:"environment"`
+	Variable    string `json:"variable" yaml:"variable"`
+	Severity    string `json:"severity" yaml:"severity"`
+	Check       string `json:"check" yaml:"check"`
+	Message     string `json:"message" yaml:"message"`
+}
+
+func DoctorCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: GroupID,
+		Use:     "doctor [flags] [<environment>...]",
+		Aliases: []string{"lint"},
+		Short:   "Check the variables of all environments for common problems",
+		Long: `Check the variables of all environments, or of the specified environments,
+for common problems:
+
+  invalid-name          names that do not follow the name policy (error)
+  undefined-reference   references to variables defined nowhere (error), or
+                        only in the system environment (warning), and
+                        references to other environments that do not define
+                        the variable (error)
+  reference-cycle       variables that reference themselves through other
+                        variables, including variables of other environments
+                        (error)
+  unexpanded-reference  values with references that are not expanded because
+                        the expand flag is not set, such as ${NAME}, or $NAME
+                        where NAME is defined in envoke or in the system
+                        environment (warning)
+  shadowed-global       variables with the same value as the global variable
+                        they override (warning)
+  whitespace            values with leading or trailing whitespace (warning)
+  duplicate-secret      secret values shared by environments that are not
+                        attached to the same schema (warning)
+
+The name policy is set with name_policy in the configuration file: posix
+(uppercase letters, digits and underscores), allow-lowercase (the default) or
+allow-dots (for Java-style properties). Names are validated when variables are
+written, and --fix renames existing variables that do not follow the policy,
+replacing invalid characters with underscores.
+
+By default, the command exits with status 0 whatever is found. Use --fail-on
+to exit with status 1 if a finding of the given severity or above is found,
+e.g. in a pre-commit hook.`,
+		Example: `  # Check all environments
+  envoke doctor
+
+  # Fail on errors only
+  envoke doctor --fail-on error
+
+  # Check the production environment, and fail on any finding
+  envoke doctor production --fail-on warning
+
+  # Rename variables that do not follow the name policy
+  envoke doctor --fix`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			for _, name := range args {
+				if name == "" {
+					return clierrors.Exit(errors.New("environment name cannot be empty"), 1)
+				}
+			}
+
+			failOn, _ := cmd.Flags().GetString("fail-on")
+			switch failOn {
+			case "none", severityWarning, severityError:
+			default:
+				return clierrors.Exit(fmt.Errorf("invalid severity '%s' (must be none, warning or error)", failOn), 1)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			format, err := output.FromCommand(cmd)
+			if err != nil {
+				return err
+			}
+
+			failOn, _ := cmd.Flags().GetString("fail-on")
+
+			fix, _ := cmd.Flags().GetBool("fix")
+
+			client := ent.FromContext(ctx)
+
+			envs, err := loadAllEnvironments(ctx, client)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			names := make([]string, len(args))
+			for i, name := range args {
+				names[i] = util.EnvironmentName(ctx, name)
+				if !slices.ContainsFunc(envs, func(env *ent.Environment) bool { return env.Name == names[i] }) {
+					return clierrors.Exit(fmt.Errorf("environment '%s' not found", names[i]), 1)
+				}
+			}
+
+			if fix {
+				// Renamed variables go to stderr with machine-readable
+				// formats, so that stdout only contains the findings.
+				w := io.Writer(os.Stdout)
+				if format.Kind != output.Table {
+					w = os.Stderr
+				}
+				err = fixNames(ctx, client, names, w)
+				if err != nil {
+					return clierrors.Exit(err, 1)
+				}
+
+				envs, err = loadAllEnvironments(ctx, client)
+				if err != nil {
+					return clierrors.Exit(err, 1)
+				}
+			}
+
+			findings := diagnoseEnvironments(envs)
+			if len(names) > 0 {
+				findings = slices.DeleteFunc(findings, func(f *finding) bool {
+					return !slices.Contains(names, f.Environment)
+				})
+			}
+
+			checked := len(envs)
+			if len(names) > 0 {
+				checked = len(names)
+			}
+			if len(findings) == 0 && format.Kind == output.Table {
+				fmt.Printf("No problems found in %d environments\n", checked)
+				return nil
+			}
+
+			err = output.Print(os.Stdout, format, findings, output.Columns[*finding]{
+				Headers: []string{"Environment", "Variable", "Severity", "Check", "Message"},
+				Fields:  []string{"environment", "variable", "severity", "check", "message"},
+				Row: func(f *finding) []any {
+					return []any{f.Environment, f.Variable, f.Severity, f.Check, f.Message}
+				},
+			})
+			if err != nil {
+				return clierrors.Exit(fmt.Errorf("failed to print findings: %w", err), 1)
+			}
+
+			errorCount := 0
+			for _, f := range findings {
+				if f.Severity == severityError {
+					errorCount++
+				}
+			}
+			warningCount := len(findings) - errorCount
+
+			if (failOn == severityError && errorCount > 0) || (failOn == severityWarning && len(findings) > 0) {
+				return clierrors.Exit(fmt.Errorf("%d errors and %d warnings found", errorCount, warningCount), 1)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String("fail-on", "none", "Exit with status 1 on findings of this severity or above (none, warning, error)")
+	cmd.Flags().Bool("fix", false, "Rename variables that do not follow the name policy (default: false)")
+
+	return cmd
+}
+
+// fixNames renames the variables of all environments, or of the environments
+// named names, that do not follow the name policy, and rewrites the references
+// to them. Variables whose fixed name is already taken are left as is, and
+// reported by the invalid-name check.
+func fixNames(ctx context.Context, client *ent.Client, names []string, w io.Writer) error {
+	policy := varname.CurrentPolicy()
+
+	type rename struct {
+		env     string
+		name    string
+		newName string
+	}
+	var renames []rename
+	var rewritten []*ent.Variable
+
+	err := client.DoTransaction(ctx, nil, func(ctx context.Context, tx *ent.Tx) error {
+		envs, err := loadAllEnvironments(ctx, tx.Client())
+		if err != nil {
+			return err
+		}
+
+		nodes := map[referenceNode]string{}
+		for _, env := range envs {
+			if len(names) > 0 && !slices.Contains(names, env.Name) {
+				continue
+			}
+
+			taken := map[string]bool{}
+			for _, v := range env.Edges.Variables {
+				taken[v.Name] = true
+			}
+			for _, v := range env.Edges.Variables {
+				if policy.Validate(v.Name) == nil {
+					continue
+				}
+				newName := policy.Fix(v.Name)
+				if taken[newName] {
+					fmt.Fprintf(w, "Variable '%s' in environment '%s' cannot be renamed to '%s' (already exists)\n", v.Name, env.Name, newName)
+					continue
+				}
+				taken[newName] = true
+				nodes[referenceNode{env: env.Name, name: v.Name}] = newName
+				renames = append(renames, rename{env.Name, v.Name, newName})
+			}
+		}
+
+		rewritten, err = renameVariables(ctx, tx.Client(), envs, nodes)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range renames {
+		fmt.Fprintf(w, "Variable '%s' renamed to '%s' in environment '%s' successfully!\n", r.name, r.newName, r.env)
+	}
+	for _, v := range rewritten {
+		fmt.Fprintf(w, "Rewrote references in variable '%s' (environment '%s')\n", v.Name, v.Edges.Environment.Name)
+	}
+
+	return nil
+}
+
+// diagnoseEnvironments checks the variables of envs, loaded with their
+// variables, and returns the findings sorted by environment and variable.
+func diagnoseEnvironments(envs []*ent.Environment) []*finding {
+	var globalEnv *ent.Environment
+	for _, env := range envs {
+		if env.Name == "global" {
+			globalEnv = env
+		}
+	}
+	var globalVars []*ent.Variable
+	if globalEnv != nil {
+		globalVars = globalEnv.Edges.Variables
+	}
+	globalEnvMap := util.MakeVariableMap(globalVars)
+	policy := varname.CurrentPolicy()
+
+	envMaps := map[string]map[string]*ent.Variable{}
+	for _, env := range envs {
+		envMaps[env.Name] = util.MakeVariableMap(env.Edges.Variables)
+	}
+
+	// Variables referenced by global variables may be defined in any
+	// environment.
+	definedAnywhere := map[string]bool{}
+	for _, env := range envs {
+		for _, v := range env.Edges.Variables {
+			definedAnywhere[v.Name] = true
+		}
+	}
+
+	findings := []*finding{}
+	add := func(env *ent.Environment, v *ent.Variable, severity, check, message string) {
+		findings = append(findings, &finding{
+			Environment: env.Name,
+			Variable:    v.Name,
+			Severity:    severity,
+			Check:       check,
+			Message:     message,
+		})
+	}
+
+	for _, env := range envs {
+		isGlobal := env == globalEnv
+		envMap := envMaps[env.Name]
+		defined := func(name string) bool {
+			if isGlobal {
+				return definedAnywhere[name]
+			}
+			return envMap[name] != nil || globalEnvMap[name] != nil
+		}
+
+		for _, v := range env.Edges.Variables {
+			if err := policy.Validate(v.Name); err != nil {
+				add(env, v, severityError, checkInvalidName, err.Error())
+			}
+
+			if v.Expand {
+				for _, ref := range util.VariableReferences(v.Value) {
+					refEnv, name := util.ParseReference(ref)
+					if refEnv != "" {
+						refEnvMap, ok := envMaps[refEnv]
+						switch {
+						case !ok:
+							add(env, v, severityError, checkUndefinedReference, fmt.Sprintf("environment '%s' of '%s' is not found", refEnv, ref))
+						case refEnvMap[name] == nil && globalEnvMap[name] == nil:
+							add(env, v, severityError, checkUndefinedReference, fmt.Sprintf("'%s' is not defined in environment '%s'", name, refEnv))
+						}
+						continue
+					}
+
+					if defined(name) {
+						continue
+					}
+					if _, ok := os.LookupEnv(name); ok {
+						add(env, v, severityWarning, checkUndefinedReference, fmt.Sprintf("'%s' is resolved from the system environment", name))
+					} else {
+						add(env, v, severityError, checkUndefinedReference, fmt.Sprintf("'%s' is not defined", name))
+					}
+				}
+			} else if looksLikeReference(v.Value, func(name string) bool {
+				_, ok := os.LookupEnv(name)
+				return ok || defined(name)
+			}) {
+				add(env, v, severityWarning, checkUnexpandedReference, "value contains a reference, but expand is not set")
+			}
+
+			if !isGlobal {
+				if g, ok := globalEnvMap[v.Name]; ok && g.Value == v.Value && g.Expand == v.Expand {
+					add(env, v, severityWarning, checkShadowedGlobal, "same value as the global variable it overrides")
+				}
+			}
+
+			if strings.TrimSpace(v.Value) != v.Value {
+				add(env, v, severityWarning, checkWhitespace, "value has leading or trailing whitespace")
+			}
+		}
+	}
+
+	for _, cycle := range referenceCycles(envs, globalVars) {
+		// A cycle is reported from the first variable defined in the
+		// environment it is expanded in, so that cycles of global variables
+		// are only reported in the global environment.
+		i := slices.IndexFunc(cycle, func(n referenceNode) bool { return envMaps[n.env][n.name] != nil })
+		if i < 0 {
+			continue
+		}
+		cycle = append(cycle[i:], cycle[:i]...)
+
+		env := cycle[0].env
+		refs := make([]string, len(cycle)+1)
+		for j, n := range append(cycle, cycle[0]) {
+			if n.env == env {
+				refs[j] = n.name
+			} else {
+				refs[j] = util.FormatReference(n.env, n.name)
+			}
+		}
+		findings = append(findings, &finding{
+			Environment: env,
+			Variable:    cycle[0].name,
+			Severity:    severityError,
+			Check:       checkReferenceCycle,
+			Message:     "reference cycle: " + strings.Join(refs, " -> "),
+		})
+	}
+
+	findings = append(findings, duplicateSecrets(envs, globalEnv)...)
+
+	slices.SortStableFunc(findings, func(a, b *finding) int {
+		if a.Environment != b.Environment {
+			if a.Environment == "global" {
+				return -1
+			}
+			if b.Environment == "global" {
+				return 1
+			}
+			return strings.Compare(a.Environment, b.Environment)
+		}
+		return strings.Compare(a.Variable, b.Variable)
+	})
+
+	return findings
+}
+
+// looksLikeReference reports whether value contains ${NAME}, or $NAME where
+// NAME is defined, in envoke or in the system environment such as $HOME.
+func looksLikeReference(value string, defined func(string) bool) bool {
+	if bracedReferencePattern.MatchString(value) {
+		return true
+	}
+	for _, m := range referencePattern.FindAllStringSubmatch(value, -1) {
+		if defined(m[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// referenceNode is a variable expanded in an environment. Global variables
+// are expanded in the environment that references them.
+type referenceNode struct {
+	env  string
+	name string
+}
+
+// referenceCycles returns the cycles of references between the variables to
+// be expanded in envs, loaded with their variables, including references to
+// other environments. Each cycle is returned once.
+func referenceCycles(envs []*ent.Environment, globalVars []*ent.Variable) [][]referenceNode {
+	globalEnvMap := util.MakeVariableMap(globalVars)
+	envMaps := map[string]map[string]*ent.Variable{}
+	for _, env := range envs {
+		envMaps[env.Name] = util.MakeVariableMap(env.Edges.Variables)
+	}
+
+	lookup := func(n referenceNode) *ent.Variable {
+		envMap, ok := envMaps[n.env]
+		if !ok {
+			return nil
+		}
+		if v, ok := envMap[n.name]; ok {
+			return v
+		}
+		return globalEnvMap[n.name]
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[referenceNode]int{}
+	var stack []referenceNode
+	var cycles [][]referenceNode
+
+	var visit func(n referenceNode)
+	visit = func(n referenceNode) {
+		v := lookup(n)
+		if v == nil || !v.Expand {
+			return
+		}
+		switch state[n] {
+		case visiting:
+			i := slices.Index(stack, n)
+			cycles = append(cycles, slices.Clone(stack[i:]))
+			return
+		case visited:
+			return
+		}
+
+		state[n] = visiting
+		stack = append(stack, n)
+		for _, ref := range util.VariableReferences(v.Value) {
+			refEnv, name := util.ParseReference(ref)
+			if refEnv == "" {
+				refEnv = n.env
+			}
+			visit(referenceNode{env: refEnv, name: name})
+		}
+		stack = stack[:len(stack)-1]
+		state[n] = visited
+	}
+
+	for _, env := range envs {
+		for _, v := range util.MergeVariables(globalVars, env.Edges.Variables) {
+			visit(referenceNode{env: env.Name, name: v.Name})
+		}
+	}
+
+	return cycles
+}
+
+// duplicateSecrets returns findings for secret values shared by environments
+// that are not attached to the same schema. The global environment is
+// related to all environments.
+func duplicateSecrets(envs []*ent.Environment, globalEnv *ent.Environment) []*finding {
+	type secret struct {
+		env *ent.Environment
+		v   *ent.Variable
+	}
+	byValue := map[string][]secret{}
+	for _, env := range envs {
+		if env == globalEnv {
+			continue
+		}
+		for _, v := range env.Edges.Variables {
+			if v.Secret && v.Value != "" {
+				byValue[v.Value] = append(byValue[v.Value], secret{env, v})
+			}
+		}
+	}
+
+	related := func(a, b *ent.Environment) bool {
+		return a.ID == b.ID || (a.SchemaID != nil && b.SchemaID != nil && *a.SchemaID == *b.SchemaID)
+	}
+
+	var findings []*finding
+	for _, secrets := range byValue {
+		for _, s := range secrets {
+			var others []string
+			for _, o := range secrets {
+				if !related(s.env, o.env) {
+					others = append(others, fmt.Sprintf("'%s' in '%s'", o.v.Name, o.env.Name))
+				}
+			}
+			if len(others) == 0 {
+				continue
+			}
+			slices.Sort(others)
+			findings = append(findings, &finding{
+				Environment: s.env.Name,
+				Variable:    s.v.Name,
+				Severity:    severityWarning,
+				Check:       checkDuplicateSecret,
+				Message:     "same secret value as " + strings.Join(others, ", "),
+			})
+		}
+	}
+
+	return findings
+}
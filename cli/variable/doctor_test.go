@@ -0,0 +1,30 @@
+package variable
+
+import "testing"
+
+func TestLooksLikeReference(t *testing.T) {
+	defined := func(name string) bool {
+		return name == "HOME" || name == "BASE_URL"
+	}
+
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{value: "plain", want: false},
+		{value: "${ANYTHING}", want: true},
+		{value: "${dev:HOST}", want: true},
+		{value: "${env:dev/HOST}", want: true},
+		{value: "$HOME/bin", want: true},
+		{value: "$BASE_URL/v1", want: true},
+		{value: "pa$$word", want: false},
+		{value: "p@ss$word", want: false},
+		{value: "costs $5", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := looksLikeReference(tt.value, defined); got != tt.want {
+			t.Errorf("looksLikeReference(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,471 @@
+package variable
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/fatih/color"
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+	"github.com/kechako/envoke/ent/variable"
+	"github.com/kechako/envoke/varname"
+	"github.com/spf13/cobra"
+)
+
+func EditCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: GroupID,
+		Use:     "edit [flags]",
+		Short:   "Edit the variables of an environment in an editor",
+		Long: `Edit the variables of an environment in an editor.
+
+The variables are rendered as a dotenv document and opened in $VISUAL or
+$EDITOR. Comment lines directly above a variable are its comment, a
+'# envoke:expand' line above a variable enables expansion, and a
+'# envoke:secret' line marks it as secret. Removing a line removes the
+variable.
+
+When the editor exits, the changes are shown and applied in one transaction,
+with the values of secret variables masked. If the document cannot be parsed,
+or the changes cannot be applied because a name or a value is invalid, the
+editor is reopened with the error at the top of the document. Emptying the
+document, or leaving it unchanged, cancels the edit without any change.`,
+		Example: `  # Edit the development environment
+  envoke edit -e development
+
+  # Edit with a specific editor
+  EDITOR=nano envoke edit -e development`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.ExactArgs(0)(cmd, args); err != nil {
+				return err
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			env, err := util.LoadEnvironment(ctx, cmd)
+			if err != nil {
+				return err
+			}
+
+			vars, err := env.QueryVariables().
+				Order(variable.ByName(sql.OrderAsc())).
+				All(ctx)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			var buf bytes.Buffer
+			err = writeEditDocument(&buf, env.Name, vars)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			client := ent.FromContext(ctx)
+
+			var changes []*editChange
+			err = editDocument(ctx, buf.Bytes(), func(edited []*editVariable) error {
+				changes = diffEditVariables(vars, edited)
+				if len(changes) == 0 {
+					return nil
+				}
+
+				printEditChanges(changes)
+
+				err := client.DoTransaction(ctx, nil, func(ctx context.Context, tx *ent.Tx) error {
+					return applyEditChanges(ctx, tx, env, changes)
+				})
+				if err != nil {
+					return fmt.Errorf("failed to update environment '%s': %w", env.Name, err)
+				}
+				return nil
+			})
+			if errors.Is(err, errEditCanceled) {
+				fmt.Println("(Edit canceled)")
+				return nil
+			}
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			if len(changes) == 0 {
+				fmt.Println("(No changes)")
+				return nil
+			}
+
+			fmt.Printf("Environment '%s' updated successfully!\n", env.Name)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("env", "e", "", "Specify the environment to edit")
+
+	return cmd
+}
+
+const (
+	editExpandMarker = "# envoke:expand"
+	editSecretMarker = "# envoke:secret"
+	editErrorPrefix  = "# envoke:error: "
+)
+
+type editVariable struct {
+	name    string
+	value   string
+	comment string
+	expand  bool
+	secret  bool
+}
+
+func writeEditDocument(w io.Writer, envName string, vars []*ent.Variable) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "# Environment: %s\n", envName)
+	fmt.Fprintln(bw, "#")
+	fmt.Fprintln(bw, "# Comment lines directly above a variable are its comment.")
+	fmt.Fprintf(bw, "# Add '%s' above a variable to enable expansion.\n", editExpandMarker)
+	fmt.Fprintf(bw, "# Add '%s' above a variable to mark it as secret.\n", editSecretMarker)
+	fmt.Fprintln(bw, "# Remove a line to remove the variable.")
+
+	for _, v := range vars {
+		bw.WriteByte('\n')
+		if v.Comment != "" {
+			for line := range strings.SplitSeq(v.Comment, "\n") {
+				fmt.Fprintf(bw, "# %s\n", line)
+			}
+		}
+		if v.Expand {
+			fmt.Fprintln(bw, editExpandMarker)
+		}
+		if v.Secret {
+			fmt.Fprintln(bw, editSecretMarker)
+		}
+		fmt.Fprintf(bw, "%s=%s\n", v.Name, quoteEditValue(v.Value))
+	}
+
+	return bw.Flush()
+}
+
+func quoteEditValue(value string) string {
+	if value == "" {
+		return value
+	}
+	if strings.TrimSpace(value) != value ||
+		strings.ContainsAny(value, "\"'#\n\r\t") {
+		return strconv.Quote(value)
+	}
+	return value
+}
+
+func parseEditDocument(data []byte) ([]*editVariable, error) {
+	var vars []*editVariable
+	seen := map[string]int{}
+
+	var comments []string
+	var expand, secret bool
+
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; s.Scan(); lineNo++ {
+		line := strings.TrimRight(s.Text(), "\r")
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			comments = nil
+			expand = false
+			secret = false
+		case strings.HasPrefix(trimmed, editErrorPrefix):
+			// Ignore errors inserted by a previous attempt.
+		case trimmed == editExpandMarker:
+			expand = true
+		case trimmed == editSecretMarker:
+			secret = true
+		case strings.HasPrefix(trimmed, "#"):
+			comment := strings.TrimPrefix(trimmed, "#")
+			comments = append(comments, strings.TrimPrefix(comment, " "))
+		default:
+			name, value, ok := strings.Cut(line, "=")
+			if !ok {
+				return nil, fmt.Errorf("line %d: expected NAME=VALUE", lineNo)
+			}
+			name = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(name), "export "))
+			if err := varname.Validate(name); err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			if prev, exists := seen[name]; exists {
+				return nil, fmt.Errorf("line %d: variable '%s' is already defined on line %d", lineNo, name, prev)
+			}
+			seen[name] = lineNo
+
+			if strings.HasPrefix(value, `"`) {
+				unquoted, err := strconv.Unquote(strings.TrimSpace(value))
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid quoted value for '%s'", lineNo, name)
+				}
+				value = unquoted
+			}
+
+			vars = append(vars, &editVariable{
+				name:    name,
+				value:   value,
+				comment: strings.Join(comments, "\n"),
+				expand:  expand,
+				secret:  secret,
+			})
+
+			comments = nil
+			expand = false
+			secret = false
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return vars, nil
+}
+
+// errEditCanceled is returned by editDocument if the document is emptied or
+// left unchanged.
+var errEditCanceled = errors.New("edit canceled")
+
+// editDocument opens the document in the editor until it can be parsed and
+// applied with apply, or the edit is canceled. The editor is reopened with the
+// error at the top of the document if parsing or applying fails.
+func editDocument(ctx context.Context, doc []byte, apply func([]*editVariable) error) error {
+	file, err := os.CreateTemp("", "envoke-*.env")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	name := file.Name()
+	defer os.Remove(name)
+	file.Close()
+
+	for {
+		err := os.WriteFile(name, doc, 0600)
+		if err != nil {
+			return fmt.Errorf("failed to write temporary file: %w", err)
+		}
+
+		err = runEditor(ctx, name)
+		if err != nil {
+			return err
+		}
+
+		edited, err := os.ReadFile(name)
+		if err != nil {
+			return fmt.Errorf("failed to read temporary file: %w", err)
+		}
+
+		if len(bytes.TrimSpace(edited)) == 0 || bytes.Equal(edited, doc) {
+			return errEditCanceled
+		}
+
+		vars, err := parseEditDocument(edited)
+		if err == nil {
+			err = apply(vars)
+			if err == nil {
+				return nil
+			}
+		}
+
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		fmt.Fprintln(os.Stderr, "Reopening the editor (empty the document or leave it unchanged to cancel)...")
+
+		doc = insertEditError(edited, err)
+	}
+}
+
+// insertEditError replaces the errors at the top of the document by err.
+func insertEditError(doc []byte, err error) []byte {
+	var buf bytes.Buffer
+	for line := range strings.SplitSeq(err.Error(), "\n") {
+		buf.WriteString(editErrorPrefix)
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+	}
+
+	for len(doc) > 0 {
+		line, rest, _ := bytes.Cut(doc, []byte("\n"))
+		if !bytes.HasPrefix(line, []byte(editErrorPrefix)) {
+			break
+		}
+		doc = rest
+	}
+	buf.Write(doc)
+
+	return buf.Bytes()
+}
+
+func runEditor(ctx context.Context, name string) error {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		if runtime.GOOS == "windows" {
+			editor = "notepad"
+		} else {
+			editor = "vi"
+		}
+	}
+
+	fields := strings.Fields(editor)
+	if len(fields) == 0 {
+		return errors.New("no editor is configured (set $VISUAL or $EDITOR)")
+	}
+
+	command := exec.CommandContext(ctx, fields[0], append(fields[1:], name)...)
+	command.Stdin = os.Stdin
+	command.Stdout = os.Stdout
+	command.Stderr = os.Stderr
+
+	err := command.Run()
+	if err != nil {
+		return fmt.Errorf("editor '%s' failed: %w", editor, err)
+	}
+
+	return nil
+}
+
+type editChangeKind int
+
+const (
+	editAdded editChangeKind = iota
+	editRemoved
+	editModified
+)
+
+type editChange struct {
+	kind editChangeKind
+	old  *ent.Variable
+	new  *editVariable
+}
+
+func diffEditVariables(vars []*ent.Variable, edited []*editVariable) []*editChange {
+	envMap := util.MakeVariableMap(vars)
+	seen := map[string]bool{}
+
+	var changes []*editChange
+	for _, e := range edited {
+		seen[e.name] = true
+
+		v, exists := envMap[e.name]
+		if !exists {
+			changes = append(changes, &editChange{kind: editAdded, new: e})
+			continue
+		}
+		if v.Value != e.value || v.Expand != e.expand || v.Secret != e.secret || v.Comment != e.comment {
+			changes = append(changes, &editChange{kind: editModified, old: v, new: e})
+		}
+	}
+
+	for _, v := range vars {
+		if !seen[v.Name] {
+			changes = append(changes, &editChange{kind: editRemoved, old: v})
+		}
+	}
+
+	return changes
+}
+
+func printEditChanges(changes []*editChange) {
+	added := color.New(color.FgGreen).SprintfFunc()
+	removed := color.New(color.FgRed).SprintfFunc()
+	modified := color.New(color.FgYellow).SprintfFunc()
+
+	for _, c := range changes {
+		switch c.kind {
+		case editAdded:
+			fmt.Println(added("+ %s=%s", c.new.name, util.MaskSecret(c.new.value, c.new.secret)))
+		case editRemoved:
+			fmt.Println(removed("- %s=%s", c.old.Name, util.MaskSecret(c.old.Value, c.old.Secret)))
+		case editModified:
+			fmt.Println(modified("~ %s", c.old.Name))
+			if c.old.Value != c.new.value {
+				secret := c.old.Secret || c.new.secret
+				fmt.Println(removed("    - %s", util.MaskSecret(c.old.Value, secret)))
+				fmt.Println(added("    + %s", util.MaskSecret(c.new.value, secret)))
+			}
+			if c.old.Expand != c.new.expand {
+				fmt.Printf("    expand: %v -> %v\n", c.old.Expand, c.new.expand)
+			}
+			if c.old.Secret != c.new.secret {
+				fmt.Printf("    secret: %v -> %v\n", c.old.Secret, c.new.secret)
+			}
+			if c.old.Comment != c.new.comment {
+				fmt.Printf("    comment: %q -> %q\n", c.old.Comment, c.new.comment)
+			}
+		}
+	}
+}
+
+func applyEditChanges(ctx context.Context, tx *ent.Tx, env *ent.Environment, changes []*editChange) error {
+	for _, c := range changes {
+		switch c.kind {
+		case editAdded:
+			create := tx.Variable.Create().
+				SetEnvironment(env).
+				SetName(c.new.name).
+				SetValue(c.new.value)
+			if c.new.expand {
+				create.SetExpand(true)
+			}
+			if c.new.secret {
+				create.SetSecret(true)
+			}
+			if c.new.comment != "" {
+				create.SetComment(c.new.comment)
+			}
+
+			err := create.Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create variable '%s': %w", c.new.name, err)
+			}
+		case editRemoved:
+			err := tx.Variable.DeleteOne(c.old).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to remove variable '%s': %w", c.old.Name, err)
+			}
+		case editModified:
+			update := tx.Variable.UpdateOne(c.old).
+				SetValue(c.new.value)
+			if c.new.expand {
+				update.SetExpand(true)
+			} else {
+				update.ClearExpand()
+			}
+			if c.new.secret {
+				update.SetSecret(true)
+			} else {
+				update.ClearSecret()
+			}
+			if c.new.comment != "" {
+				update.SetComment(c.new.comment)
+			} else {
+				update.ClearComment()
+			}
+
+			err := update.Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to update variable '%s': %w", c.old.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package variable
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kechako/envoke/ent"
+)
+
+func TestEditDocumentRoundTrip(t *testing.T) {
+	vars := []*ent.Variable{
+		{Name: "PLAIN", Value: "value"},
+		{Name: "EMPTY", Value: ""},
+		{Name: "URL", Value: "https://${HOST}", Comment: "Built from HOST", Expand: true},
+		{Name: "TOKEN", Value: "s3cr3t", Comment: "First line\nSecond line", Secret: true},
+		{Name: "BOTH", Value: "${TOKEN}", Expand: true, Secret: true},
+		{Name: "QUOTED", Value: " spaces # and \"quotes\"\n"},
+		{Name: "COMMENT_LIKE", Value: "# envoke:expand"},
+	}
+
+	var buf bytes.Buffer
+	if err := writeEditDocument(&buf, "dev", vars); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := parseEditDocument(buf.Bytes())
+	if err != nil {
+		t.Fatalf("parseEditDocument(): unexpected error: %v\n%s", err, buf.String())
+	}
+	if len(got) != len(vars) {
+		t.Fatalf("parseEditDocument() returned %d variables, want %d\n%s", len(got), len(vars), buf.String())
+	}
+	for i, v := range vars {
+		want := editVariable{name: v.Name, value: v.Value, comment: v.Comment, expand: v.Expand, secret: v.Secret}
+		if *got[i] != want {
+			t.Errorf("parseEditDocument()[%d] = %+v, want %+v", i, *got[i], want)
+		}
+	}
+}
+
+func TestParseEditDocument(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     string
+		want    []editVariable
+		wantErr string
+	}{
+		{
+			name: "markers apply to the next variable only",
+			doc:  "# envoke:secret\n# envoke:expand\nA=1\nB=2\n",
+			want: []editVariable{
+				{name: "A", value: "1", expand: true, secret: true},
+				{name: "B", value: "2"},
+			},
+		},
+		{
+			name: "blank line resets comment and markers",
+			doc:  "# Comment\n# envoke:secret\n\nA=1\n",
+			want: []editVariable{{name: "A", value: "1"}},
+		},
+		{
+			name: "error lines are ignored",
+			doc:  "# envoke:error: line 2: expected NAME=VALUE\n# Comment\nA=1\n",
+			want: []editVariable{{name: "A", value: "1", comment: "Comment"}},
+		},
+		{
+			name: "export prefix and crlf",
+			doc:  "export A=1\r\nB=2\r\n",
+			want: []editVariable{{name: "A", value: "1"}, {name: "B", value: "2"}},
+		},
+		{name: "missing separator", doc: "A=1\nB\n", wantErr: "line 2: expected NAME=VALUE"},
+		{name: "empty name", doc: "=1\n", wantErr: "line 1: variable name cannot be empty"},
+		{name: "invalid name", doc: "A=1\nMY-VAR=2\n", wantErr: "line 2: invalid variable name 'MY-VAR'"},
+		{name: "duplicate", doc: "A=1\n\nA=2\n", wantErr: "line 3: variable 'A' is already defined on line 1"},
+		{name: "invalid quoted value", doc: "A=\"1\n", wantErr: "line 1: invalid quoted value for 'A'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseEditDocument([]byte(tt.doc))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("parseEditDocument(): error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseEditDocument(): unexpected error: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("parseEditDocument() returned %d variables, want %d", len(got), len(tt.want))
+			}
+			for i, want := range tt.want {
+				if *got[i] != want {
+					t.Errorf("parseEditDocument()[%d] = %+v, want %+v", i, *got[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestInsertEditError(t *testing.T) {
+	doc := []byte("A=1\n")
+
+	doc = insertEditError(doc, errors.New("first"))
+	doc = insertEditError(doc, errors.Join(errors.New("second"), errors.New("third")))
+
+	want := editErrorPrefix + "second\n" + editErrorPrefix + "third\nA=1\n"
+	if string(doc) != want {
+		t.Errorf("insertEditError() = %q, want %q", doc, want)
+	}
+
+	vars, err := parseEditDocument(doc)
+	if err != nil {
+		t.Fatalf("parseEditDocument(): unexpected error: %v", err)
+	}
+	if len(vars) != 1 || vars[0].name != "A" || vars[0].comment != "" {
+		t.Errorf("parseEditDocument() did not ignore the errors: %+v", vars)
+	}
+}
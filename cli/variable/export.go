@@ -2,16 +2,25 @@ package variable
 
 import (
 	"bufio"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"slices"
+	"strings"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/goccy/go-yaml"
 	"github.com/kechako/envoke/cli/clierrors"
 	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/config"
 	"github.com/kechako/envoke/ent"
 	"github.com/kechako/envoke/ent/variable"
+	"github.com/kechako/envoke/project"
 	"github.com/spf13/cobra"
 )
 
@@ -19,6 +28,26 @@ func exportCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "export [flags] [<envfile>]",
 		Short: "Export environment variables to a file",
+		Long: `Export environment variables of the specified environment to a file.
+
+The file is written in the .env format by default. Use --format to export
+a JSON or YAML object mapping names to values instead.
+
+When no file is given, the variables are written to the export path of the
+configuration (export.path in the config file or ` + project.FileName + `), or to the
+standard output if it is not set. Use "-" to write to the standard output.
+
+Use --sign to append a detached Ed25519 signature block to the exported file.
+The signature can be checked with 'envoke var import --verify'.`,
+		Example: `  # Export variables to a .env file
+  envoke var export -e development .env
+
+  # Export and sign with an Ed25519 private key
+  # (generated by: openssl genpkey -algorithm ed25519 -out envoke.pem)
+  envoke var export -e development --sign envoke.pem development.env
+
+  # Export as JSON to the standard output
+  envoke var export -e development --format json -`,
 		Args: func(cmd *cobra.Command, args []string) error {
 			if err := cobra.RangeArgs(0, 1)(cmd, args); err != nil {
 				return err
@@ -35,6 +64,33 @@ func exportCommand() *cobra.Command {
 
 			comment, _ := cmd.Flags().GetBool("comment")
 			global, _ := cmd.Flags().GetBool("global")
+			signKey, _ := cmd.Flags().GetString("sign")
+			format, _ := cmd.Flags().GetString("format")
+
+			cfg := config.FromContext(ctx)
+			if format == "" && cfg != nil {
+				format = cfg.Export.Format
+			}
+			if format == "" {
+				format = exportFormatEnv
+			}
+			switch format {
+			case exportFormatEnv, exportFormatJSON, exportFormatYAML:
+			default:
+				return clierrors.Exit(fmt.Errorf("invalid export format '%s' (must be env, json or yaml)", format), 1)
+			}
+			if signKey != "" && format != exportFormatEnv {
+				return clierrors.Exit(errors.New("--sign can only be used with the env format"), 1)
+			}
+
+			var key ed25519.PrivateKey
+			if signKey != "" {
+				var err error
+				key, err = loadPrivateKey(signKey)
+				if err != nil {
+					return clierrors.Exit(err, 1)
+				}
+			}
 
 			globalEnv, err := util.LoadGlobalEnvironment(ctx)
 			if err != nil {
@@ -63,13 +119,18 @@ func exportCommand() *cobra.Command {
 				return nil
 			}
 
-			var envfileName string
+			envfileName := "-"
+			if len(args) > 0 {
+				envfileName = args[0]
+			} else if cfg != nil && cfg.Export.Path != "" {
+				envfileName = cfg.Export.Path
+			}
+
 			var w io.Writer
-			if len(args) == 0 {
+			if envfileName == "-" {
 				envfileName = "<stdout>"
 				w = os.Stdout
 			} else {
-				envfileName = args[0]
 				file, err := os.Create(envfileName)
 				if err != nil {
 					return fmt.Errorf("failed to create environment file '%s': %w", envfileName, err)
@@ -78,7 +139,14 @@ func exportCommand() *cobra.Command {
 				w = file
 			}
 
-			err = exportVariables(w, globalVars, vars, comment, global)
+			switch {
+			case key != nil:
+				err = exportSignedVariables(ctx, w, globalVars, vars, comment, global, key)
+			case format == exportFormatEnv:
+				err = exportVariables(ctx, w, globalVars, vars, comment, global)
+			default:
+				err = exportObject(ctx, w, format, globalVars, vars, global)
+			}
 			if err != nil {
 				return clierrors.Exit(fmt.Errorf("failed to write environment file: %w", err), 1)
 			}
@@ -89,11 +157,20 @@ func exportCommand() *cobra.Command {
 
 	cmd.Flags().Bool("comment", false, "Include comments in the export (default: false)")
 	cmd.Flags().Bool("global", false, "Export global variables (default: false)")
+	cmd.Flags().String("sign", "", "Sign the export with the Ed25519 private key file")
+	cmd.Flags().String("format", "", "Format of the exported file (env, json, yaml) (default: env)")
 
 	return cmd
 }
 
-func exportVariables(w io.Writer, globalVars, vars []*ent.Variable, comment, global bool) error {
+// Formats of the exported file.
+const (
+	exportFormatEnv  = "env"
+	exportFormatJSON = "json"
+	exportFormatYAML = "yaml"
+)
+
+func exportVariables(ctx context.Context, w io.Writer, globalVars, vars []*ent.Variable, comment, global bool) error {
 	globalEnvMap := util.MakeVariableMap(globalVars)
 	envMap := util.MakeVariableMap(vars)
 
@@ -114,7 +191,7 @@ func exportVariables(w io.Writer, globalVars, vars []*ent.Variable, comment, glo
 
 		value := v.Value
 		if v.Expand {
-			value, _ = util.ExpandVariable(value, globalEnvMap, envMap, false)
+			value, _ = util.ExpandVariable(ctx, value, globalEnvMap, envMap, false)
 		}
 		err := ew.WriteVariable(v.Name, value)
 		if err != nil {
@@ -129,6 +206,58 @@ func exportVariables(w io.Writer, globalVars, vars []*ent.Variable, comment, glo
 	return nil
 }
 
+// exportObject writes the variables as a JSON or YAML object mapping names to
+// values, sorted by name.
+func exportObject(ctx context.Context, w io.Writer, format string, globalVars, vars []*ent.Variable, global bool) error {
+	globalEnvMap := util.MakeVariableMap(globalVars)
+	envMap := util.MakeVariableMap(vars)
+
+	var exportGlobalVars []*ent.Variable
+	if global {
+		exportGlobalVars = globalVars
+	}
+
+	var values yaml.MapSlice
+	for _, v := range util.MergeVariables(exportGlobalVars, vars) {
+		value := v.Value
+		if v.Expand {
+			value, _ = util.ExpandVariable(ctx, value, globalEnvMap, envMap, false)
+		}
+		values = append(values, yaml.MapItem{Key: v.Name, Value: value})
+	}
+	slices.SortFunc(values, func(a, b yaml.MapItem) int {
+		return strings.Compare(a.Key.(string), b.Key.(string))
+	})
+
+	if format == exportFormatJSON {
+		m := make(map[string]any, len(values))
+		for _, item := range values {
+			m[item.Key.(string)] = item.Value
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(m)
+	}
+
+	data, err := yaml.Marshal(values)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+func exportSignedVariables(ctx context.Context, w io.Writer, globalVars, vars []*ent.Variable, comment, global bool, key ed25519.PrivateKey) error {
+	var buf bytes.Buffer
+	err := exportVariables(ctx, &buf, globalVars, vars, comment, global)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(signEnvFile(buf.Bytes(), key))
+	return err
+}
+
 type envWriter struct {
 	w   *bufio.Writer
 	err error
@@ -0,0 +1,106 @@
+package variable
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: GroupID,
+		Use:     "get [flags] <name>",
+		Short:   "Print the resolved value of a variable",
+		Long: `Print the resolved value of a single variable, without any decoration.
+
+The variable is looked up in the same order as when running a command: the
+specified environment, the global environment, and the OS environment.
+Values of variables with the expand flag are expanded unless --raw (or
+--expanded=false) is given.
+
+The command exits with a non-zero status if the variable is not found.`,
+		Example: `  # Print a value
+  envoke get -e development DATABASE_URL
+
+  # Print the value without expansion
+  envoke get -e development API_URL --raw
+
+  # Print the layer the value comes from (environment, global or os)
+  envoke get -e development API_URL --source`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.ExactArgs(1)(cmd, args); err != nil {
+				return err
+			}
+
+			if args[0] == "" {
+				return clierrors.Exit(errors.New("variable name cannot be empty"), 1)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			name := args[0]
+
+			raw, _ := cmd.Flags().GetBool("raw")
+			expanded, _ := cmd.Flags().GetBool("expanded")
+			raw = raw || !expanded
+			source, _ := cmd.Flags().GetBool("source")
+
+			globalEnv, err := util.LoadGlobalEnvironment(ctx)
+			if err != nil {
+				return err
+			}
+
+			env, err := util.LoadEnvironment(ctx, cmd)
+			if err != nil {
+				return err
+			}
+
+			globalVars, err := globalEnv.QueryVariables().All(ctx)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			vars, err := env.QueryVariables().All(ctx)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			globalEnvMap := util.MakeVariableMap(globalVars)
+			envMap := util.MakeVariableMap(vars)
+
+			v, value, src := util.LookupVariable(name, globalEnvMap, envMap)
+			if src == util.SourceNone {
+				return clierrors.Exit(fmt.Errorf("variable '%s' not found", name), 1)
+			}
+
+			if source {
+				fmt.Println(src)
+				return nil
+			}
+
+			if v != nil && v.Expand && !raw {
+				value, err = util.ExpandVariable(ctx, value, globalEnvMap, envMap, false)
+				if err != nil {
+					return clierrors.Exit(fmt.Errorf("failed to expand variable '%s': %w", name, err), 1)
+				}
+			}
+
+			fmt.Println(value)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("env", "e", "", "Specify the environment to read from")
+	cmd.Flags().Bool("raw", false, "Print the value without expansion (default: false)")
+	cmd.Flags().Bool("expanded", true, "Print the expanded value (default: true)")
+	cmd.Flags().Bool("source", false, "Print the layer the value comes from instead of the value (default: false)")
+
+	return cmd
+}
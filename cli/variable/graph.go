@@ -0,0 +1,284 @@
+package variable
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+	"github.com/spf13/cobra"
+)
+
+func graphCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "graph [flags] [<name>...]",
+		Short: "Print the reference graph of variables",
+		Long: `Print the graph of references between the variables of an environment,
+built from the values to be expanded.
+
+Variables are resolved in the same way as expansion: in the environment, the
+global environment and the OS environment, or in the referenced environment
+and the global environment for ${ENV:NAME} references. Variables that are not
+defined anywhere are marked as undefined.
+
+If variable names are specified, only the variables they are built from are
+printed.`,
+		Example: `  # Show the chain of variables API_URL is built from
+  envoke var graph -e dev API_URL
+
+  # Render the graph of the dev environment with Graphviz
+  envoke var graph -e dev --format dot | dot -Tsvg -o graph.svg
+
+  # Print the graph as a Mermaid flowchart
+  envoke var graph -e dev --format mermaid`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			for _, name := range args {
+				if name == "" {
+					return clierrors.Exit(errors.New("variable name cannot be empty"), 1)
+				}
+			}
+
+			format, _ := cmd.Flags().GetString("format")
+			switch format {
+			case "text", "dot", "mermaid":
+			default:
+				return clierrors.Exit(fmt.Errorf("invalid format '%s' (must be text, dot or mermaid)", format), 1)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			format, _ := cmd.Flags().GetString("format")
+
+			env, err := util.LoadEnvironment(ctx, cmd)
+			if err != nil {
+				return err
+			}
+
+			envs, err := loadAllEnvironments(ctx, ent.FromContext(ctx))
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			g, err := buildReferenceGraph(envs, env.Name, args)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			switch format {
+			case "dot":
+				g.writeDot(os.Stdout, env.Name)
+			case "mermaid":
+				g.writeMermaid(os.Stdout)
+			default:
+				g.writeText(os.Stdout)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String("format", "text", "Format of the graph (text, dot, mermaid)")
+
+	return cmd
+}
+
+// graphNode is a node of a reference graph.
+type graphNode struct {
+	referenceNode
+	// source is the environment defining the variable, "os" for a variable
+	// of the OS environment, or "" for an undefined variable.
+	source string
+	// refs are the indexes of the nodes referenced by the variable.
+	refs []int
+}
+
+// referenceGraph is the graph of references between variables, whose nodes
+// are variables expanded in an environment.
+type referenceGraph struct {
+	envName string
+	nodes   []*graphNode
+	// roots are the indexes of the nodes the graph was built from.
+	roots []int
+}
+
+// buildReferenceGraph returns the reference graph of the variables names of
+// the environment envName, or of all its variables if names is empty. envs
+// are all environments, loaded with their variables.
+func buildReferenceGraph(envs []*ent.Environment, envName string, names []string) (*referenceGraph, error) {
+	r := newReferenceResolver(envs)
+	g := &referenceGraph{envName: envName}
+
+	if len(names) == 0 {
+		var env *ent.Environment
+		var globalVars []*ent.Variable
+		for _, e := range envs {
+			switch e.Name {
+			case envName:
+				env = e
+			case "global":
+				globalVars = e.Edges.Variables
+			}
+		}
+		if env != nil {
+			for _, v := range util.MergeVariables(globalVars, env.Edges.Variables) {
+				names = append(names, v.Name)
+			}
+		}
+	}
+
+	index := map[referenceNode]int{}
+	var add func(n referenceNode) int
+	add = func(n referenceNode) int {
+		if i, ok := index[n]; ok {
+			return i
+		}
+
+		node := &graphNode{referenceNode: n}
+		v, source := r.lookup(n)
+		if v == nil && n.env == envName {
+			if _, ok := os.LookupEnv(n.name); ok {
+				source = "os"
+			}
+		}
+		node.source = source
+
+		i := len(g.nodes)
+		index[n] = i
+		g.nodes = append(g.nodes, node)
+
+		if v != nil && v.Expand {
+			for _, ref := range util.VariableReferences(v.Value) {
+				node.refs = append(node.refs, add(resolveReference(n.env, ref)))
+			}
+		}
+
+		return i
+	}
+
+	for _, name := range names {
+		n := referenceNode{env: envName, name: name}
+		if v, _ := r.lookup(n); v == nil {
+			return nil, fmt.Errorf("variable '%s' not found in environment '%s'", name, envName)
+		}
+		g.roots = append(g.roots, add(n))
+	}
+
+	return g, nil
+}
+
+// label returns the label of the node i: the name of the variable, followed by
+// where it comes from unless it is defined in the environment of the graph.
+func (g *referenceGraph) label(i int) string {
+	n := g.nodes[i]
+	switch {
+	case n.source == "":
+		return n.name + " (undefined)"
+	case n.source == g.envName:
+		return n.name
+	default:
+		return n.name + " (" + n.source + ")"
+	}
+}
+
+// writeText writes the graph as trees of the variables each root variable is
+// built from. Only the roots that are not referenced by other variables are
+// printed as trees of their own.
+func (g *referenceGraph) writeText(w io.Writer) {
+	referenced := make([]bool, len(g.nodes))
+	for _, n := range g.nodes {
+		for _, ref := range n.refs {
+			referenced[ref] = true
+		}
+	}
+
+	printed := make([]bool, len(g.nodes))
+	var path []int
+	var write func(i int, prefix, childPrefix string)
+	write = func(i int, prefix, childPrefix string) {
+		printed[i] = true
+		if slices.Contains(path, i) {
+			fmt.Fprintf(w, "%s%s (cycle)\n", prefix, g.label(i))
+			return
+		}
+		fmt.Fprintf(w, "%s%s\n", prefix, g.label(i))
+
+		path = append(path, i)
+		refs := g.nodes[i].refs
+		for j, ref := range refs {
+			if j == len(refs)-1 {
+				write(ref, childPrefix+"└── ", childPrefix+"    ")
+			} else {
+				write(ref, childPrefix+"├── ", childPrefix+"│   ")
+			}
+		}
+		path = path[:len(path)-1]
+	}
+
+	for _, i := range g.roots {
+		if !referenced[i] {
+			write(i, "", "")
+		}
+	}
+	// Variables referenced only from cycles have no root of their own.
+	for _, i := range g.roots {
+		if !printed[i] {
+			write(i, "", "")
+		}
+	}
+}
+
+// writeDot writes the graph in the DOT language of Graphviz.
+func (g *referenceGraph) writeDot(w io.Writer, name string) {
+	fmt.Fprintf(w, "digraph %s {\n", strconv.Quote(name))
+	fmt.Fprintln(w, "  rankdir=LR;")
+	for i, n := range g.nodes {
+		attrs := "label=" + strconv.Quote(g.label(i))
+		switch n.source {
+		case "":
+			attrs += ", color=red"
+		case "os":
+			attrs += ", style=dashed"
+		}
+		fmt.Fprintf(w, "  n%d [%s];\n", i, attrs)
+	}
+	for i, n := range g.nodes {
+		for _, ref := range n.refs {
+			fmt.Fprintf(w, "  n%d -> n%d;\n", i, ref)
+		}
+	}
+	fmt.Fprintln(w, "}")
+}
+
+// mermaidEscaper escapes the characters that end a quoted label of Mermaid.
+var mermaidEscaper = strings.NewReplacer(`"`, "#quot;")
+
+// writeMermaid writes the graph as a Mermaid flowchart.
+func (g *referenceGraph) writeMermaid(w io.Writer) {
+	fmt.Fprintln(w, "flowchart LR")
+	for i, n := range g.nodes {
+		class := ""
+		switch n.source {
+		case "":
+			class = ":::undefined"
+		case "os":
+			class = ":::os"
+		}
+		fmt.Fprintf(w, "  n%d[\"%s\"]%s\n", i, mermaidEscaper.Replace(g.label(i)), class)
+	}
+	for i, n := range g.nodes {
+		for _, ref := range n.refs {
+			fmt.Fprintf(w, "  n%d --> n%d\n", i, ref)
+		}
+	}
+	fmt.Fprintln(w, "  classDef undefined stroke:#f00")
+	fmt.Fprintln(w, "  classDef os stroke-dasharray:4")
+}
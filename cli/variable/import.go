@@ -1,6 +1,7 @@
 package variable
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 	"github.com/kechako/envfile"
 	"github.com/kechako/envoke/cli/clierrors"
 	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/config"
 	"github.com/kechako/envoke/ent"
 	"github.com/kechako/envoke/ent/variable"
 	"github.com/spf13/cobra"
@@ -19,6 +21,20 @@ func importCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "import [flags] [<envfile>]",
 		Short: "Import environment variables from a file",
+		Long: `Import environment variables from a file into the specified environment.
+
+Use --verify to accept only files signed by 'envoke var export --sign'.
+The key can be a public key file (PEM or base64) or the name of a key in
+the trusted_keys list of the configuration file. Unsigned files and files
+modified after signing are refused.`,
+		Example: `  # Import variables from a .env file
+  envoke var import -e development .env
+
+  # Import a signed file, verifying it with a public key file
+  envoke var import -e development --verify alice.pub development.env
+
+  # Import a signed file, verifying it with a trusted key from the config
+  envoke var import -e development --verify alice development.env`,
 		Args: func(cmd *cobra.Command, args []string) error {
 			if err := cobra.RangeArgs(0, 1)(cmd, args); err != nil {
 				return err
@@ -34,6 +50,7 @@ func importCommand() *cobra.Command {
 			ctx := cmd.Context()
 
 			merge, _ := cmd.Flags().GetBool("merge")
+			verifyKey, _ := cmd.Flags().GetString("verify")
 
 			var envfileName string
 			var r io.Reader
@@ -50,6 +67,24 @@ func importCommand() *cobra.Command {
 				r = file
 			}
 
+			if verifyKey != "" {
+				pub, err := loadPublicKey(config.FromContext(ctx), verifyKey)
+				if err != nil {
+					return clierrors.Exit(err, 1)
+				}
+
+				data, err := io.ReadAll(r)
+				if err != nil {
+					return clierrors.Exit(fmt.Errorf("failed to read environment file '%s': %w", envfileName, err), 1)
+				}
+
+				content, err := verifyEnvFile(data, pub)
+				if err != nil {
+					return clierrors.Exit(fmt.Errorf("failed to verify environment file '%s': %w", envfileName, err), 1)
+				}
+				r = bytes.NewReader(content)
+			}
+
 			envs, err := envfile.Parse(r)
 			if err != nil {
 				return clierrors.Exit(fmt.Errorf("failed to parse environment: %w", err), 1)
@@ -111,6 +146,7 @@ func importCommand() *cobra.Command {
 	}
 
 	cmd.Flags().Bool("merge", false, "Merge with existing variables (default: false)")
+	cmd.Flags().String("verify", "", "Verify the signature with the public key file or trusted key name")
 
 	return cmd
 }
@@ -2,13 +2,13 @@ package variable
 
 import (
 	"fmt"
+	"os"
 
 	"entgo.io/ent/dialect/sql"
-	"github.com/fatih/color"
 	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/output"
 	"github.com/kechako/envoke/cli/util"
 	"github.com/kechako/envoke/ent/variable"
-	"github.com/rodaine/table"
 	"github.com/spf13/cobra"
 )
 
@@ -27,6 +27,13 @@ func listCommand() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
+			showSecrets, _ := cmd.Flags().GetBool("show-secrets")
+
+			format, err := output.FromCommand(cmd)
+			if err != nil {
+				return err
+			}
+
 			env, err := util.LoadEnvironment(ctx, cmd)
 			if err != nil {
 				return err
@@ -39,24 +46,58 @@ func listCommand() *cobra.Command {
 				return clierrors.Exit(err, 1)
 			}
 
-			if len(vars) == 0 {
+			if len(vars) == 0 && format.Kind == output.Table {
 				fmt.Println("(No environment variables found)")
 				return nil
 			}
 
-			headerFmt := color.New(color.ResetUnderline, color.Bold).SprintfFunc()
+			type Var struct {
+				Environment string `json:"environment" yaml:"environment"`
+				Name        string `json:"name" yaml:"name"`
+				Value       string `json:"value" yaml:"value"`
+				Expand      bool   `json:"expand" yaml:"expand"`
+				Secret      bool   `json:"secret" yaml:"secret"`
+				Comment     string `json:"comment" yaml:"comment"`
+				Type        string `json:"type" yaml:"type"`
 
-			tbl := table.New("Name", "Value", "Expand", "Comment")
-			tbl.WithHeaderFormatter(headerFmt)
+				Pattern string   `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+				Min     string   `json:"min,omitempty" yaml:"min,omitempty"`
+				Max     string   `json:"max,omitempty" yaml:"max,omitempty"`
+				Values  []string `json:"values,omitempty" yaml:"values,omitempty"`
+			}
 
-			for _, v := range vars {
-				tbl.AddRow(v.Name, v.Value, v.Expand, v.Comment)
+			records := make([]*Var, len(vars))
+			for i, v := range vars {
+				records[i] = &Var{
+					Environment: env.Name,
+					Name:        v.Name,
+					Value:       util.MaskSecret(v.Value, v.Secret && !showSecrets),
+					Expand:      v.Expand,
+					Secret:      v.Secret,
+					Comment:     v.Comment,
+					Type:        v.Type,
+					Pattern:     v.Pattern,
+					Min:         v.Min,
+					Max:         v.Max,
+					Values:      v.EnumValues,
+				}
 			}
 
-			tbl.Print()
+			err = output.Print(os.Stdout, format, records, output.Columns[*Var]{
+				Headers: []string{"Name", "Value", "Type", "Expand", "Secret", "Comment"},
+				Fields:  []string{"name", "value", "type", "expand", "secret", "comment"},
+				Row: func(v *Var) []any {
+					return []any{v.Name, v.Value, v.Type, v.Expand, v.Secret, v.Comment}
+				},
+			})
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
 
 			return nil
 		},
 	}
+	cmd.Flags().Bool("show-secrets", false, "Show the values of secret variables (default: false)")
+
 	return cmd
 }
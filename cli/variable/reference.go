@@ -0,0 +1,70 @@
+package variable
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+	envpred "github.com/kechako/envoke/ent/environment"
+	varpred "github.com/kechako/envoke/ent/variable"
+)
+
+// loadAllEnvironments loads all environments with their variables.
+func loadAllEnvironments(ctx context.Context, client *ent.Client) ([]*ent.Environment, error) {
+	envs, err := client.Environment.Query().
+		Order(envpred.ByName(sql.OrderAsc())).
+		WithVariables(func(q *ent.VariableQuery) {
+			q.Order(varpred.ByName(sql.OrderAsc()))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load environments: %w", err)
+	}
+	return envs, nil
+}
+
+// referenceResolver resolves references between the variables of
+// environments in the same way as expansion, without the OS environment.
+type referenceResolver struct {
+	envMaps map[string]map[string]*ent.Variable
+}
+
+// newReferenceResolver returns a referenceResolver for envs, loaded with their
+// variables.
+func newReferenceResolver(envs []*ent.Environment) *referenceResolver {
+	r := &referenceResolver{
+		envMaps: make(map[string]map[string]*ent.Variable, len(envs)),
+	}
+	for _, env := range envs {
+		r.envMaps[env.Name] = util.MakeVariableMap(env.Edges.Variables)
+	}
+	return r
+}
+
+// lookup returns the variable n and the name of the environment defining it,
+// which is either n.env or the global environment. lookup returns nil and ""
+// if n is not defined.
+func (r *referenceResolver) lookup(n referenceNode) (*ent.Variable, string) {
+	envMap, ok := r.envMaps[n.env]
+	if !ok {
+		return nil, ""
+	}
+	if v, ok := envMap[n.name]; ok {
+		return v, n.env
+	}
+	if v, ok := r.envMaps["global"][n.name]; ok {
+		return v, "global"
+	}
+	return nil, ""
+}
+
+// resolveReference returns the variable referenced by ref in the environment envName.
+func resolveReference(envName, ref string) referenceNode {
+	refEnv, name := util.ParseReference(ref)
+	if refEnv == "" {
+		refEnv = envName
+	}
+	return referenceNode{env: refEnv, name: name}
+}
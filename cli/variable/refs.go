@@ -0,0 +1,155 @@
+package variable
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/output"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+	"github.com/spf13/cobra"
+)
+
+func refsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refs [flags] <name>",
+		Short: "List the variables referencing a variable",
+		Long: `List the variables whose values reference the specified variable of the
+environment with ${NAME}, ${ENV:NAME} or ${env:ENV/NAME} when expanded.
+
+If the environment does not define the variable, references to the variable of
+the global environment are listed. Variables of the global environment are
+listed if they reference the variable when expanded in the environment.`,
+		Example: `  # List the variables built from BASE_URL in the dev environment
+  envoke var refs -e dev BASE_URL`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.ExactArgs(1)(cmd, args); err != nil {
+				return err
+			}
+
+			if args[0] == "" {
+				return clierrors.Exit(errors.New("variable name cannot be empty"), 1)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			name := args[0]
+
+			format, err := output.FromCommand(cmd)
+			if err != nil {
+				return err
+			}
+
+			env, err := util.LoadEnvironment(ctx, cmd)
+			if err != nil {
+				return err
+			}
+
+			envs, err := loadAllEnvironments(ctx, ent.FromContext(ctx))
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			refs, err := findReferences(envs, referenceNode{env: env.Name, name: name})
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			if len(refs) == 0 && format.Kind == output.Table {
+				fmt.Println("(No references found)")
+				return nil
+			}
+
+			err = output.Print(os.Stdout, format, refs, output.Columns[*reference]{
+				Headers: []string{"Environment", "Name", "Reference", "Value"},
+				Fields:  []string{"environment", "name", "reference", "value"},
+				Row: func(r *reference) []any {
+					return []any{r.Environment, r.Name, r.Reference, r.Value}
+				},
+			})
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// reference is a reference to a variable from the value of another variable.
+type reference struct {
+	Environment string `json:"environment" yaml:"environment"`
+	Name        string `json:"name" yaml:"name"`
+	Reference   string `json:"reference" yaml:"reference"`
+	Value       string `json:"value" yaml:"value"`
+}
+
+// findReferences returns the references to the variable target from the
+// variables of envs, loaded with their variables. Global variables are
+// expanded in every environment, and listed once.
+func findReferences(envs []*ent.Environment, target referenceNode) ([]*reference, error) {
+	r := newReferenceResolver(envs)
+
+	_, defEnv := r.lookup(target)
+	if defEnv == "" {
+		return nil, fmt.Errorf("variable '%s' not found in environment '%s'", target.name, target.env)
+	}
+
+	var globalVars []*ent.Variable
+	for _, env := range envs {
+		if env.Name == "global" {
+			globalVars = env.Edges.Variables
+		}
+	}
+
+	type key struct {
+		id  int
+		ref string
+	}
+	seen := map[key]bool{}
+
+	var refs []*reference
+	for _, env := range envs {
+		for _, v := range util.MergeVariables(globalVars, env.Edges.Variables) {
+			if !v.Expand {
+				continue
+			}
+
+			for _, ref := range util.VariableReferences(v.Value) {
+				n := resolveReference(env.Name, ref)
+				if n.name != target.name {
+					continue
+				}
+				if _, d := r.lookup(n); d != defEnv {
+					continue
+				}
+
+				k := key{v.ID, ref}
+				if seen[k] {
+					continue
+				}
+				seen[k] = true
+
+				owner := env.Name
+				if _, ok := r.envMaps[env.Name][v.Name]; !ok {
+					owner = "global"
+				}
+				refs = append(refs, &reference{
+					Environment: owner,
+					Name:        v.Name,
+					Reference:   "${" + ref + "}",
+					Value:       v.Value,
+				})
+			}
+		}
+	}
+
+	return refs, nil
+}
@@ -0,0 +1,238 @@
+package variable
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+	reqpred "github.com/kechako/envoke/ent/requiredvariable"
+	"github.com/kechako/envoke/varname"
+	"github.com/spf13/cobra"
+)
+
+func renameCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rename [flags] <old-name> <new-name>",
+		Short: "Rename an environment variable",
+		Long: `Rename an environment variable, keeping its value, comment and other settings.
+
+References to the variable in values to be expanded, such as ${OLD_NAME} or
+${ENV:OLD_NAME}, are rewritten to the new name in all environments. When a
+variable of the global environment is renamed, references from environments
+that do not define the variable themselves are rewritten as well. Declarations
+of the variable as required by the environment are renamed too.`,
+		Example: `  # Rename DB_HOST to DATABASE_HOST in the dev environment
+  envoke var rename -e dev DB_HOST DATABASE_HOST
+
+  # Rename DB_HOST to DATABASE_HOST in all environments
+  envoke var rename --all-envs DB_HOST DATABASE_HOST`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.ExactArgs(2)(cmd, args); err != nil {
+				return err
+			}
+
+			if args[0] == "" || args[1] == "" {
+				return clierrors.Exit(errors.New("variable name cannot be empty"), 1)
+			}
+			if args[0] == args[1] {
+				return clierrors.Exit(errors.New("new variable name must be different from the old one"), 1)
+			}
+
+			allEnvs, _ := cmd.Flags().GetBool("all-envs")
+			if allEnvs && cmd.Flags().Changed("env") {
+				return clierrors.Exit(errors.New("--all-envs cannot be used with --env"), 1)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			oldName, newName := args[0], args[1]
+			if err := varname.Validate(newName); err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			allEnvs, _ := cmd.Flags().GetBool("all-envs")
+
+			var env *ent.Environment
+			if !allEnvs {
+				var err error
+				env, err = util.LoadEnvironment(ctx, cmd)
+				if err != nil {
+					return err
+				}
+			}
+
+			client := ent.FromContext(ctx)
+
+			var result *renameResult
+			err := client.DoTransaction(ctx, nil, func(ctx context.Context, tx *ent.Tx) error {
+				var err error
+				result, err = renameVariable(ctx, tx.Client(), env, oldName, newName)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, env := range result.envs {
+				fmt.Printf("Variable '%s' renamed to '%s' in environment '%s' successfully!\n", oldName, newName, env.Name)
+			}
+			for _, v := range result.rewritten {
+				fmt.Printf("Rewrote references in variable '%s' (environment '%s')\n", v.Name, v.Edges.Environment.Name)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool("all-envs", false, "Rename the variable in all environments that define it")
+
+	return cmd
+}
+
+// renameResult is the result of renameVariable.
+type renameResult struct {
+	// envs are the environments where the variable was renamed.
+	envs []*ent.Environment
+	// rewritten are the variables whose references were rewritten, loaded
+	// with their environment and with their name before renaming.
+	rewritten []*ent.Variable
+}
+
+// renameVariable renames the variable oldName of env, or of all environments
+// defining it if env is nil, to newName, and rewrites the references that
+// resolve to a renamed variable.
+func renameVariable(ctx context.Context, client *ent.Client, env *ent.Environment, oldName, newName string) (*renameResult, error) {
+	envs, err := loadAllEnvironments(ctx, client)
+	if err != nil {
+		return nil, clierrors.Exit(err, 1)
+	}
+
+	result := &renameResult{}
+	renames := map[referenceNode]string{}
+	for _, e := range envs {
+		if env != nil && e.ID != env.ID {
+			continue
+		}
+		if !slices.ContainsFunc(e.Edges.Variables, func(v *ent.Variable) bool { return v.Name == oldName }) {
+			continue
+		}
+		if slices.ContainsFunc(e.Edges.Variables, func(v *ent.Variable) bool { return v.Name == newName }) {
+			return nil, clierrors.Exit(fmt.Errorf("variable '%s' already exists in environment '%s'", newName, e.Name), 1)
+		}
+		renames[referenceNode{env: e.Name, name: oldName}] = newName
+		result.envs = append(result.envs, e)
+	}
+	if len(result.envs) == 0 {
+		if env != nil {
+			return nil, clierrors.Exit(fmt.Errorf("variable '%s' not found in environment '%s'", oldName, env.Name), 1)
+		}
+		return nil, clierrors.Exit(fmt.Errorf("variable '%s' not found in any environment", oldName), 1)
+	}
+
+	result.rewritten, err = renameVariables(ctx, client, envs, renames)
+	if err != nil {
+		return nil, clierrors.Exit(err, 1)
+	}
+
+	return result, nil
+}
+
+// renameVariables renames the variables of envs, loaded with their variables,
+// to the names of renames, keyed by the variables before renaming. The
+// references that resolve to a renamed variable are rewritten, and the
+// declarations of renamed variables as required by their environment are
+// renamed too. The variables whose references were rewritten are returned,
+// loaded with their environment and with their name before renaming.
+func renameVariables(ctx context.Context, client *ent.Client, envs []*ent.Environment, renames map[referenceNode]string) ([]*ent.Variable, error) {
+	r := newReferenceResolver(envs)
+
+	var rewritten []*ent.Variable
+	for _, e := range envs {
+		for _, v := range e.Edges.Variables {
+			name := v.Name
+			if newName, ok := renames[referenceNode{env: e.Name, name: v.Name}]; ok {
+				name = newName
+			}
+
+			value := v.Value
+			if v.Expand {
+				value = util.RewriteReferences(v.Value, func(ref string) (string, bool) {
+					n := resolveReference(e.Name, ref)
+					refVar, defEnv := r.lookup(n)
+					if refVar == nil {
+						return "", false
+					}
+					newName, ok := renames[referenceNode{env: defEnv, name: n.name}]
+					if !ok {
+						return "", false
+					}
+					return util.RenameReference(ref, newName), true
+				})
+			}
+
+			if name == v.Name && value == v.Value {
+				continue
+			}
+
+			err := client.Variable.UpdateOne(v).
+				SetName(name).
+				SetValue(value).
+				Exec(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update variable '%s' in environment '%s': %w", v.Name, e.Name, err)
+			}
+
+			if value != v.Value {
+				v.Edges.Environment = e
+				rewritten = append(rewritten, v)
+			}
+		}
+	}
+
+	envIDs := make(map[string]int, len(envs))
+	for _, e := range envs {
+		envIDs[e.Name] = e.ID
+	}
+	for n, newName := range renames {
+		envID := envIDs[n.env]
+
+		exists, err := client.RequiredVariable.Query().
+			Where(
+				reqpred.EnvironmentID(envID),
+				reqpred.Name(newName),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query required variables: %w", err)
+		}
+
+		if exists {
+			_, err = client.RequiredVariable.Delete().
+				Where(
+					reqpred.EnvironmentID(envID),
+					reqpred.Name(n.name),
+				).
+				Exec(ctx)
+		} else {
+			_, err = client.RequiredVariable.Update().
+				Where(
+					reqpred.EnvironmentID(envID),
+					reqpred.Name(n.name),
+				).
+				SetName(newName).
+				Save(ctx)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to rename required variable '%s' in environment '%s': %w", n.name, n.env, err)
+		}
+	}
+
+	return rewritten, nil
+}
@@ -0,0 +1,157 @@
+package variable
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+	reqpred "github.com/kechako/envoke/ent/requiredvariable"
+	"github.com/kechako/envoke/varname"
+	"github.com/spf13/cobra"
+)
+
+func requireCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "require [flags] <name>...",
+		Short: "Declare variables required by an environment",
+		Long: `Declare variables that must resolve to a non-empty value to run a command in
+the specified environment. Variables required by the global environment are
+required by all environments.
+
+Declared variables are checked by 'envoke check' and before 'envoke run'.`,
+		Example: `  # Require DATABASE_URL in the production environment
+  envoke var require -e production DATABASE_URL -d "Connection string of the main database"
+
+  # Require API_KEY in all environments
+  envoke var require -e global API_KEY`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.MinimumNArgs(1)(cmd, args); err != nil {
+				return err
+			}
+
+			for _, name := range args {
+				if name == "" {
+					return clierrors.Exit(errors.New("variable name cannot be empty"), 1)
+				}
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			for _, name := range args {
+				if err := varname.Validate(name); err != nil {
+					return clierrors.Exit(err, 1)
+				}
+			}
+
+			env, err := util.LoadEnvironment(ctx, cmd)
+			if err != nil {
+				return err
+			}
+
+			client := ent.FromContext(ctx)
+
+			err = client.DoTransaction(ctx, nil, func(ctx context.Context, tx *ent.Tx) error {
+				for _, name := range args {
+					create := tx.RequiredVariable.Create().
+						SetEnvironment(env).
+						SetName(name)
+					if cmd.Flags().Changed("description") {
+						description, _ := cmd.Flags().GetString("description")
+						create.SetDescription(description)
+					}
+
+					err := create.
+						OnConflictColumns(reqpred.FieldEnvironmentID, reqpred.FieldName).
+						UpdateNewValues().
+						Exec(ctx)
+					if err != nil {
+						return clierrors.Exit(fmt.Errorf("failed to declare required variable '%s': %w", name, err), 1)
+					}
+				}
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, name := range args {
+				fmt.Printf("Required variable '%s' declared successfully!\n", name)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("description", "d", "", "Description of the variables, shown when they are missing")
+
+	return cmd
+}
+
+func unrequireCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unrequire [flags] <name>...",
+		Short: "Remove declarations of required variables",
+		Example: `  # DATABASE_URL is no longer required in the production environment
+  envoke var unrequire -e production DATABASE_URL`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.MinimumNArgs(1)(cmd, args); err != nil {
+				return err
+			}
+
+			for _, name := range args {
+				if name == "" {
+					return clierrors.Exit(errors.New("variable name cannot be empty"), 1)
+				}
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			env, err := util.LoadEnvironment(ctx, cmd)
+			if err != nil {
+				return err
+			}
+
+			client := ent.FromContext(ctx)
+
+			err = client.DoTransaction(ctx, nil, func(ctx context.Context, tx *ent.Tx) error {
+				for _, name := range args {
+					n, err := tx.RequiredVariable.Delete().
+						Where(
+							reqpred.EnvironmentID(env.ID),
+							reqpred.Name(name),
+						).
+						Exec(ctx)
+					if err != nil {
+						return clierrors.Exit(fmt.Errorf("failed to remove required variable '%s': %w", name, err), 1)
+					}
+					if n == 0 {
+						return clierrors.Exit(fmt.Errorf("variable '%s' is not required in environment '%s'", name, env.Name), 1)
+					}
+				}
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, name := range args {
+				fmt.Printf("Required variable '%s' removed successfully!\n", name)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
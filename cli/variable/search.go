@@ -0,0 +1,160 @@
+package variable
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/output"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+	"github.com/spf13/cobra"
+)
+
+func searchCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search [flags] <pattern>",
+		Short: "Search variables across all environments",
+		Long: `Search the names, values and comments of the variables of all environments
+for a pattern, and list the matching variables with their environment.
+
+The pattern is matched as a substring, or as a regular expression with --regex.
+Use --name, --value or --comment to search only some of the fields. The glob of
+--env-glob is prefixed with the environment name prefix of the configuration,
+like the names given with -e. Values of secret variables are neither searched
+nor shown unless --include-secrets is given.`,
+		Example: `  # Find the environments still pointing at the old database host
+  envoke var search --value db-old.internal
+
+  # Search the names of the variables of the production environments
+  envoke var search --name --regex '^AWS_' --env-glob 'prod-*'`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.ExactArgs(1)(cmd, args); err != nil {
+				return err
+			}
+
+			if args[0] == "" {
+				return clierrors.Exit(errors.New("pattern cannot be empty"), 1)
+			}
+
+			envGlob, _ := cmd.Flags().GetString("env-glob")
+			if _, err := path.Match(envGlob, ""); err != nil {
+				return clierrors.Exit(fmt.Errorf("invalid environment glob '%s': %w", envGlob, err), 1)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			format, err := output.FromCommand(cmd)
+			if err != nil {
+				return err
+			}
+
+			matches := func(s string) bool {
+				return strings.Contains(s, args[0])
+			}
+			if useRegex, _ := cmd.Flags().GetBool("regex"); useRegex {
+				re, err := regexp.Compile(args[0])
+				if err != nil {
+					return clierrors.Exit(fmt.Errorf("invalid pattern: %w", err), 1)
+				}
+				matches = re.MatchString
+			}
+
+			searchName, _ := cmd.Flags().GetBool("name")
+			searchValue, _ := cmd.Flags().GetBool("value")
+			searchComment, _ := cmd.Flags().GetBool("comment")
+			if !searchName && !searchValue && !searchComment {
+				searchName, searchValue, searchComment = true, true, true
+			}
+			includeSecrets, _ := cmd.Flags().GetBool("include-secrets")
+			envGlob, _ := cmd.Flags().GetString("env-glob")
+			if envGlob != "" {
+				envGlob = util.EnvironmentName(ctx, envGlob)
+			}
+
+			envs, err := loadAllEnvironments(ctx, ent.FromContext(ctx))
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			type match struct {
+				Environment string   `json:"environment" yaml:"environment"`
+				Name        string   `json:"name" yaml:"name"`
+				Value       string   `json:"value" yaml:"value"`
+				Secret      bool     `json:"secret" yaml:"secret"`
+				Comment     string   `json:"comment" yaml:"comment"`
+				Fields      []string `json:"fields" yaml:"fields"`
+			}
+
+			var records []*match
+			for _, env := range envs {
+				if envGlob != "" {
+					if ok, _ := path.Match(envGlob, env.Name); !ok {
+						continue
+					}
+				}
+
+				for _, v := range env.Edges.Variables {
+					hideValue := v.Secret && !includeSecrets
+
+					var fields []string
+					if searchName && matches(v.Name) {
+						fields = append(fields, "name")
+					}
+					if searchValue && !hideValue && matches(v.Value) {
+						fields = append(fields, "value")
+					}
+					if searchComment && matches(v.Comment) {
+						fields = append(fields, "comment")
+					}
+					if len(fields) == 0 {
+						continue
+					}
+
+					records = append(records, &match{
+						Environment: env.Name,
+						Name:        v.Name,
+						Value:       util.MaskSecret(v.Value, hideValue),
+						Secret:      v.Secret,
+						Comment:     v.Comment,
+						Fields:      fields,
+					})
+				}
+			}
+
+			if len(records) == 0 && format.Kind == output.Table {
+				fmt.Println("(No matching variables found)")
+				return nil
+			}
+
+			err = output.Print(os.Stdout, format, records, output.Columns[*match]{
+				Headers: []string{"Environment", "Name", "Value", "Comment", "Matched"},
+				Fields:  []string{"environment", "name", "value", "comment", "fields"},
+				Row: func(m *match) []any {
+					return []any{m.Environment, m.Name, m.Value, m.Comment, strings.Join(m.Fields, ",")}
+				},
+			})
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool("name", false, "Search the names of variables (default: false)")
+	cmd.Flags().Bool("value", false, "Search the values of variables (default: false)")
+	cmd.Flags().Bool("comment", false, "Search the comments of variables (default: false)")
+	cmd.Flags().Bool("regex", false, "Match the pattern as a regular expression (default: false)")
+	cmd.Flags().String("env-glob", "", "Search only the environments whose names match the glob, e.g. 'prod-*'")
+	cmd.Flags().Bool("include-secrets", false, "Search and show the values of secret variables (default: false)")
+
+	return cmd
+}
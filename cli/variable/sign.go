@@ -0,0 +1,178 @@
+package variable
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kechako/envoke/config"
+)
+
+const (
+	signatureBegin = "# -----BEGIN ENVOKE SIGNATURE-----"
+	signatureEnd   = "# -----END ENVOKE SIGNATURE-----"
+)
+
+// signEnvFile appends a detached signature block to data.
+// The signature covers every byte that precedes the block.
+func signEnvFile(data []byte, key ed25519.PrivateKey) []byte {
+	var buf bytes.Buffer
+	buf.Write(data)
+	if len(data) > 0 && data[len(data)-1] != '\n' {
+		buf.WriteByte('\n')
+	}
+
+	signed := buf.Len()
+	sig := ed25519.Sign(key, buf.Bytes()[:signed])
+	pub := key.Public().(ed25519.PublicKey)
+
+	buf.WriteString(signatureBegin)
+	buf.WriteByte('\n')
+	buf.WriteString("# key: ")
+	buf.WriteString(base64.StdEncoding.EncodeToString(pub))
+	buf.WriteByte('\n')
+	buf.WriteString("# sig: ")
+	buf.WriteString(base64.StdEncoding.EncodeToString(sig))
+	buf.WriteByte('\n')
+	buf.WriteString(signatureEnd)
+	buf.WriteByte('\n')
+
+	return buf.Bytes()
+}
+
+// verifyEnvFile checks the signature block of data against key and returns
+// the signed content without the signature block.
+func verifyEnvFile(data []byte, key ed25519.PublicKey) ([]byte, error) {
+	idx := bytes.LastIndex(data, []byte(signatureBegin))
+	if idx < 0 || (idx > 0 && data[idx-1] != '\n') {
+		return nil, errors.New("environment file is not signed")
+	}
+	content, block := data[:idx], string(data[idx:])
+
+	var keyLine, sigLine string
+	var ended bool
+	for i, line := range strings.Split(strings.TrimRight(block, "\n"), "\n") {
+		line = strings.TrimRight(line, "\r")
+		switch {
+		case i == 0:
+			// BEGIN marker
+		case ended:
+			return nil, errors.New("unexpected content after signature block")
+		case line == signatureEnd:
+			ended = true
+		case strings.HasPrefix(line, "# key: "):
+			keyLine = strings.TrimPrefix(line, "# key: ")
+		case strings.HasPrefix(line, "# sig: "):
+			sigLine = strings.TrimPrefix(line, "# sig: ")
+		default:
+			return nil, fmt.Errorf("malformed signature block: %q", line)
+		}
+	}
+	if !ended {
+		return nil, errors.New("malformed signature block: missing end marker")
+	}
+
+	signer, err := base64.StdEncoding.DecodeString(keyLine)
+	if err != nil || len(signer) != ed25519.PublicKeySize {
+		return nil, errors.New("malformed signature block: invalid key")
+	}
+	if !bytes.Equal(signer, key) {
+		return nil, fmt.Errorf("environment file was signed by an unknown key (%s)", keyLine)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(sigLine)
+	if err != nil || len(sig) != ed25519.SignatureSize {
+		return nil, errors.New("malformed signature block: invalid signature")
+	}
+	if !ed25519.Verify(key, content, sig) {
+		return nil, errors.New("signature verification failed (the file may have been modified)")
+	}
+
+	return content, nil
+}
+
+// loadPrivateKey reads an Ed25519 private key from a PKCS #8 PEM file,
+// as generated by `openssl genpkey -algorithm ed25519`.
+func loadPrivateKey(name string) (ed25519.PrivateKey, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key '%s': %w", name, err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "PRIVATE KEY" {
+		return nil, fmt.Errorf("private key '%s' is not a PEM encoded PKCS #8 key", name)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key '%s': %w", name, err)
+	}
+
+	priv, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key '%s' is not an Ed25519 key", name)
+	}
+
+	return priv, nil
+}
+
+// loadPublicKey resolves name to an Ed25519 public key. The name is looked up
+// in the trusted keys of the configuration first, and is otherwise read as a
+// file containing either a PKIX PEM public key or a base64 encoded raw key.
+func loadPublicKey(cfg *config.Config, name string) (ed25519.PublicKey, error) {
+	if cfg != nil {
+		if key, ok := cfg.FindTrustedKey(name); ok {
+			pub, err := parsePublicKey([]byte(key.PublicKey))
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted key '%s': %w", name, err)
+			}
+			return pub, nil
+		}
+	}
+
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key '%s': %w", name, err)
+	}
+
+	pub, err := parsePublicKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key '%s': %w", name, err)
+	}
+
+	return pub, nil
+}
+
+func parsePublicKey(data []byte) (ed25519.PublicKey, error) {
+	if block, _ := pem.Decode(data); block != nil {
+		if block.Type != "PUBLIC KEY" {
+			return nil, fmt.Errorf("unexpected PEM block type '%s'", block.Type)
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		pub, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return nil, errors.New("not an Ed25519 key")
+		}
+		return pub, nil
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, errors.New("neither a PEM block nor a base64 encoded key")
+	}
+	if len(raw) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid key size %d", len(raw))
+	}
+
+	return ed25519.PublicKey(raw), nil
+}
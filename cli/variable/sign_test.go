@@ -0,0 +1,123 @@
+package variable
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/kechako/envoke/config"
+)
+
+func testKey(seed byte) ed25519.PrivateKey {
+	return ed25519.NewKeyFromSeed(bytes.Repeat([]byte{seed}, ed25519.SeedSize))
+}
+
+func TestSignEnvFile(t *testing.T) {
+	key := testKey(1)
+	pub := key.Public().(ed25519.PublicKey)
+
+	for _, content := range []string{"", "A=1\nB=2\n", "A=1"} {
+		signed := signEnvFile([]byte(content), key)
+
+		got, err := verifyEnvFile(signed, pub)
+		if err != nil {
+			t.Errorf("verifyEnvFile(%q): unexpected error: %v", content, err)
+			continue
+		}
+		want := content
+		if want != "" && !strings.HasSuffix(want, "\n") {
+			want += "\n"
+		}
+		if string(got) != want {
+			t.Errorf("verifyEnvFile(%q) = %q, want %q", content, got, want)
+		}
+	}
+}
+
+func TestVerifyEnvFile(t *testing.T) {
+	key := testKey(1)
+	pub := key.Public().(ed25519.PublicKey)
+	signed := string(signEnvFile([]byte("A=1\nB=2\n"), key))
+
+	otherKey := testKey(2)
+	otherPub := otherKey.Public().(ed25519.PublicKey)
+
+	resigned := strings.Replace(signed, "A=1", "A=0", 1)
+
+	// replaySig replaces the signature with a valid one for other content,
+	// keeping the signer.
+	otherSig := base64.StdEncoding.EncodeToString(ed25519.Sign(key, []byte("A=0\nB=2\n")))
+	sigStart := strings.Index(signed, "# sig: ") + len("# sig: ")
+	sigEnd := sigStart + strings.Index(signed[sigStart:], "\n")
+	replaySig := signed[:sigStart] + otherSig + signed[sigEnd:]
+
+	tests := []struct {
+		name    string
+		data    string
+		key     ed25519.PublicKey
+		wantErr string
+	}{
+		{name: "valid", data: signed, key: pub},
+		{name: "unsigned", data: "A=1\nB=2\n", key: pub, wantErr: "not signed"},
+		{name: "marker not at line start", data: "A=1 " + signed[strings.Index(signed, signatureBegin):], key: pub, wantErr: "not signed"},
+		{name: "tampered content", data: resigned, key: pub, wantErr: "signature verification failed"},
+		{name: "appended content", data: "C=3\n" + signed, key: pub, wantErr: "signature verification failed"},
+		{name: "signature of other content", data: replaySig, key: pub, wantErr: "signature verification failed"},
+		{name: "content after block", data: signed + "C=3\n", key: pub, wantErr: "unexpected content after signature block"},
+		{name: "missing end marker", data: strings.Replace(signed, signatureEnd+"\n", "", 1), key: pub, wantErr: "missing end marker"},
+		{name: "malformed line", data: strings.Replace(signed, "# sig: ", "# signature: ", 1), key: pub, wantErr: "malformed signature block"},
+		{name: "untrusted key", data: signed, key: otherPub, wantErr: "signed by an unknown key"},
+		{name: "signed by untrusted key", data: string(signEnvFile([]byte("A=1\n"), otherKey)), key: pub, wantErr: "signed by an unknown key"},
+		{
+			name:    "forged key line",
+			data:    strings.Replace(string(signEnvFile([]byte("A=1\n"), otherKey)), base64.StdEncoding.EncodeToString(otherPub), base64.StdEncoding.EncodeToString(pub), 1),
+			key:     pub,
+			wantErr: "signature verification failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := verifyEnvFile([]byte(tt.data), tt.key)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("verifyEnvFile(): error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("verifyEnvFile(): unexpected error: %v", err)
+			}
+			if string(content) != "A=1\nB=2\n" {
+				t.Errorf("verifyEnvFile() = %q, want %q", content, "A=1\nB=2\n")
+			}
+		})
+	}
+}
+
+func TestLoadPublicKey(t *testing.T) {
+	pub := testKey(1).Public().(ed25519.PublicKey)
+	cfg := &config.Config{
+		TrustedKeys: []config.TrustedKey{
+			{Name: "ci", PublicKey: base64.StdEncoding.EncodeToString(pub)},
+			{Name: "broken", PublicKey: "not a key"},
+		},
+	}
+
+	got, err := loadPublicKey(cfg, "ci")
+	if err != nil {
+		t.Fatalf("loadPublicKey(ci): unexpected error: %v", err)
+	}
+	if !got.Equal(pub) {
+		t.Errorf("loadPublicKey(ci) = %x, want %x", got, pub)
+	}
+
+	if _, err := loadPublicKey(cfg, "broken"); err == nil || !strings.Contains(err.Error(), "invalid trusted key 'broken'") {
+		t.Errorf("loadPublicKey(broken): error = %v, want invalid trusted key", err)
+	}
+	if _, err := loadPublicKey(cfg, "unknown"); err == nil {
+		t.Error("loadPublicKey(unknown): expected an error")
+	}
+}
@@ -0,0 +1,590 @@
+package variable
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/fatih/color"
+	"github.com/kechako/envfile"
+	"github.com/kechako/envoke/cli/clierrors"
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+	"github.com/kechako/envoke/ent/syncstate"
+	"github.com/kechako/envoke/ent/variable"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+)
+
+func SyncCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		GroupID: GroupID,
+		Use:     "sync [flags] <envfile>",
+		Short:   "Synchronize an environment with a .env file in both directions",
+		Long: `Synchronize the variables of an environment with a .env file in both directions.
+
+The file and the environment are compared against the state recorded by the
+last synchronization of the same environment and file:
+
+  • Changes made only on one side are applied to the other side.
+  • Changes made on both sides are conflicts. Conflicts are resolved
+    interactively, or with --prefer db|file.
+
+After resolving, both the environment and the file are updated and the new
+state is recorded. Values of variables with the expand flag are compared and
+written in their expanded form, and are never overwritten with the values of
+the file, so that their references are kept. Values of secret variables are
+masked unless --show-secrets is given.`,
+		Example: `  # Synchronize the development environment with .env
+  envoke sync -e development .env
+
+  # Show what would change without writing anything
+  envoke sync -e development .env --dry-run
+
+  # Resolve conflicts in favor of the file
+  envoke sync -e development .env --prefer file`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.ExactArgs(1)(cmd, args); err != nil {
+				return err
+			}
+
+			if args[0] == "" {
+				return clierrors.Exit(errors.New("environment file path cannot be empty"), 1)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			prefer, _ := cmd.Flags().GetString("prefer")
+			dryRun, _ := cmd.Flags().GetBool("dry-run")
+			showSecrets, _ := cmd.Flags().GetBool("show-secrets")
+
+			switch prefer {
+			case "", "db", "file":
+			default:
+				return clierrors.Exit(fmt.Errorf("invalid value for --prefer: '%s' (must be 'db' or 'file')", prefer), 1)
+			}
+
+			path, err := filepath.Abs(args[0])
+			if err != nil {
+				return clierrors.Exit(fmt.Errorf("failed to resolve path '%s': %w", args[0], err), 1)
+			}
+
+			fileVars, err := readSyncFile(path)
+			if err != nil {
+				return clierrors.Exit(err, 1)
+			}
+
+			globalEnv, err := util.LoadGlobalEnvironment(ctx)
+			if err != nil {
+				return err
+			}
+
+			env, err := util.LoadEnvironment(ctx, cmd)
+			if err != nil {
+				return err
+			}
+
+			client := ent.FromContext(ctx)
+
+			var applied bool
+			var tmpPath string
+			var skipped []string
+			err = client.DoTransaction(ctx, nil, func(ctx context.Context, tx *ent.Tx) error {
+				globalVars, err := tx.Variable.Query().
+					Where(variable.EnvironmentID(globalEnv.ID)).
+					All(ctx)
+				if err != nil {
+					return clierrors.Exit(err, 1)
+				}
+
+				vars, err := tx.Variable.Query().
+					Where(variable.EnvironmentID(env.ID)).
+					Order(variable.ByName(sql.OrderAsc())).
+					All(ctx)
+				if err != nil {
+					return clierrors.Exit(err, 1)
+				}
+
+				state, err := tx.SyncState.Query().
+					Where(
+						syncstate.EnvironmentID(env.ID),
+						syncstate.Path(path),
+					).
+					Only(ctx)
+				if err != nil && !ent.IsNotFound(err) {
+					return clierrors.Exit(err, 1)
+				}
+
+				var baseVars map[string]string
+				if state != nil {
+					baseVars = state.Variables
+				}
+
+				envMap := util.MakeVariableMap(vars)
+
+				entries := compareSync(baseVars, makeSyncVariables(ctx, globalVars, vars), fileVars)
+				for _, e := range entries {
+					if v, ok := envMap[e.name]; ok {
+						e.secret = v.Secret && !showSecrets
+					}
+				}
+				skipExpandedSync(entries, envMap)
+
+				printSyncEntries(entries)
+
+				if dryRun {
+					return nil
+				}
+
+				err = resolveSyncConflicts(entries, prefer)
+				if err != nil {
+					return clierrors.Exit(err, 1)
+				}
+				skipExpandedSync(entries, envMap)
+				for _, e := range entries {
+					if e.expanded {
+						skipped = append(skipped, e.name)
+					}
+				}
+
+				result, synced, err := applySyncToDatabase(ctx, tx, env, vars, entries)
+				if err != nil {
+					return clierrors.Exit(fmt.Errorf("failed to update environment '%s': %w", env.Name, err), 1)
+				}
+
+				// The file is replaced only after the changes are committed,
+				// so that it is left as it is if the transaction fails.
+				if !maps.Equal(result, fileVars) {
+					tmpPath, err = writeSyncFile(path, result, envMap)
+					if err != nil {
+						return clierrors.Exit(err, 1)
+					}
+				}
+
+				err = tx.SyncState.Create().
+					SetEnvironment(env).
+					SetPath(path).
+					SetVariables(synced).
+					OnConflict().
+					UpdateNewValues().
+					Exec(ctx)
+				if err != nil {
+					return clierrors.Exit(fmt.Errorf("failed to record sync state: %w", err), 1)
+				}
+
+				applied = true
+
+				return nil
+			})
+			if err != nil {
+				if tmpPath != "" {
+					os.Remove(tmpPath)
+				}
+				return err
+			}
+
+			if tmpPath != "" {
+				err = os.Rename(tmpPath, path)
+				if err != nil {
+					os.Remove(tmpPath)
+					// Without the recorded state, the next synchronization
+					// reports the differences as conflicts instead of
+					// reverting the environment to the content of the file.
+					_, stateErr := client.SyncState.Delete().
+						Where(
+							syncstate.EnvironmentID(env.ID),
+							syncstate.Path(path),
+						).
+						Exec(ctx)
+					return clierrors.Exit(errors.Join(
+						fmt.Errorf("environment '%s' was updated, but failed to write environment file '%s': %w", env.Name, args[0], err),
+						stateErr,
+					), 1)
+				}
+			}
+
+			for _, name := range skipped {
+				fmt.Fprintf(os.Stderr, "Warning: variable '%s' has the expand flag, and was not updated from the file (use 'envoke var update' to change it)\n", name)
+			}
+
+			if applied {
+				fmt.Printf("Environment '%s' synchronized with '%s' successfully!\n", env.Name, args[0])
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("env", "e", "", "Specify the environment to synchronize")
+	cmd.Flags().String("prefer", "", "Resolve conflicts in favor of 'db' or 'file'")
+	cmd.Flags().Bool("dry-run", false, "Show the changes without applying them (default: false)")
+	cmd.Flags().Bool("show-secrets", false, "Show the values of secret variables (default: false)")
+
+	return cmd
+}
+
+type syncAction int
+
+const (
+	syncNone syncAction = iota
+	syncToFile
+	syncToDB
+	syncConflict
+	syncSkip
+)
+
+func (a syncAction) String() string {
+	switch a {
+	case syncToFile:
+		return "db -> file"
+	case syncToDB:
+		return "file -> db"
+	case syncConflict:
+		return "conflict"
+	case syncSkip:
+		return "skip"
+	default:
+		return ""
+	}
+}
+
+type syncValue struct {
+	value string
+	ok    bool
+}
+
+func lookupSyncValue(m map[string]string, name string) syncValue {
+	v, ok := m[name]
+	return syncValue{value: v, ok: ok}
+}
+
+func (v syncValue) String() string {
+	if !v.ok {
+		return "(none)"
+	}
+	return v.value
+}
+
+// display returns the value to be displayed, masked if secret is true.
+func (v syncValue) display(secret bool) string {
+	if !v.ok {
+		return v.String()
+	}
+	return util.MaskSecret(v.value, secret)
+}
+
+type syncEntry struct {
+	name   string
+	base   syncValue
+	db     syncValue
+	file   syncValue
+	action syncAction
+	// secret reports whether the values must be masked when displayed.
+	secret bool
+	// expanded reports whether a change of the file was skipped because the
+	// variable has the expand flag.
+	expanded bool
+}
+
+// makeSyncVariables returns the variables of an environment as they are
+// written to a .env file, expanding variables with the expand flag.
+func makeSyncVariables(ctx context.Context, globalVars, vars []*ent.Variable) map[string]string {
+	globalEnvMap := util.MakeVariableMap(globalVars)
+	envMap := util.MakeVariableMap(vars)
+
+	m := make(map[string]string, len(vars))
+	for _, v := range vars {
+		value := v.Value
+		if v.Expand {
+			value, _ = util.ExpandVariable(ctx, value, globalEnvMap, envMap, false)
+		}
+		m[v.Name] = value
+	}
+	return m
+}
+
+func compareSync(base, db, file map[string]string) []*syncEntry {
+	var names []string
+	for _, m := range []map[string]string{base, db, file} {
+		for name := range m {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	names = slices.Compact(names)
+
+	entries := make([]*syncEntry, 0, len(names))
+	for _, name := range names {
+		e := &syncEntry{
+			name: name,
+			base: lookupSyncValue(base, name),
+			db:   lookupSyncValue(db, name),
+			file: lookupSyncValue(file, name),
+		}
+
+		switch {
+		case e.db == e.file:
+			e.action = syncNone
+		case e.db == e.base:
+			e.action = syncToDB
+		case e.file == e.base:
+			e.action = syncToFile
+		default:
+			e.action = syncConflict
+		}
+
+		entries = append(entries, e)
+	}
+
+	return entries
+}
+
+func printSyncEntries(entries []*syncEntry) {
+	var changed int
+	for _, e := range entries {
+		if e.action != syncNone {
+			changed++
+		}
+	}
+	if changed == 0 {
+		fmt.Println("(Already in sync)")
+		return
+	}
+
+	headerFmt := color.New(color.ResetUnderline, color.Bold).SprintfFunc()
+
+	tbl := table.New("Name", "Last Synced", "Database", "File", "Action")
+	tbl.WithHeaderFormatter(headerFmt)
+
+	for _, e := range entries {
+		if e.action == syncNone {
+			continue
+		}
+		action := e.action.String()
+		if e.expanded {
+			action += " (expanded)"
+		}
+		tbl.AddRow(e.name, e.base.display(e.secret), e.db.display(e.secret), e.file.display(e.secret), action)
+	}
+
+	tbl.Print()
+}
+
+// skipExpandedSync skips the changes of the file to variables with the expand
+// flag, which are compared in their expanded form and would lose their
+// references if overwritten.
+func skipExpandedSync(entries []*syncEntry, envMap map[string]*ent.Variable) {
+	for _, e := range entries {
+		if e.action != syncToDB {
+			continue
+		}
+		if v, ok := envMap[e.name]; !ok || !v.Expand {
+			continue
+		}
+		e.action = syncSkip
+		e.expanded = true
+	}
+}
+
+func resolveSyncConflicts(entries []*syncEntry, prefer string) error {
+	var s *bufio.Scanner
+	for _, e := range entries {
+		if e.action != syncConflict {
+			continue
+		}
+
+		switch prefer {
+		case "db":
+			e.action = syncToFile
+			continue
+		case "file":
+			e.action = syncToDB
+			continue
+		}
+
+		if s == nil {
+			s = bufio.NewScanner(os.Stdin)
+		}
+
+		action, err := promptSyncConflict(s, e)
+		if err != nil {
+			return err
+		}
+		e.action = action
+	}
+
+	return nil
+}
+
+func promptSyncConflict(s *bufio.Scanner, e *syncEntry) (syncAction, error) {
+	for {
+		fmt.Printf("Conflict on '%s': database=%s, file=%s\n", e.name, e.db.display(e.secret), e.file.display(e.secret))
+		fmt.Print("Keep [d]atabase, [f]ile or [s]kip? ")
+
+		if !s.Scan() {
+			if err := s.Err(); err != nil {
+				return syncNone, fmt.Errorf("failed to read input: %w", err)
+			}
+			return syncNone, fmt.Errorf("no input provided")
+		}
+
+		switch strings.ToLower(strings.TrimSpace(s.Text())) {
+		case "d", "db", "database":
+			return syncToFile, nil
+		case "f", "file":
+			return syncToDB, nil
+		case "s", "skip":
+			return syncSkip, nil
+		}
+	}
+}
+
+// applySyncToDatabase applies the file side changes to the environment.
+// It returns the variables to be written to the file, and the variables to be
+// recorded as the last synced state.
+func applySyncToDatabase(ctx context.Context, tx *ent.Tx, env *ent.Environment, vars []*ent.Variable, entries []*syncEntry) (result, synced map[string]string, err error) {
+	envMap := util.MakeVariableMap(vars)
+
+	result = map[string]string{}
+	synced = map[string]string{}
+	for _, e := range entries {
+		var v syncValue
+		s := syncValue{}
+		switch e.action {
+		case syncToDB:
+			v = e.file
+
+			current, exists := envMap[e.name]
+			switch {
+			case !v.ok:
+				err := tx.Variable.DeleteOne(current).Exec(ctx)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to remove variable '%s': %w", e.name, err)
+				}
+			case exists:
+				err := tx.Variable.UpdateOne(current).
+					SetValue(v.value).
+					Exec(ctx)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to update variable '%s': %w", e.name, err)
+				}
+			default:
+				err := tx.Variable.Create().
+					SetEnvironment(env).
+					SetName(e.name).
+					SetValue(v.value).
+					Exec(ctx)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to create variable '%s': %w", e.name, err)
+				}
+			}
+			s = v
+		case syncSkip:
+			// Keep the file as it is, and the last synced state for the variable,
+			// so that the conflict is reported again next time.
+			v = e.file
+			s = e.base
+		default:
+			v = e.db
+			s = v
+		}
+
+		if v.ok {
+			result[e.name] = v.value
+		}
+		if s.ok {
+			synced[e.name] = s.value
+		}
+	}
+
+	return result, synced, nil
+}
+
+func readSyncFile(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]string{}, nil
+		}
+		return nil, fmt.Errorf("failed to open environment file '%s': %w", path, err)
+	}
+	defer file.Close()
+
+	envs, err := envfile.Parse(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse environment file '%s': %w", path, err)
+	}
+
+	m := map[string]string{}
+	for name, value := range envs.Envs() {
+		m[name] = value
+	}
+
+	return m, nil
+}
+
+// writeSyncFile writes vars to a temporary file in the directory of path, and
+// returns its name. The temporary file replaces path once renamed, and keeps
+// the permissions of path if it exists.
+func writeSyncFile(path string, vars map[string]string, envMap map[string]*ent.Variable) (string, error) {
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create environment file '%s': %w", path, err)
+	}
+	defer file.Close()
+
+	if info, err := os.Stat(path); err == nil {
+		err = file.Chmod(info.Mode().Perm())
+		if err != nil {
+			os.Remove(file.Name())
+			return "", fmt.Errorf("failed to create environment file '%s': %w", path, err)
+		}
+	}
+
+	err = writeSyncVariables(file, vars, envMap)
+	if err == nil {
+		err = file.Close()
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return "", fmt.Errorf("failed to write environment file '%s': %w", path, err)
+	}
+
+	return file.Name(), nil
+}
+
+func writeSyncVariables(w io.Writer, vars map[string]string, envMap map[string]*ent.Variable) error {
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	ew := newEnvWriter(w)
+	for _, name := range names {
+		if v, ok := envMap[name]; ok && v.Comment != "" {
+			err := ew.WriteComment(v.Comment)
+			if err != nil {
+				return err
+			}
+		}
+
+		err := ew.WriteVariable(name, vars[name])
+		if err != nil {
+			return err
+		}
+	}
+
+	return ew.Flush()
+}
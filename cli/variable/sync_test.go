@@ -0,0 +1,119 @@
+package variable
+
+import (
+	"testing"
+
+	"github.com/kechako/envoke/cli/util"
+	"github.com/kechako/envoke/ent"
+)
+
+func TestCompareSync(t *testing.T) {
+	tests := []struct {
+		name string
+		base map[string]string
+		db   map[string]string
+		file map[string]string
+		want map[string]syncAction
+	}{
+		{
+			name: "first sync",
+			base: nil,
+			db:   map[string]string{"A": "1", "B": "2"},
+			file: map[string]string{"B": "2", "C": "3"},
+			want: map[string]syncAction{"A": syncToFile, "B": syncNone, "C": syncToDB},
+		},
+		{
+			name: "changed on one side",
+			base: map[string]string{"A": "1", "B": "2"},
+			db:   map[string]string{"A": "10", "B": "2"},
+			file: map[string]string{"A": "1", "B": "20"},
+			want: map[string]syncAction{"A": syncToFile, "B": syncToDB},
+		},
+		{
+			name: "deleted on one side",
+			base: map[string]string{"A": "1", "B": "2"},
+			db:   map[string]string{"B": "2"},
+			file: map[string]string{"A": "1"},
+			want: map[string]syncAction{"A": syncToFile, "B": syncToDB},
+		},
+		{
+			name: "same change on both sides",
+			base: map[string]string{"A": "1"},
+			db:   map[string]string{"A": "2"},
+			file: map[string]string{"A": "2"},
+			want: map[string]syncAction{"A": syncNone},
+		},
+		{
+			name: "deleted on both sides",
+			base: map[string]string{"A": "1"},
+			db:   map[string]string{},
+			file: map[string]string{},
+			want: map[string]syncAction{"A": syncNone},
+		},
+		{
+			name: "conflict",
+			base: map[string]string{"A": "1", "B": "2"},
+			db:   map[string]string{"A": "10"},
+			file: map[string]string{"A": "100", "B": "20"},
+			want: map[string]syncAction{"A": syncConflict, "B": syncConflict},
+		},
+		{
+			name: "empty value is not missing",
+			base: map[string]string{"A": ""},
+			db:   map[string]string{"A": ""},
+			file: map[string]string{},
+			want: map[string]syncAction{"A": syncToDB},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := compareSync(tt.base, tt.db, tt.file)
+			if len(entries) != len(tt.want) {
+				t.Fatalf("compareSync() returned %d entries, want %d", len(entries), len(tt.want))
+			}
+			for i, e := range entries {
+				if i > 0 && entries[i-1].name >= e.name {
+					t.Errorf("compareSync(): entries are not sorted by name: %q before %q", entries[i-1].name, e.name)
+				}
+				want, ok := tt.want[e.name]
+				if !ok {
+					t.Errorf("compareSync(): unexpected entry %q", e.name)
+					continue
+				}
+				if e.action != want {
+					t.Errorf("compareSync(): action of %q = %v, want %v", e.name, e.action, want)
+				}
+			}
+		})
+	}
+}
+
+func TestSkipExpandedSync(t *testing.T) {
+	envMap := util.MakeVariableMap([]*ent.Variable{
+		{Name: "URL", Value: "https://${HOST}", Expand: true},
+		{Name: "HOST", Value: "example.com"},
+	})
+	entries := compareSync(
+		map[string]string{"URL": "https://example.com", "HOST": "example.com"},
+		map[string]string{"URL": "https://example.com", "HOST": "example.com"},
+		map[string]string{"URL": "https://example.org", "HOST": "example.org", "NEW": "1"},
+	)
+
+	skipExpandedSync(entries, envMap)
+
+	want := map[string]struct {
+		action   syncAction
+		expanded bool
+	}{
+		"HOST": {syncToDB, false},
+		"NEW":  {syncToDB, false},
+		"URL":  {syncSkip, true},
+	}
+	for _, e := range entries {
+		w := want[e.name]
+		if e.action != w.action || e.expanded != w.expanded {
+			t.Errorf("skipExpandedSync(): %q = (%v, %v), want (%v, %v)", e.name, e.action, e.expanded, w.action, w.expanded)
+		}
+	}
+}
@@ -38,7 +38,7 @@ func updateCommand() *cobra.Command {
 
 			client := ent.FromContext(ctx)
 
-			client.DoTransaction(ctx, nil, func(ctx context.Context, tx *ent.Tx) error {
+			err = client.DoTransaction(ctx, nil, func(ctx context.Context, tx *ent.Tx) error {
 				v, err := util.FindVariable(ctx, tx.Client(), env.ID, name)
 				if err != nil {
 					return clierrors.Exit(err, 1)
@@ -54,6 +54,9 @@ func updateCommand() *cobra.Command {
 
 				return nil
 			})
+			if err != nil {
+				return err
+			}
 
 			fmt.Printf("Environment variable '%s' updated successfully!\n", name)
 
@@ -63,6 +66,8 @@ func updateCommand() *cobra.Command {
 
 	cmd.Flags().String("comment", "", "Comment for the variable")
 	cmd.Flags().Bool("expand", false, "Expand the variable (default: false)")
+	cmd.Flags().Bool("secret", false, "Mark the variable as secret, or unmark it with --secret=false (default: unchanged)")
+	util.AddRuleFlags(cmd)
 
 	return cmd
 }
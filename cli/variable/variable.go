@@ -18,9 +18,15 @@ func Command() *cobra.Command {
 	cmd.AddCommand(
 		addCommand(),
 		exportCommand(),
+		graphCommand(),
 		importCommand(),
 		listCommand(),
+		refsCommand(),
 		removeCommand(),
+		renameCommand(),
+		requireCommand(),
+		searchCommand(),
+		unrequireCommand(),
 		updateCommand(),
 	)
 
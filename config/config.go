@@ -8,18 +8,147 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/goccy/go-yaml"
+	"github.com/kechako/envoke/varname"
 )
 
 type Config struct {
-	DBPath string `yaml:"db_path"`
+	DBPath      string       `yaml:"db_path"`
+	TrustedKeys []TrustedKey `yaml:"trusted_keys"`
+	Run         RunConfig    `yaml:"run"`
+
+	// Environment is the default environment used when -e is not given.
+	Environment string `yaml:"environment"`
+	// EnvPrefix is prepended to the environment names given on the command
+	// line, so that environments can be scoped to a project.
+	EnvPrefix string `yaml:"env_prefix"`
+	// Required is the list of variables that must be defined to run a command.
+	Required []RequiredVariable `yaml:"required"`
+	// Export holds the defaults of the export command.
+	Export ExportConfig `yaml:"export"`
+	// NamePolicy is the policy that variable names must follow: posix,
+	// allow-lowercase (default) or allow-dots.
+	NamePolicy string `yaml:"name_policy"`
+}
+
+// RequiredVariable is a variable that must be defined to run a command.
+// In YAML, it is either a name or a mapping with a name and a description.
+type RequiredVariable struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+}
+
+func (v *RequiredVariable) UnmarshalYAML(unmarshal func(any) error) error {
+	var name string
+	if err := unmarshal(&name); err == nil {
+		*v = RequiredVariable{Name: name}
+		return nil
+	}
+
+	type plain RequiredVariable
+	return unmarshal((*plain)(v))
+}
+
+// ValidateRequired checks the declarations of required variables.
+func ValidateRequired(required []RequiredVariable) error {
+	for i, v := range required {
+		if v.Name == "" {
+			return fmt.Errorf("required[%d]: name cannot be empty", i)
+		}
+	}
+	return nil
+}
+
+// ExportConfig holds the settings of the export command.
+type ExportConfig struct {
+	// Path is the file to export to when no file is given.
+	Path string `yaml:"path"`
+	// Format is the format of the exported file.
+	Format string `yaml:"format"`
+}
+
+// RunConfig holds the settings of the run command.
+type RunConfig struct {
+	// CleanKeep is the list of OS environment variables that are kept
+	// when running with --clean.
+	CleanKeep []string `yaml:"clean_keep"`
+}
+
+// DefaultCleanKeep is used when RunConfig.CleanKeep is not configured.
+var DefaultCleanKeep = []string{"PATH", "HOME", "TERM"}
+
+// GetCleanKeep returns the OS environment variables kept by run --clean.
+func (cfg *Config) GetCleanKeep() []string {
+	if cfg.Run.CleanKeep != nil {
+		return cfg.Run.CleanKeep
+	}
+	return DefaultCleanKeep
+}
+
+// TrustedKey is a named Ed25519 public key that is accepted when verifying
+// signed environment files.
+type TrustedKey struct {
+	Name      string `yaml:"name"`
+	PublicKey string `yaml:"public_key"`
 }
 
 func (cfg *Config) validate() error {
+	names := map[string]bool{}
+	for i, key := range cfg.TrustedKeys {
+		if key.Name == "" {
+			return fmt.Errorf("trusted_keys[%d]: name cannot be empty", i)
+		}
+		if key.PublicKey == "" {
+			return fmt.Errorf("trusted_keys[%d]: public_key cannot be empty", i)
+		}
+		if names[key.Name] {
+			return fmt.Errorf("trusted_keys[%d]: duplicate name '%s'", i, key.Name)
+		}
+		names[key.Name] = true
+	}
+
+	if err := ValidateRequired(cfg.Required); err != nil {
+		return err
+	}
+
+	if _, err := varname.ParsePolicy(cfg.NamePolicy); err != nil {
+		return fmt.Errorf("name_policy: %w", err)
+	}
+
 	return nil
 }
 
+// GetNamePolicy returns the policy that variable names must follow.
+func (cfg *Config) GetNamePolicy() varname.Policy {
+	p, err := varname.ParsePolicy(cfg.NamePolicy)
+	if err != nil {
+		return varname.DefaultPolicy
+	}
+	return p
+}
+
+// FindTrustedKey returns the trusted key with the given name.
+func (cfg *Config) FindTrustedKey(name string) (TrustedKey, bool) {
+	for _, key := range cfg.TrustedKeys {
+		if key.Name == name {
+			return key, true
+		}
+	}
+	return TrustedKey{}, false
+}
+
+// EnvironmentName returns the name of the environment given on the
+// command line, with EnvPrefix prepended. The global environment and names
+// that already have the prefix are returned as is.
+func (cfg *Config) EnvironmentName(name string) string {
+	if cfg.EnvPrefix == "" || name == "global" || strings.HasPrefix(name, cfg.EnvPrefix) {
+		return name
+	}
+	return cfg.EnvPrefix + name
+}
+
 func (cfg *Config) GetDBPath() (string, error) {
 	if cfg.DBPath != "" {
 		return cfg.DBPath, nil
@@ -0,0 +1,128 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/envoke/ent/allowedproject"
+)
+
+// AllowedProject is the model entity for the AllowedProject schema.
+type AllowedProject struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash string `json:"hash,omitempty"`
+	// AllowedAt holds the value of the "allowed_at" field.
+	AllowedAt    time.Time `json:"allowed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AllowedProject) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case allowedproject.FieldID:
+			values[i] = new(sql.NullInt64)
+		case allowedproject.FieldPath, allowedproject.FieldHash:
+			values[i] = new(sql.NullString)
+		case allowedproject.FieldAllowedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AllowedProject fields.
+func (ap *AllowedProject) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case allowedproject.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ap.ID = int(value.Int64)
+		case allowedproject.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				ap.Path = value.String
+			}
+		case allowedproject.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				ap.Hash = value.String
+			}
+		case allowedproject.FieldAllowedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_at", values[i])
+			} else if value.Valid {
+				ap.AllowedAt = value.Time
+			}
+		default:
+			ap.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AllowedProject.
+// This includes values selected through modifiers, order, etc.
+func (ap *AllowedProject) Value(name string) (ent.Value, error) {
+	return ap.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AllowedProject.
+// Note that you need to call AllowedProject.Unwrap() before calling this method if this AllowedProject
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ap *AllowedProject) Update() *AllowedProjectUpdateOne {
+	return NewAllowedProjectClient(ap.config).UpdateOne(ap)
+}
+
+// Unwrap unwraps the AllowedProject entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ap *AllowedProject) Unwrap() *AllowedProject {
+	_tx, ok := ap.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AllowedProject is not a transactional entity")
+	}
+	ap.config.driver = _tx.drv
+	return ap
+}
+
+// String implements the fmt.Stringer.
+func (ap *AllowedProject) String() string {
+	var builder strings.Builder
+	builder.WriteString("AllowedProject(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ap.ID))
+	builder.WriteString("path=")
+	builder.WriteString(ap.Path)
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(ap.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("allowed_at=")
+	builder.WriteString(ap.AllowedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AllowedProjects is a parsable slice of AllowedProject.
+type AllowedProjects []*AllowedProject
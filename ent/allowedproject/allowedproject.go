@@ -0,0 +1,76 @@
+// Code generated by ent, DO NOT EDIT.
+
+package allowedproject
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the allowedproject type in the database.
+	Label = "allowed_project"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPath holds the string denoting the path field in the database.
+	FieldPath = "path"
+	// FieldHash holds the string denoting the hash field in the database.
+	FieldHash = "hash"
+	// FieldAllowedAt holds the string denoting the allowed_at field in the database.
+	FieldAllowedAt = "allowed_at"
+	// Table holds the table name of the allowedproject in the database.
+	Table = "allowed_projects"
+)
+
+// Columns holds all SQL columns for allowedproject fields.
+var Columns = []string{
+	FieldID,
+	FieldPath,
+	FieldHash,
+	FieldAllowedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PathValidator is a validator for the "path" field. It is called by the builders before save.
+	PathValidator func(string) error
+	// HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	HashValidator func(string) error
+	// DefaultAllowedAt holds the default value on creation for the "allowed_at" field.
+	DefaultAllowedAt func() time.Time
+	// UpdateDefaultAllowedAt holds the default value on update for the "allowed_at" field.
+	UpdateDefaultAllowedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the AllowedProject queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPath orders the results by the path field.
+func ByPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPath, opts...).ToFunc()
+}
+
+// ByHash orders the results by the hash field.
+func ByHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHash, opts...).ToFunc()
+}
+
+// ByAllowedAt orders the results by the allowed_at field.
+func ByAllowedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAllowedAt, opts...).ToFunc()
+}
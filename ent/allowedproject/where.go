@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package allowedproject
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/envoke/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldLTE(FieldID, id))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldEQ(FieldPath, v))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldEQ(FieldHash, v))
+}
+
+// AllowedAt applies equality check predicate on the "allowed_at" field. It's identical to AllowedAtEQ.
+func AllowedAt(v time.Time) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldEQ(FieldAllowedAt, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldContainsFold(FieldPath, v))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldLTE(FieldHash, v))
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldContains(FieldHash, v))
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldHasPrefix(FieldHash, v))
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldHasSuffix(FieldHash, v))
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldEqualFold(FieldHash, v))
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldContainsFold(FieldHash, v))
+}
+
+// AllowedAtEQ applies the EQ predicate on the "allowed_at" field.
+func AllowedAtEQ(v time.Time) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldEQ(FieldAllowedAt, v))
+}
+
+// AllowedAtNEQ applies the NEQ predicate on the "allowed_at" field.
+func AllowedAtNEQ(v time.Time) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldNEQ(FieldAllowedAt, v))
+}
+
+// AllowedAtIn applies the In predicate on the "allowed_at" field.
+func AllowedAtIn(vs ...time.Time) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldIn(FieldAllowedAt, vs...))
+}
+
+// AllowedAtNotIn applies the NotIn predicate on the "allowed_at" field.
+func AllowedAtNotIn(vs ...time.Time) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldNotIn(FieldAllowedAt, vs...))
+}
+
+// AllowedAtGT applies the GT predicate on the "allowed_at" field.
+func AllowedAtGT(v time.Time) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldGT(FieldAllowedAt, v))
+}
+
+// AllowedAtGTE applies the GTE predicate on the "allowed_at" field.
+func AllowedAtGTE(v time.Time) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldGTE(FieldAllowedAt, v))
+}
+
+// AllowedAtLT applies the LT predicate on the "allowed_at" field.
+func AllowedAtLT(v time.Time) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldLT(FieldAllowedAt, v))
+}
+
+// AllowedAtLTE applies the LTE predicate on the "allowed_at" field.
+func AllowedAtLTE(v time.Time) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.FieldLTE(FieldAllowedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AllowedProject) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AllowedProject) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AllowedProject) predicate.AllowedProject {
+	return predicate.AllowedProject(sql.NotPredicates(p))
+}
@@ -0,0 +1,589 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/envoke/ent/allowedproject"
+)
+
+// AllowedProjectCreate is the builder for creating a AllowedProject entity.
+type AllowedProjectCreate struct {
+	config
+	mutation *AllowedProjectMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPath sets the "path" field.
+func (apc *AllowedProjectCreate) SetPath(s string) *AllowedProjectCreate {
+	apc.mutation.SetPath(s)
+	return apc
+}
+
+// SetHash sets the "hash" field.
+func (apc *AllowedProjectCreate) SetHash(s string) *AllowedProjectCreate {
+	apc.mutation.SetHash(s)
+	return apc
+}
+
+// SetAllowedAt sets the "allowed_at" field.
+func (apc *AllowedProjectCreate) SetAllowedAt(t time.Time) *AllowedProjectCreate {
+	apc.mutation.SetAllowedAt(t)
+	return apc
+}
+
+// SetNillableAllowedAt sets the "allowed_at" field if the given value is not nil.
+func (apc *AllowedProjectCreate) SetNillableAllowedAt(t *time.Time) *AllowedProjectCreate {
+	if t != nil {
+		apc.SetAllowedAt(*t)
+	}
+	return apc
+}
+
+// Mutation returns the AllowedProjectMutation object of the builder.
+func (apc *AllowedProjectCreate) Mutation() *AllowedProjectMutation {
+	return apc.mutation
+}
+
+// Save creates the AllowedProject in the database.
+func (apc *AllowedProjectCreate) Save(ctx context.Context) (*AllowedProject, error) {
+	apc.defaults()
+	return withHooks(ctx, apc.sqlSave, apc.mutation, apc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (apc *AllowedProjectCreate) SaveX(ctx context.Context) *AllowedProject {
+	v, err := apc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (apc *AllowedProjectCreate) Exec(ctx context.Context) error {
+	_, err := apc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apc *AllowedProjectCreate) ExecX(ctx context.Context) {
+	if err := apc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (apc *AllowedProjectCreate) defaults() {
+	if _, ok := apc.mutation.AllowedAt(); !ok {
+		v := allowedproject.DefaultAllowedAt()
+		apc.mutation.SetAllowedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (apc *AllowedProjectCreate) check() error {
+	if _, ok := apc.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "AllowedProject.path"`)}
+	}
+	if v, ok := apc.mutation.Path(); ok {
+		if err := allowedproject.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "AllowedProject.path": %w`, err)}
+		}
+	}
+	if _, ok := apc.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "AllowedProject.hash"`)}
+	}
+	if v, ok := apc.mutation.Hash(); ok {
+		if err := allowedproject.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "AllowedProject.hash": %w`, err)}
+		}
+	}
+	if _, ok := apc.mutation.AllowedAt(); !ok {
+		return &ValidationError{Name: "allowed_at", err: errors.New(`ent: missing required field "AllowedProject.allowed_at"`)}
+	}
+	return nil
+}
+
+func (apc *AllowedProjectCreate) sqlSave(ctx context.Context) (*AllowedProject, error) {
+	if err := apc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := apc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, apc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	apc.mutation.id = &_node.ID
+	apc.mutation.done = true
+	return _node, nil
+}
+
+func (apc *AllowedProjectCreate) createSpec() (*AllowedProject, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AllowedProject{config: apc.config}
+		_spec = sqlgraph.NewCreateSpec(allowedproject.Table, sqlgraph.NewFieldSpec(allowedproject.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = apc.conflict
+	if value, ok := apc.mutation.Path(); ok {
+		_spec.SetField(allowedproject.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := apc.mutation.Hash(); ok {
+		_spec.SetField(allowedproject.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := apc.mutation.AllowedAt(); ok {
+		_spec.SetField(allowedproject.FieldAllowedAt, field.TypeTime, value)
+		_node.AllowedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AllowedProject.Create().
+//		SetPath(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AllowedProjectUpsert) {
+//			SetPath(v+v).
+//		}).
+//		Exec(ctx)
+func (apc *AllowedProjectCreate) OnConflict(opts ...sql.ConflictOption) *AllowedProjectUpsertOne {
+	apc.conflict = opts
+	return &AllowedProjectUpsertOne{
+		create: apc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AllowedProject.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (apc *AllowedProjectCreate) OnConflictColumns(columns ...string) *AllowedProjectUpsertOne {
+	apc.conflict = append(apc.conflict, sql.ConflictColumns(columns...))
+	return &AllowedProjectUpsertOne{
+		create: apc,
+	}
+}
+
+type (
+	// AllowedProjectUpsertOne is the builder for "upsert"-ing
+	//  one AllowedProject node.
+	AllowedProjectUpsertOne struct {
+		create *AllowedProjectCreate
+	}
+
+	// AllowedProjectUpsert is the "OnConflict" setter.
+	AllowedProjectUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPath sets the "path" field.
+func (u *AllowedProjectUpsert) SetPath(v string) *AllowedProjectUpsert {
+	u.Set(allowedproject.FieldPath, v)
+	return u
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *AllowedProjectUpsert) UpdatePath() *AllowedProjectUpsert {
+	u.SetExcluded(allowedproject.FieldPath)
+	return u
+}
+
+// SetHash sets the "hash" field.
+func (u *AllowedProjectUpsert) SetHash(v string) *AllowedProjectUpsert {
+	u.Set(allowedproject.FieldHash, v)
+	return u
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *AllowedProjectUpsert) UpdateHash() *AllowedProjectUpsert {
+	u.SetExcluded(allowedproject.FieldHash)
+	return u
+}
+
+// SetAllowedAt sets the "allowed_at" field.
+func (u *AllowedProjectUpsert) SetAllowedAt(v time.Time) *AllowedProjectUpsert {
+	u.Set(allowedproject.FieldAllowedAt, v)
+	return u
+}
+
+// UpdateAllowedAt sets the "allowed_at" field to the value that was provided on create.
+func (u *AllowedProjectUpsert) UpdateAllowedAt() *AllowedProjectUpsert {
+	u.SetExcluded(allowedproject.FieldAllowedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.AllowedProject.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AllowedProjectUpsertOne) UpdateNewValues() *AllowedProjectUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AllowedProject.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AllowedProjectUpsertOne) Ignore() *AllowedProjectUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AllowedProjectUpsertOne) DoNothing() *AllowedProjectUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AllowedProjectCreate.OnConflict
+// documentation for more info.
+func (u *AllowedProjectUpsertOne) Update(set func(*AllowedProjectUpsert)) *AllowedProjectUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AllowedProjectUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPath sets the "path" field.
+func (u *AllowedProjectUpsertOne) SetPath(v string) *AllowedProjectUpsertOne {
+	return u.Update(func(s *AllowedProjectUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *AllowedProjectUpsertOne) UpdatePath() *AllowedProjectUpsertOne {
+	return u.Update(func(s *AllowedProjectUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetHash sets the "hash" field.
+func (u *AllowedProjectUpsertOne) SetHash(v string) *AllowedProjectUpsertOne {
+	return u.Update(func(s *AllowedProjectUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *AllowedProjectUpsertOne) UpdateHash() *AllowedProjectUpsertOne {
+	return u.Update(func(s *AllowedProjectUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// SetAllowedAt sets the "allowed_at" field.
+func (u *AllowedProjectUpsertOne) SetAllowedAt(v time.Time) *AllowedProjectUpsertOne {
+	return u.Update(func(s *AllowedProjectUpsert) {
+		s.SetAllowedAt(v)
+	})
+}
+
+// UpdateAllowedAt sets the "allowed_at" field to the value that was provided on create.
+func (u *AllowedProjectUpsertOne) UpdateAllowedAt() *AllowedProjectUpsertOne {
+	return u.Update(func(s *AllowedProjectUpsert) {
+		s.UpdateAllowedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AllowedProjectUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AllowedProjectCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AllowedProjectUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AllowedProjectUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AllowedProjectUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AllowedProjectCreateBulk is the builder for creating many AllowedProject entities in bulk.
+type AllowedProjectCreateBulk struct {
+	config
+	err      error
+	builders []*AllowedProjectCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AllowedProject entities in the database.
+func (apcb *AllowedProjectCreateBulk) Save(ctx context.Context) ([]*AllowedProject, error) {
+	if apcb.err != nil {
+		return nil, apcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(apcb.builders))
+	nodes := make([]*AllowedProject, len(apcb.builders))
+	mutators := make([]Mutator, len(apcb.builders))
+	for i := range apcb.builders {
+		func(i int, root context.Context) {
+			builder := apcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AllowedProjectMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, apcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = apcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, apcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, apcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (apcb *AllowedProjectCreateBulk) SaveX(ctx context.Context) []*AllowedProject {
+	v, err := apcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (apcb *AllowedProjectCreateBulk) Exec(ctx context.Context) error {
+	_, err := apcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apcb *AllowedProjectCreateBulk) ExecX(ctx context.Context) {
+	if err := apcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AllowedProject.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AllowedProjectUpsert) {
+//			SetPath(v+v).
+//		}).
+//		Exec(ctx)
+func (apcb *AllowedProjectCreateBulk) OnConflict(opts ...sql.ConflictOption) *AllowedProjectUpsertBulk {
+	apcb.conflict = opts
+	return &AllowedProjectUpsertBulk{
+		create: apcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AllowedProject.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (apcb *AllowedProjectCreateBulk) OnConflictColumns(columns ...string) *AllowedProjectUpsertBulk {
+	apcb.conflict = append(apcb.conflict, sql.ConflictColumns(columns...))
+	return &AllowedProjectUpsertBulk{
+		create: apcb,
+	}
+}
+
+// AllowedProjectUpsertBulk is the builder for "upsert"-ing
+// a bulk of AllowedProject nodes.
+type AllowedProjectUpsertBulk struct {
+	create *AllowedProjectCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AllowedProject.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AllowedProjectUpsertBulk) UpdateNewValues() *AllowedProjectUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AllowedProject.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AllowedProjectUpsertBulk) Ignore() *AllowedProjectUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AllowedProjectUpsertBulk) DoNothing() *AllowedProjectUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AllowedProjectCreateBulk.OnConflict
+// documentation for more info.
+func (u *AllowedProjectUpsertBulk) Update(set func(*AllowedProjectUpsert)) *AllowedProjectUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AllowedProjectUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPath sets the "path" field.
+func (u *AllowedProjectUpsertBulk) SetPath(v string) *AllowedProjectUpsertBulk {
+	return u.Update(func(s *AllowedProjectUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *AllowedProjectUpsertBulk) UpdatePath() *AllowedProjectUpsertBulk {
+	return u.Update(func(s *AllowedProjectUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetHash sets the "hash" field.
+func (u *AllowedProjectUpsertBulk) SetHash(v string) *AllowedProjectUpsertBulk {
+	return u.Update(func(s *AllowedProjectUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *AllowedProjectUpsertBulk) UpdateHash() *AllowedProjectUpsertBulk {
+	return u.Update(func(s *AllowedProjectUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// SetAllowedAt sets the "allowed_at" field.
+func (u *AllowedProjectUpsertBulk) SetAllowedAt(v time.Time) *AllowedProjectUpsertBulk {
+	return u.Update(func(s *AllowedProjectUpsert) {
+		s.SetAllowedAt(v)
+	})
+}
+
+// UpdateAllowedAt sets the "allowed_at" field to the value that was provided on create.
+func (u *AllowedProjectUpsertBulk) UpdateAllowedAt() *AllowedProjectUpsertBulk {
+	return u.Update(func(s *AllowedProjectUpsert) {
+		s.UpdateAllowedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AllowedProjectUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AllowedProjectCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AllowedProjectCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AllowedProjectUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
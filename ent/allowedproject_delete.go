@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/envoke/ent/allowedproject"
+	"github.com/kechako/envoke/ent/predicate"
+)
+
+// AllowedProjectDelete is the builder for deleting a AllowedProject entity.
+type AllowedProjectDelete struct {
+	config
+	hooks    []Hook
+	mutation *AllowedProjectMutation
+}
+
+// Where appends a list predicates to the AllowedProjectDelete builder.
+func (apd *AllowedProjectDelete) Where(ps ...predicate.AllowedProject) *AllowedProjectDelete {
+	apd.mutation.Where(ps...)
+	return apd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (apd *AllowedProjectDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, apd.sqlExec, apd.mutation, apd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apd *AllowedProjectDelete) ExecX(ctx context.Context) int {
+	n, err := apd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (apd *AllowedProjectDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(allowedproject.Table, sqlgraph.NewFieldSpec(allowedproject.FieldID, field.TypeInt))
+	if ps := apd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, apd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	apd.mutation.done = true
+	return affected, err
+}
+
+// AllowedProjectDeleteOne is the builder for deleting a single AllowedProject entity.
+type AllowedProjectDeleteOne struct {
+	apd *AllowedProjectDelete
+}
+
+// Where appends a list predicates to the AllowedProjectDelete builder.
+func (apdo *AllowedProjectDeleteOne) Where(ps ...predicate.AllowedProject) *AllowedProjectDeleteOne {
+	apdo.apd.mutation.Where(ps...)
+	return apdo
+}
+
+// Exec executes the deletion query.
+func (apdo *AllowedProjectDeleteOne) Exec(ctx context.Context) error {
+	n, err := apdo.apd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{allowedproject.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apdo *AllowedProjectDeleteOne) ExecX(ctx context.Context) {
+	if err := apdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
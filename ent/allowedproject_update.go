@@ -0,0 +1,316 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/envoke/ent/allowedproject"
+	"github.com/kechako/envoke/ent/predicate"
+)
+
+// AllowedProjectUpdate is the builder for updating AllowedProject entities.
+type AllowedProjectUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AllowedProjectMutation
+}
+
+// Where appends a list predicates to the AllowedProjectUpdate builder.
+func (apu *AllowedProjectUpdate) Where(ps ...predicate.AllowedProject) *AllowedProjectUpdate {
+	apu.mutation.Where(ps...)
+	return apu
+}
+
+// SetPath sets the "path" field.
+func (apu *AllowedProjectUpdate) SetPath(s string) *AllowedProjectUpdate {
+	apu.mutation.SetPath(s)
+	return apu
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (apu *AllowedProjectUpdate) SetNillablePath(s *string) *AllowedProjectUpdate {
+	if s != nil {
+		apu.SetPath(*s)
+	}
+	return apu
+}
+
+// SetHash sets the "hash" field.
+func (apu *AllowedProjectUpdate) SetHash(s string) *AllowedProjectUpdate {
+	apu.mutation.SetHash(s)
+	return apu
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (apu *AllowedProjectUpdate) SetNillableHash(s *string) *AllowedProjectUpdate {
+	if s != nil {
+		apu.SetHash(*s)
+	}
+	return apu
+}
+
+// SetAllowedAt sets the "allowed_at" field.
+func (apu *AllowedProjectUpdate) SetAllowedAt(t time.Time) *AllowedProjectUpdate {
+	apu.mutation.SetAllowedAt(t)
+	return apu
+}
+
+// Mutation returns the AllowedProjectMutation object of the builder.
+func (apu *AllowedProjectUpdate) Mutation() *AllowedProjectMutation {
+	return apu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (apu *AllowedProjectUpdate) Save(ctx context.Context) (int, error) {
+	apu.defaults()
+	return withHooks(ctx, apu.sqlSave, apu.mutation, apu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (apu *AllowedProjectUpdate) SaveX(ctx context.Context) int {
+	affected, err := apu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (apu *AllowedProjectUpdate) Exec(ctx context.Context) error {
+	_, err := apu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apu *AllowedProjectUpdate) ExecX(ctx context.Context) {
+	if err := apu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (apu *AllowedProjectUpdate) defaults() {
+	if _, ok := apu.mutation.AllowedAt(); !ok {
+		v := allowedproject.UpdateDefaultAllowedAt()
+		apu.mutation.SetAllowedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (apu *AllowedProjectUpdate) check() error {
+	if v, ok := apu.mutation.Path(); ok {
+		if err := allowedproject.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "AllowedProject.path": %w`, err)}
+		}
+	}
+	if v, ok := apu.mutation.Hash(); ok {
+		if err := allowedproject.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "AllowedProject.hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (apu *AllowedProjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := apu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(allowedproject.Table, allowedproject.Columns, sqlgraph.NewFieldSpec(allowedproject.FieldID, field.TypeInt))
+	if ps := apu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := apu.mutation.Path(); ok {
+		_spec.SetField(allowedproject.FieldPath, field.TypeString, value)
+	}
+	if value, ok := apu.mutation.Hash(); ok {
+		_spec.SetField(allowedproject.FieldHash, field.TypeString, value)
+	}
+	if value, ok := apu.mutation.AllowedAt(); ok {
+		_spec.SetField(allowedproject.FieldAllowedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, apu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{allowedproject.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	apu.mutation.done = true
+	return n, nil
+}
+
+// AllowedProjectUpdateOne is the builder for updating a single AllowedProject entity.
+type AllowedProjectUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AllowedProjectMutation
+}
+
+// SetPath sets the "path" field.
+func (apuo *AllowedProjectUpdateOne) SetPath(s string) *AllowedProjectUpdateOne {
+	apuo.mutation.SetPath(s)
+	return apuo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (apuo *AllowedProjectUpdateOne) SetNillablePath(s *string) *AllowedProjectUpdateOne {
+	if s != nil {
+		apuo.SetPath(*s)
+	}
+	return apuo
+}
+
+// SetHash sets the "hash" field.
+func (apuo *AllowedProjectUpdateOne) SetHash(s string) *AllowedProjectUpdateOne {
+	apuo.mutation.SetHash(s)
+	return apuo
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (apuo *AllowedProjectUpdateOne) SetNillableHash(s *string) *AllowedProjectUpdateOne {
+	if s != nil {
+		apuo.SetHash(*s)
+	}
+	return apuo
+}
+
+// SetAllowedAt sets the "allowed_at" field.
+func (apuo *AllowedProjectUpdateOne) SetAllowedAt(t time.Time) *AllowedProjectUpdateOne {
+	apuo.mutation.SetAllowedAt(t)
+	return apuo
+}
+
+// Mutation returns the AllowedProjectMutation object of the builder.
+func (apuo *AllowedProjectUpdateOne) Mutation() *AllowedProjectMutation {
+	return apuo.mutation
+}
+
+// Where appends a list predicates to the AllowedProjectUpdate builder.
+func (apuo *AllowedProjectUpdateOne) Where(ps ...predicate.AllowedProject) *AllowedProjectUpdateOne {
+	apuo.mutation.Where(ps...)
+	return apuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (apuo *AllowedProjectUpdateOne) Select(field string, fields ...string) *AllowedProjectUpdateOne {
+	apuo.fields = append([]string{field}, fields...)
+	return apuo
+}
+
+// Save executes the query and returns the updated AllowedProject entity.
+func (apuo *AllowedProjectUpdateOne) Save(ctx context.Context) (*AllowedProject, error) {
+	apuo.defaults()
+	return withHooks(ctx, apuo.sqlSave, apuo.mutation, apuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (apuo *AllowedProjectUpdateOne) SaveX(ctx context.Context) *AllowedProject {
+	node, err := apuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (apuo *AllowedProjectUpdateOne) Exec(ctx context.Context) error {
+	_, err := apuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apuo *AllowedProjectUpdateOne) ExecX(ctx context.Context) {
+	if err := apuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (apuo *AllowedProjectUpdateOne) defaults() {
+	if _, ok := apuo.mutation.AllowedAt(); !ok {
+		v := allowedproject.UpdateDefaultAllowedAt()
+		apuo.mutation.SetAllowedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (apuo *AllowedProjectUpdateOne) check() error {
+	if v, ok := apuo.mutation.Path(); ok {
+		if err := allowedproject.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "AllowedProject.path": %w`, err)}
+		}
+	}
+	if v, ok := apuo.mutation.Hash(); ok {
+		if err := allowedproject.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "AllowedProject.hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (apuo *AllowedProjectUpdateOne) sqlSave(ctx context.Context) (_node *AllowedProject, err error) {
+	if err := apuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(allowedproject.Table, allowedproject.Columns, sqlgraph.NewFieldSpec(allowedproject.FieldID, field.TypeInt))
+	id, ok := apuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AllowedProject.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := apuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, allowedproject.FieldID)
+		for _, f := range fields {
+			if !allowedproject.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != allowedproject.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := apuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := apuo.mutation.Path(); ok {
+		_spec.SetField(allowedproject.FieldPath, field.TypeString, value)
+	}
+	if value, ok := apuo.mutation.Hash(); ok {
+		_spec.SetField(allowedproject.FieldHash, field.TypeString, value)
+	}
+	if value, ok := apuo.mutation.AllowedAt(); ok {
+		_spec.SetField(allowedproject.FieldAllowedAt, field.TypeTime, value)
+	}
+	_node = &AllowedProject{config: apuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, apuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{allowedproject.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	apuo.mutation.done = true
+	return _node, nil
+}
@@ -15,7 +15,13 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/kechako/envoke/ent/allowedproject"
 	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/environmentschema"
+	"github.com/kechako/envoke/ent/mergestate"
+	"github.com/kechako/envoke/ent/requiredvariable"
+	"github.com/kechako/envoke/ent/schemakey"
+	"github.com/kechako/envoke/ent/syncstate"
 	"github.com/kechako/envoke/ent/variable"
 )
 
@@ -24,8 +30,20 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AllowedProject is the client for interacting with the AllowedProject builders.
+	AllowedProject *AllowedProjectClient
 	// Environment is the client for interacting with the Environment builders.
 	Environment *EnvironmentClient
+	// EnvironmentSchema is the client for interacting with the EnvironmentSchema builders.
+	EnvironmentSchema *EnvironmentSchemaClient
+	// MergeState is the client for interacting with the MergeState builders.
+	MergeState *MergeStateClient
+	// RequiredVariable is the client for interacting with the RequiredVariable builders.
+	RequiredVariable *RequiredVariableClient
+	// SchemaKey is the client for interacting with the SchemaKey builders.
+	SchemaKey *SchemaKeyClient
+	// SyncState is the client for interacting with the SyncState builders.
+	SyncState *SyncStateClient
 	// Variable is the client for interacting with the Variable builders.
 	Variable *VariableClient
 }
@@ -39,7 +57,13 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AllowedProject = NewAllowedProjectClient(c.config)
 	c.Environment = NewEnvironmentClient(c.config)
+	c.EnvironmentSchema = NewEnvironmentSchemaClient(c.config)
+	c.MergeState = NewMergeStateClient(c.config)
+	c.RequiredVariable = NewRequiredVariableClient(c.config)
+	c.SchemaKey = NewSchemaKeyClient(c.config)
+	c.SyncState = NewSyncStateClient(c.config)
 	c.Variable = NewVariableClient(c.config)
 }
 
@@ -131,10 +155,16 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Environment: NewEnvironmentClient(cfg),
-		Variable:    NewVariableClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		AllowedProject:    NewAllowedProjectClient(cfg),
+		Environment:       NewEnvironmentClient(cfg),
+		EnvironmentSchema: NewEnvironmentSchemaClient(cfg),
+		MergeState:        NewMergeStateClient(cfg),
+		RequiredVariable:  NewRequiredVariableClient(cfg),
+		SchemaKey:         NewSchemaKeyClient(cfg),
+		SyncState:         NewSyncStateClient(cfg),
+		Variable:          NewVariableClient(cfg),
 	}, nil
 }
 
@@ -152,17 +182,23 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Environment: NewEnvironmentClient(cfg),
-		Variable:    NewVariableClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		AllowedProject:    NewAllowedProjectClient(cfg),
+		Environment:       NewEnvironmentClient(cfg),
+		EnvironmentSchema: NewEnvironmentSchemaClient(cfg),
+		MergeState:        NewMergeStateClient(cfg),
+		RequiredVariable:  NewRequiredVariableClient(cfg),
+		SchemaKey:         NewSchemaKeyClient(cfg),
+		SyncState:         NewSyncStateClient(cfg),
+		Variable:          NewVariableClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Environment.
+//		AllowedProject.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -184,22 +220,42 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Environment.Use(hooks...)
-	c.Variable.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AllowedProject, c.Environment, c.EnvironmentSchema, c.MergeState,
+		c.RequiredVariable, c.SchemaKey, c.SyncState, c.Variable,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Environment.Intercept(interceptors...)
-	c.Variable.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AllowedProject, c.Environment, c.EnvironmentSchema, c.MergeState,
+		c.RequiredVariable, c.SchemaKey, c.SyncState, c.Variable,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AllowedProjectMutation:
+		return c.AllowedProject.mutate(ctx, m)
 	case *EnvironmentMutation:
 		return c.Environment.mutate(ctx, m)
+	case *EnvironmentSchemaMutation:
+		return c.EnvironmentSchema.mutate(ctx, m)
+	case *MergeStateMutation:
+		return c.MergeState.mutate(ctx, m)
+	case *RequiredVariableMutation:
+		return c.RequiredVariable.mutate(ctx, m)
+	case *SchemaKeyMutation:
+		return c.SchemaKey.mutate(ctx, m)
+	case *SyncStateMutation:
+		return c.SyncState.mutate(ctx, m)
 	case *VariableMutation:
 		return c.Variable.mutate(ctx, m)
 	default:
@@ -207,6 +263,139 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// AllowedProjectClient is a client for the AllowedProject schema.
+type AllowedProjectClient struct {
+	config
+}
+
+// NewAllowedProjectClient returns a client for the AllowedProject from the given config.
+func NewAllowedProjectClient(c config) *AllowedProjectClient {
+	return &AllowedProjectClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `allowedproject.Hooks(f(g(h())))`.
+func (c *AllowedProjectClient) Use(hooks ...Hook) {
+	c.hooks.AllowedProject = append(c.hooks.AllowedProject, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `allowedproject.Intercept(f(g(h())))`.
+func (c *AllowedProjectClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AllowedProject = append(c.inters.AllowedProject, interceptors...)
+}
+
+// Create returns a builder for creating a AllowedProject entity.
+func (c *AllowedProjectClient) Create() *AllowedProjectCreate {
+	mutation := newAllowedProjectMutation(c.config, OpCreate)
+	return &AllowedProjectCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AllowedProject entities.
+func (c *AllowedProjectClient) CreateBulk(builders ...*AllowedProjectCreate) *AllowedProjectCreateBulk {
+	return &AllowedProjectCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AllowedProjectClient) MapCreateBulk(slice any, setFunc func(*AllowedProjectCreate, int)) *AllowedProjectCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AllowedProjectCreateBulk{err: fmt.Errorf("calling to AllowedProjectClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AllowedProjectCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AllowedProjectCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AllowedProject.
+func (c *AllowedProjectClient) Update() *AllowedProjectUpdate {
+	mutation := newAllowedProjectMutation(c.config, OpUpdate)
+	return &AllowedProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AllowedProjectClient) UpdateOne(ap *AllowedProject) *AllowedProjectUpdateOne {
+	mutation := newAllowedProjectMutation(c.config, OpUpdateOne, withAllowedProject(ap))
+	return &AllowedProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AllowedProjectClient) UpdateOneID(id int) *AllowedProjectUpdateOne {
+	mutation := newAllowedProjectMutation(c.config, OpUpdateOne, withAllowedProjectID(id))
+	return &AllowedProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AllowedProject.
+func (c *AllowedProjectClient) Delete() *AllowedProjectDelete {
+	mutation := newAllowedProjectMutation(c.config, OpDelete)
+	return &AllowedProjectDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AllowedProjectClient) DeleteOne(ap *AllowedProject) *AllowedProjectDeleteOne {
+	return c.DeleteOneID(ap.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AllowedProjectClient) DeleteOneID(id int) *AllowedProjectDeleteOne {
+	builder := c.Delete().Where(allowedproject.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AllowedProjectDeleteOne{builder}
+}
+
+// Query returns a query builder for AllowedProject.
+func (c *AllowedProjectClient) Query() *AllowedProjectQuery {
+	return &AllowedProjectQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAllowedProject},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AllowedProject entity by its id.
+func (c *AllowedProjectClient) Get(ctx context.Context, id int) (*AllowedProject, error) {
+	return c.Query().Where(allowedproject.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AllowedProjectClient) GetX(ctx context.Context, id int) *AllowedProject {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AllowedProjectClient) Hooks() []Hook {
+	return c.hooks.AllowedProject
+}
+
+// Interceptors returns the client interceptors.
+func (c *AllowedProjectClient) Interceptors() []Interceptor {
+	return c.inters.AllowedProject
+}
+
+func (c *AllowedProjectClient) mutate(ctx context.Context, m *AllowedProjectMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AllowedProjectCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AllowedProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AllowedProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AllowedProjectDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AllowedProject mutation op: %q", m.Op())
+	}
+}
+
 // EnvironmentClient is a client for the Environment schema.
 type EnvironmentClient struct {
 	config
@@ -331,6 +520,70 @@ func (c *EnvironmentClient) QueryVariables(e *Environment) *VariableQuery {
 	return query
 }
 
+// QuerySyncStates queries the sync_states edge of a Environment.
+func (c *EnvironmentClient) QuerySyncStates(e *Environment) *SyncStateQuery {
+	query := (&SyncStateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, id),
+			sqlgraph.To(syncstate.Table, syncstate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environment.SyncStatesTable, environment.SyncStatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRequiredVariables queries the required_variables edge of a Environment.
+func (c *EnvironmentClient) QueryRequiredVariables(e *Environment) *RequiredVariableQuery {
+	query := (&RequiredVariableClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, id),
+			sqlgraph.To(requiredvariable.Table, requiredvariable.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environment.RequiredVariablesTable, environment.RequiredVariablesColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMergeStates queries the merge_states edge of a Environment.
+func (c *EnvironmentClient) QueryMergeStates(e *Environment) *MergeStateQuery {
+	query := (&MergeStateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, id),
+			sqlgraph.To(mergestate.Table, mergestate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environment.MergeStatesTable, environment.MergeStatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySchema queries the schema edge of a Environment.
+func (c *EnvironmentClient) QuerySchema(e *Environment) *EnvironmentSchemaQuery {
+	query := (&EnvironmentSchemaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, id),
+			sqlgraph.To(environmentschema.Table, environmentschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, environment.SchemaTable, environment.SchemaColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *EnvironmentClient) Hooks() []Hook {
 	return c.hooks.Environment
@@ -356,6 +609,768 @@ func (c *EnvironmentClient) mutate(ctx context.Context, m *EnvironmentMutation)
 	}
 }
 
+// EnvironmentSchemaClient is a client for the EnvironmentSchema schema.
+type EnvironmentSchemaClient struct {
+	config
+}
+
+// NewEnvironmentSchemaClient returns a client for the EnvironmentSchema from the given config.
+func NewEnvironmentSchemaClient(c config) *EnvironmentSchemaClient {
+	return &EnvironmentSchemaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `environmentschema.Hooks(f(g(h())))`.
+func (c *EnvironmentSchemaClient) Use(hooks ...Hook) {
+	c.hooks.EnvironmentSchema = append(c.hooks.EnvironmentSchema, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `environmentschema.Intercept(f(g(h())))`.
+func (c *EnvironmentSchemaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EnvironmentSchema = append(c.inters.EnvironmentSchema, interceptors...)
+}
+
+// Create returns a builder for creating a EnvironmentSchema entity.
+func (c *EnvironmentSchemaClient) Create() *EnvironmentSchemaCreate {
+	mutation := newEnvironmentSchemaMutation(c.config, OpCreate)
+	return &EnvironmentSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EnvironmentSchema entities.
+func (c *EnvironmentSchemaClient) CreateBulk(builders ...*EnvironmentSchemaCreate) *EnvironmentSchemaCreateBulk {
+	return &EnvironmentSchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EnvironmentSchemaClient) MapCreateBulk(slice any, setFunc func(*EnvironmentSchemaCreate, int)) *EnvironmentSchemaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EnvironmentSchemaCreateBulk{err: fmt.Errorf("calling to EnvironmentSchemaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EnvironmentSchemaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EnvironmentSchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EnvironmentSchema.
+func (c *EnvironmentSchemaClient) Update() *EnvironmentSchemaUpdate {
+	mutation := newEnvironmentSchemaMutation(c.config, OpUpdate)
+	return &EnvironmentSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EnvironmentSchemaClient) UpdateOne(es *EnvironmentSchema) *EnvironmentSchemaUpdateOne {
+	mutation := newEnvironmentSchemaMutation(c.config, OpUpdateOne, withEnvironmentSchema(es))
+	return &EnvironmentSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EnvironmentSchemaClient) UpdateOneID(id int) *EnvironmentSchemaUpdateOne {
+	mutation := newEnvironmentSchemaMutation(c.config, OpUpdateOne, withEnvironmentSchemaID(id))
+	return &EnvironmentSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EnvironmentSchema.
+func (c *EnvironmentSchemaClient) Delete() *EnvironmentSchemaDelete {
+	mutation := newEnvironmentSchemaMutation(c.config, OpDelete)
+	return &EnvironmentSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EnvironmentSchemaClient) DeleteOne(es *EnvironmentSchema) *EnvironmentSchemaDeleteOne {
+	return c.DeleteOneID(es.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EnvironmentSchemaClient) DeleteOneID(id int) *EnvironmentSchemaDeleteOne {
+	builder := c.Delete().Where(environmentschema.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EnvironmentSchemaDeleteOne{builder}
+}
+
+// Query returns a query builder for EnvironmentSchema.
+func (c *EnvironmentSchemaClient) Query() *EnvironmentSchemaQuery {
+	return &EnvironmentSchemaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEnvironmentSchema},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EnvironmentSchema entity by its id.
+func (c *EnvironmentSchemaClient) Get(ctx context.Context, id int) (*EnvironmentSchema, error) {
+	return c.Query().Where(environmentschema.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EnvironmentSchemaClient) GetX(ctx context.Context, id int) *EnvironmentSchema {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryKeys queries the keys edge of a EnvironmentSchema.
+func (c *EnvironmentSchemaClient) QueryKeys(es *EnvironmentSchema) *SchemaKeyQuery {
+	query := (&SchemaKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := es.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environmentschema.Table, environmentschema.FieldID, id),
+			sqlgraph.To(schemakey.Table, schemakey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environmentschema.KeysTable, environmentschema.KeysColumn),
+		)
+		fromV = sqlgraph.Neighbors(es.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEnvironments queries the environments edge of a EnvironmentSchema.
+func (c *EnvironmentSchemaClient) QueryEnvironments(es *EnvironmentSchema) *EnvironmentQuery {
+	query := (&EnvironmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := es.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environmentschema.Table, environmentschema.FieldID, id),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environmentschema.EnvironmentsTable, environmentschema.EnvironmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(es.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EnvironmentSchemaClient) Hooks() []Hook {
+	return c.hooks.EnvironmentSchema
+}
+
+// Interceptors returns the client interceptors.
+func (c *EnvironmentSchemaClient) Interceptors() []Interceptor {
+	return c.inters.EnvironmentSchema
+}
+
+func (c *EnvironmentSchemaClient) mutate(ctx context.Context, m *EnvironmentSchemaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EnvironmentSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EnvironmentSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EnvironmentSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EnvironmentSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EnvironmentSchema mutation op: %q", m.Op())
+	}
+}
+
+// MergeStateClient is a client for the MergeState schema.
+type MergeStateClient struct {
+	config
+}
+
+// NewMergeStateClient returns a client for the MergeState from the given config.
+func NewMergeStateClient(c config) *MergeStateClient {
+	return &MergeStateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mergestate.Hooks(f(g(h())))`.
+func (c *MergeStateClient) Use(hooks ...Hook) {
+	c.hooks.MergeState = append(c.hooks.MergeState, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mergestate.Intercept(f(g(h())))`.
+func (c *MergeStateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MergeState = append(c.inters.MergeState, interceptors...)
+}
+
+// Create returns a builder for creating a MergeState entity.
+func (c *MergeStateClient) Create() *MergeStateCreate {
+	mutation := newMergeStateMutation(c.config, OpCreate)
+	return &MergeStateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MergeState entities.
+func (c *MergeStateClient) CreateBulk(builders ...*MergeStateCreate) *MergeStateCreateBulk {
+	return &MergeStateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MergeStateClient) MapCreateBulk(slice any, setFunc func(*MergeStateCreate, int)) *MergeStateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MergeStateCreateBulk{err: fmt.Errorf("calling to MergeStateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MergeStateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MergeStateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MergeState.
+func (c *MergeStateClient) Update() *MergeStateUpdate {
+	mutation := newMergeStateMutation(c.config, OpUpdate)
+	return &MergeStateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MergeStateClient) UpdateOne(ms *MergeState) *MergeStateUpdateOne {
+	mutation := newMergeStateMutation(c.config, OpUpdateOne, withMergeState(ms))
+	return &MergeStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MergeStateClient) UpdateOneID(id int) *MergeStateUpdateOne {
+	mutation := newMergeStateMutation(c.config, OpUpdateOne, withMergeStateID(id))
+	return &MergeStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MergeState.
+func (c *MergeStateClient) Delete() *MergeStateDelete {
+	mutation := newMergeStateMutation(c.config, OpDelete)
+	return &MergeStateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MergeStateClient) DeleteOne(ms *MergeState) *MergeStateDeleteOne {
+	return c.DeleteOneID(ms.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MergeStateClient) DeleteOneID(id int) *MergeStateDeleteOne {
+	builder := c.Delete().Where(mergestate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MergeStateDeleteOne{builder}
+}
+
+// Query returns a query builder for MergeState.
+func (c *MergeStateClient) Query() *MergeStateQuery {
+	return &MergeStateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMergeState},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MergeState entity by its id.
+func (c *MergeStateClient) Get(ctx context.Context, id int) (*MergeState, error) {
+	return c.Query().Where(mergestate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MergeStateClient) GetX(ctx context.Context, id int) *MergeState {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEnvironment queries the environment edge of a MergeState.
+func (c *MergeStateClient) QueryEnvironment(ms *MergeState) *EnvironmentQuery {
+	query := (&EnvironmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ms.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mergestate.Table, mergestate.FieldID, id),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, mergestate.EnvironmentTable, mergestate.EnvironmentColumn),
+		)
+		fromV = sqlgraph.Neighbors(ms.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MergeStateClient) Hooks() []Hook {
+	return c.hooks.MergeState
+}
+
+// Interceptors returns the client interceptors.
+func (c *MergeStateClient) Interceptors() []Interceptor {
+	return c.inters.MergeState
+}
+
+func (c *MergeStateClient) mutate(ctx context.Context, m *MergeStateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MergeStateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MergeStateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MergeStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MergeStateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MergeState mutation op: %q", m.Op())
+	}
+}
+
+// RequiredVariableClient is a client for the RequiredVariable schema.
+type RequiredVariableClient struct {
+	config
+}
+
+// NewRequiredVariableClient returns a client for the RequiredVariable from the given config.
+func NewRequiredVariableClient(c config) *RequiredVariableClient {
+	return &RequiredVariableClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `requiredvariable.Hooks(f(g(h())))`.
+func (c *RequiredVariableClient) Use(hooks ...Hook) {
+	c.hooks.RequiredVariable = append(c.hooks.RequiredVariable, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `requiredvariable.Intercept(f(g(h())))`.
+func (c *RequiredVariableClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RequiredVariable = append(c.inters.RequiredVariable, interceptors...)
+}
+
+// Create returns a builder for creating a RequiredVariable entity.
+func (c *RequiredVariableClient) Create() *RequiredVariableCreate {
+	mutation := newRequiredVariableMutation(c.config, OpCreate)
+	return &RequiredVariableCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RequiredVariable entities.
+func (c *RequiredVariableClient) CreateBulk(builders ...*RequiredVariableCreate) *RequiredVariableCreateBulk {
+	return &RequiredVariableCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RequiredVariableClient) MapCreateBulk(slice any, setFunc func(*RequiredVariableCreate, int)) *RequiredVariableCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RequiredVariableCreateBulk{err: fmt.Errorf("calling to RequiredVariableClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RequiredVariableCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RequiredVariableCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RequiredVariable.
+func (c *RequiredVariableClient) Update() *RequiredVariableUpdate {
+	mutation := newRequiredVariableMutation(c.config, OpUpdate)
+	return &RequiredVariableUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RequiredVariableClient) UpdateOne(rv *RequiredVariable) *RequiredVariableUpdateOne {
+	mutation := newRequiredVariableMutation(c.config, OpUpdateOne, withRequiredVariable(rv))
+	return &RequiredVariableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RequiredVariableClient) UpdateOneID(id int) *RequiredVariableUpdateOne {
+	mutation := newRequiredVariableMutation(c.config, OpUpdateOne, withRequiredVariableID(id))
+	return &RequiredVariableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RequiredVariable.
+func (c *RequiredVariableClient) Delete() *RequiredVariableDelete {
+	mutation := newRequiredVariableMutation(c.config, OpDelete)
+	return &RequiredVariableDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RequiredVariableClient) DeleteOne(rv *RequiredVariable) *RequiredVariableDeleteOne {
+	return c.DeleteOneID(rv.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RequiredVariableClient) DeleteOneID(id int) *RequiredVariableDeleteOne {
+	builder := c.Delete().Where(requiredvariable.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RequiredVariableDeleteOne{builder}
+}
+
+// Query returns a query builder for RequiredVariable.
+func (c *RequiredVariableClient) Query() *RequiredVariableQuery {
+	return &RequiredVariableQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRequiredVariable},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RequiredVariable entity by its id.
+func (c *RequiredVariableClient) Get(ctx context.Context, id int) (*RequiredVariable, error) {
+	return c.Query().Where(requiredvariable.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RequiredVariableClient) GetX(ctx context.Context, id int) *RequiredVariable {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEnvironment queries the environment edge of a RequiredVariable.
+func (c *RequiredVariableClient) QueryEnvironment(rv *RequiredVariable) *EnvironmentQuery {
+	query := (&EnvironmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(requiredvariable.Table, requiredvariable.FieldID, id),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, requiredvariable.EnvironmentTable, requiredvariable.EnvironmentColumn),
+		)
+		fromV = sqlgraph.Neighbors(rv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RequiredVariableClient) Hooks() []Hook {
+	return c.hooks.RequiredVariable
+}
+
+// Interceptors returns the client interceptors.
+func (c *RequiredVariableClient) Interceptors() []Interceptor {
+	return c.inters.RequiredVariable
+}
+
+func (c *RequiredVariableClient) mutate(ctx context.Context, m *RequiredVariableMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RequiredVariableCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RequiredVariableUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RequiredVariableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RequiredVariableDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RequiredVariable mutation op: %q", m.Op())
+	}
+}
+
+// SchemaKeyClient is a client for the SchemaKey schema.
+type SchemaKeyClient struct {
+	config
+}
+
+// NewSchemaKeyClient returns a client for the SchemaKey from the given config.
+func NewSchemaKeyClient(c config) *SchemaKeyClient {
+	return &SchemaKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `schemakey.Hooks(f(g(h())))`.
+func (c *SchemaKeyClient) Use(hooks ...Hook) {
+	c.hooks.SchemaKey = append(c.hooks.SchemaKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `schemakey.Intercept(f(g(h())))`.
+func (c *SchemaKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SchemaKey = append(c.inters.SchemaKey, interceptors...)
+}
+
+// Create returns a builder for creating a SchemaKey entity.
+func (c *SchemaKeyClient) Create() *SchemaKeyCreate {
+	mutation := newSchemaKeyMutation(c.config, OpCreate)
+	return &SchemaKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SchemaKey entities.
+func (c *SchemaKeyClient) CreateBulk(builders ...*SchemaKeyCreate) *SchemaKeyCreateBulk {
+	return &SchemaKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SchemaKeyClient) MapCreateBulk(slice any, setFunc func(*SchemaKeyCreate, int)) *SchemaKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SchemaKeyCreateBulk{err: fmt.Errorf("calling to SchemaKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SchemaKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SchemaKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SchemaKey.
+func (c *SchemaKeyClient) Update() *SchemaKeyUpdate {
+	mutation := newSchemaKeyMutation(c.config, OpUpdate)
+	return &SchemaKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SchemaKeyClient) UpdateOne(sk *SchemaKey) *SchemaKeyUpdateOne {
+	mutation := newSchemaKeyMutation(c.config, OpUpdateOne, withSchemaKey(sk))
+	return &SchemaKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SchemaKeyClient) UpdateOneID(id int) *SchemaKeyUpdateOne {
+	mutation := newSchemaKeyMutation(c.config, OpUpdateOne, withSchemaKeyID(id))
+	return &SchemaKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SchemaKey.
+func (c *SchemaKeyClient) Delete() *SchemaKeyDelete {
+	mutation := newSchemaKeyMutation(c.config, OpDelete)
+	return &SchemaKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SchemaKeyClient) DeleteOne(sk *SchemaKey) *SchemaKeyDeleteOne {
+	return c.DeleteOneID(sk.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SchemaKeyClient) DeleteOneID(id int) *SchemaKeyDeleteOne {
+	builder := c.Delete().Where(schemakey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SchemaKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for SchemaKey.
+func (c *SchemaKeyClient) Query() *SchemaKeyQuery {
+	return &SchemaKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSchemaKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SchemaKey entity by its id.
+func (c *SchemaKeyClient) Get(ctx context.Context, id int) (*SchemaKey, error) {
+	return c.Query().Where(schemakey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SchemaKeyClient) GetX(ctx context.Context, id int) *SchemaKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySchema queries the schema edge of a SchemaKey.
+func (c *SchemaKeyClient) QuerySchema(sk *SchemaKey) *EnvironmentSchemaQuery {
+	query := (&EnvironmentSchemaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sk.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(schemakey.Table, schemakey.FieldID, id),
+			sqlgraph.To(environmentschema.Table, environmentschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, schemakey.SchemaTable, schemakey.SchemaColumn),
+		)
+		fromV = sqlgraph.Neighbors(sk.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SchemaKeyClient) Hooks() []Hook {
+	hooks := c.hooks.SchemaKey
+	return append(hooks[:len(hooks):len(hooks)], schemakey.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SchemaKeyClient) Interceptors() []Interceptor {
+	return c.inters.SchemaKey
+}
+
+func (c *SchemaKeyClient) mutate(ctx context.Context, m *SchemaKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SchemaKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SchemaKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SchemaKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SchemaKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SchemaKey mutation op: %q", m.Op())
+	}
+}
+
+// SyncStateClient is a client for the SyncState schema.
+type SyncStateClient struct {
+	config
+}
+
+// NewSyncStateClient returns a client for the SyncState from the given config.
+func NewSyncStateClient(c config) *SyncStateClient {
+	return &SyncStateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `syncstate.Hooks(f(g(h())))`.
+func (c *SyncStateClient) Use(hooks ...Hook) {
+	c.hooks.SyncState = append(c.hooks.SyncState, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `syncstate.Intercept(f(g(h())))`.
+func (c *SyncStateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SyncState = append(c.inters.SyncState, interceptors...)
+}
+
+// Create returns a builder for creating a SyncState entity.
+func (c *SyncStateClient) Create() *SyncStateCreate {
+	mutation := newSyncStateMutation(c.config, OpCreate)
+	return &SyncStateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SyncState entities.
+func (c *SyncStateClient) CreateBulk(builders ...*SyncStateCreate) *SyncStateCreateBulk {
+	return &SyncStateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SyncStateClient) MapCreateBulk(slice any, setFunc func(*SyncStateCreate, int)) *SyncStateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SyncStateCreateBulk{err: fmt.Errorf("calling to SyncStateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SyncStateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SyncStateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SyncState.
+func (c *SyncStateClient) Update() *SyncStateUpdate {
+	mutation := newSyncStateMutation(c.config, OpUpdate)
+	return &SyncStateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SyncStateClient) UpdateOne(ss *SyncState) *SyncStateUpdateOne {
+	mutation := newSyncStateMutation(c.config, OpUpdateOne, withSyncState(ss))
+	return &SyncStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SyncStateClient) UpdateOneID(id int) *SyncStateUpdateOne {
+	mutation := newSyncStateMutation(c.config, OpUpdateOne, withSyncStateID(id))
+	return &SyncStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SyncState.
+func (c *SyncStateClient) Delete() *SyncStateDelete {
+	mutation := newSyncStateMutation(c.config, OpDelete)
+	return &SyncStateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SyncStateClient) DeleteOne(ss *SyncState) *SyncStateDeleteOne {
+	return c.DeleteOneID(ss.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SyncStateClient) DeleteOneID(id int) *SyncStateDeleteOne {
+	builder := c.Delete().Where(syncstate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SyncStateDeleteOne{builder}
+}
+
+// Query returns a query builder for SyncState.
+func (c *SyncStateClient) Query() *SyncStateQuery {
+	return &SyncStateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSyncState},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SyncState entity by its id.
+func (c *SyncStateClient) Get(ctx context.Context, id int) (*SyncState, error) {
+	return c.Query().Where(syncstate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SyncStateClient) GetX(ctx context.Context, id int) *SyncState {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEnvironment queries the environment edge of a SyncState.
+func (c *SyncStateClient) QueryEnvironment(ss *SyncState) *EnvironmentQuery {
+	query := (&EnvironmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ss.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(syncstate.Table, syncstate.FieldID, id),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, syncstate.EnvironmentTable, syncstate.EnvironmentColumn),
+		)
+		fromV = sqlgraph.Neighbors(ss.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SyncStateClient) Hooks() []Hook {
+	return c.hooks.SyncState
+}
+
+// Interceptors returns the client interceptors.
+func (c *SyncStateClient) Interceptors() []Interceptor {
+	return c.inters.SyncState
+}
+
+func (c *SyncStateClient) mutate(ctx context.Context, m *SyncStateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SyncStateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SyncStateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SyncStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SyncStateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SyncState mutation op: %q", m.Op())
+	}
+}
+
 // VariableClient is a client for the Variable schema.
 type VariableClient struct {
 	config
@@ -482,7 +1497,8 @@ func (c *VariableClient) QueryEnvironment(v *Variable) *EnvironmentQuery {
 
 // Hooks returns the client hooks.
 func (c *VariableClient) Hooks() []Hook {
-	return c.hooks.Variable
+	hooks := c.hooks.Variable
+	return append(hooks[:len(hooks):len(hooks)], variable.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -508,9 +1524,11 @@ func (c *VariableClient) mutate(ctx context.Context, m *VariableMutation) (Value
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Environment, Variable []ent.Hook
+		AllowedProject, Environment, EnvironmentSchema, MergeState, RequiredVariable,
+		SchemaKey, SyncState, Variable []ent.Hook
 	}
 	inters struct {
-		Environment, Variable []ent.Interceptor
+		AllowedProject, Environment, EnvironmentSchema, MergeState, RequiredVariable,
+		SchemaKey, SyncState, Variable []ent.Interceptor
 	}
 )
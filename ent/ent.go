@@ -12,7 +12,13 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/kechako/envoke/ent/allowedproject"
 	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/environmentschema"
+	"github.com/kechako/envoke/ent/mergestate"
+	"github.com/kechako/envoke/ent/requiredvariable"
+	"github.com/kechako/envoke/ent/schemakey"
+	"github.com/kechako/envoke/ent/syncstate"
 	"github.com/kechako/envoke/ent/variable"
 )
 
@@ -74,8 +80,14 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			environment.Table: environment.ValidColumn,
-			variable.Table:    variable.ValidColumn,
+			allowedproject.Table:    allowedproject.ValidColumn,
+			environment.Table:       environment.ValidColumn,
+			environmentschema.Table: environmentschema.ValidColumn,
+			mergestate.Table:        mergestate.ValidColumn,
+			requiredvariable.Table:  requiredvariable.ValidColumn,
+			schemakey.Table:         schemakey.ValidColumn,
+			syncstate.Table:         syncstate.ValidColumn,
+			variable.Table:          variable.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -5,10 +5,12 @@ package ent
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/environmentschema"
 )
 
 // Environment is the model entity for the Environment schema.
@@ -16,10 +18,16 @@ type Environment struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
+	// SchemaID holds the value of the "schema_id" field.
+	SchemaID *int `json:"schema_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EnvironmentQuery when eager-loading is set.
 	Edges        EnvironmentEdges `json:"edges"`
@@ -30,9 +38,17 @@ type Environment struct {
 type EnvironmentEdges struct {
 	// Variables holds the value of the variables edge.
 	Variables []*Variable `json:"variables,omitempty"`
+	// SyncStates holds the value of the sync_states edge.
+	SyncStates []*SyncState `json:"sync_states,omitempty"`
+	// RequiredVariables holds the value of the required_variables edge.
+	RequiredVariables []*RequiredVariable `json:"required_variables,omitempty"`
+	// MergeStates holds the value of the merge_states edge.
+	MergeStates []*MergeState `json:"merge_states,omitempty"`
+	// Schema holds the value of the schema edge.
+	Schema *EnvironmentSchema `json:"schema,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [5]bool
 }
 
 // VariablesOrErr returns the Variables value or an error if the edge
@@ -44,15 +60,55 @@ func (e EnvironmentEdges) VariablesOrErr() ([]*Variable, error) {
 	return nil, &NotLoadedError{edge: "variables"}
 }
 
+// SyncStatesOrErr returns the SyncStates value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnvironmentEdges) SyncStatesOrErr() ([]*SyncState, error) {
+	if e.loadedTypes[1] {
+		return e.SyncStates, nil
+	}
+	return nil, &NotLoadedError{edge: "sync_states"}
+}
+
+// RequiredVariablesOrErr returns the RequiredVariables value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnvironmentEdges) RequiredVariablesOrErr() ([]*RequiredVariable, error) {
+	if e.loadedTypes[2] {
+		return e.RequiredVariables, nil
+	}
+	return nil, &NotLoadedError{edge: "required_variables"}
+}
+
+// MergeStatesOrErr returns the MergeStates value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnvironmentEdges) MergeStatesOrErr() ([]*MergeState, error) {
+	if e.loadedTypes[3] {
+		return e.MergeStates, nil
+	}
+	return nil, &NotLoadedError{edge: "merge_states"}
+}
+
+// SchemaOrErr returns the Schema value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EnvironmentEdges) SchemaOrErr() (*EnvironmentSchema, error) {
+	if e.Schema != nil {
+		return e.Schema, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: environmentschema.Label}
+	}
+	return nil, &NotLoadedError{edge: "schema"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Environment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case environment.FieldID:
+		case environment.FieldID, environment.FieldSchemaID:
 			values[i] = new(sql.NullInt64)
 		case environment.FieldName, environment.FieldDescription:
 			values[i] = new(sql.NullString)
+		case environment.FieldCreatedAt, environment.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -74,6 +130,18 @@ func (e *Environment) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			e.ID = int(value.Int64)
+		case environment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				e.CreatedAt = value.Time
+			}
+		case environment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				e.UpdatedAt = value.Time
+			}
 		case environment.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -86,6 +154,13 @@ func (e *Environment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				e.Description = value.String
 			}
+		case environment.FieldSchemaID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field schema_id", values[i])
+			} else if value.Valid {
+				e.SchemaID = new(int)
+				*e.SchemaID = int(value.Int64)
+			}
 		default:
 			e.selectValues.Set(columns[i], values[i])
 		}
@@ -104,6 +179,26 @@ func (e *Environment) QueryVariables() *VariableQuery {
 	return NewEnvironmentClient(e.config).QueryVariables(e)
 }
 
+// QuerySyncStates queries the "sync_states" edge of the Environment entity.
+func (e *Environment) QuerySyncStates() *SyncStateQuery {
+	return NewEnvironmentClient(e.config).QuerySyncStates(e)
+}
+
+// QueryRequiredVariables queries the "required_variables" edge of the Environment entity.
+func (e *Environment) QueryRequiredVariables() *RequiredVariableQuery {
+	return NewEnvironmentClient(e.config).QueryRequiredVariables(e)
+}
+
+// QueryMergeStates queries the "merge_states" edge of the Environment entity.
+func (e *Environment) QueryMergeStates() *MergeStateQuery {
+	return NewEnvironmentClient(e.config).QueryMergeStates(e)
+}
+
+// QuerySchema queries the "schema" edge of the Environment entity.
+func (e *Environment) QuerySchema() *EnvironmentSchemaQuery {
+	return NewEnvironmentClient(e.config).QuerySchema(e)
+}
+
 // Update returns a builder for updating this Environment.
 // Note that you need to call Environment.Unwrap() before calling this method if this Environment
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -127,11 +222,22 @@ func (e *Environment) String() string {
 	var builder strings.Builder
 	builder.WriteString("Environment(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", e.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(e.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(e.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(e.Name)
 	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(e.Description)
+	builder.WriteString(", ")
+	if v := e.SchemaID; v != nil {
+		builder.WriteString("schema_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package environment
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -12,12 +14,26 @@ const (
 	Label = "environment"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldSchemaID holds the string denoting the schema_id field in the database.
+	FieldSchemaID = "schema_id"
 	// EdgeVariables holds the string denoting the variables edge name in mutations.
 	EdgeVariables = "variables"
+	// EdgeSyncStates holds the string denoting the sync_states edge name in mutations.
+	EdgeSyncStates = "sync_states"
+	// EdgeRequiredVariables holds the string denoting the required_variables edge name in mutations.
+	EdgeRequiredVariables = "required_variables"
+	// EdgeMergeStates holds the string denoting the merge_states edge name in mutations.
+	EdgeMergeStates = "merge_states"
+	// EdgeSchema holds the string denoting the schema edge name in mutations.
+	EdgeSchema = "schema"
 	// Table holds the table name of the environment in the database.
 	Table = "environments"
 	// VariablesTable is the table that holds the variables relation/edge.
@@ -27,13 +43,44 @@ const (
 	VariablesInverseTable = "variables"
 	// VariablesColumn is the table column denoting the variables relation/edge.
 	VariablesColumn = "environment_id"
+	// SyncStatesTable is the table that holds the sync_states relation/edge.
+	SyncStatesTable = "sync_states"
+	// SyncStatesInverseTable is the table name for the SyncState entity.
+	// It exists in this package in order to avoid circular dependency with the "syncstate" package.
+	SyncStatesInverseTable = "sync_states"
+	// SyncStatesColumn is the table column denoting the sync_states relation/edge.
+	SyncStatesColumn = "environment_id"
+	// RequiredVariablesTable is the table that holds the required_variables relation/edge.
+	RequiredVariablesTable = "required_variables"
+	// RequiredVariablesInverseTable is the table name for the RequiredVariable entity.
+	// It exists in this package in order to avoid circular dependency with the "requiredvariable" package.
+	RequiredVariablesInverseTable = "required_variables"
+	// RequiredVariablesColumn is the table column denoting the required_variables relation/edge.
+	RequiredVariablesColumn = "environment_id"
+	// MergeStatesTable is the table that holds the merge_states relation/edge.
+	MergeStatesTable = "merge_states"
+	// MergeStatesInverseTable is the table name for the MergeState entity.
+	// It exists in this package in order to avoid circular dependency with the "mergestate" package.
+	MergeStatesInverseTable = "merge_states"
+	// MergeStatesColumn is the table column denoting the merge_states relation/edge.
+	MergeStatesColumn = "environment_id"
+	// SchemaTable is the table that holds the schema relation/edge.
+	SchemaTable = "environments"
+	// SchemaInverseTable is the table name for the EnvironmentSchema entity.
+	// It exists in this package in order to avoid circular dependency with the "environmentschema" package.
+	SchemaInverseTable = "environment_schemas"
+	// SchemaColumn is the table column denoting the schema relation/edge.
+	SchemaColumn = "schema_id"
 )
 
 // Columns holds all SQL columns for environment fields.
 var Columns = []string{
 	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
 	FieldName,
 	FieldDescription,
+	FieldSchemaID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -47,6 +94,12 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 )
@@ -59,6 +112,16 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
 // ByName orders the results by the name field.
 func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
@@ -69,6 +132,11 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// BySchemaID orders the results by the schema_id field.
+func BySchemaID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSchemaID, opts...).ToFunc()
+}
+
 // ByVariablesCount orders the results by variables count.
 func ByVariablesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -82,6 +150,55 @@ func ByVariables(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newVariablesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySyncStatesCount orders the results by sync_states count.
+func BySyncStatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSyncStatesStep(), opts...)
+	}
+}
+
+// BySyncStates orders the results by sync_states terms.
+func BySyncStates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSyncStatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRequiredVariablesCount orders the results by required_variables count.
+func ByRequiredVariablesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRequiredVariablesStep(), opts...)
+	}
+}
+
+// ByRequiredVariables orders the results by required_variables terms.
+func ByRequiredVariables(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRequiredVariablesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMergeStatesCount orders the results by merge_states count.
+func ByMergeStatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMergeStatesStep(), opts...)
+	}
+}
+
+// ByMergeStates orders the results by merge_states terms.
+func ByMergeStates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMergeStatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySchemaField orders the results by schema field.
+func BySchemaField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSchemaStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newVariablesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -89,3 +206,31 @@ func newVariablesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, VariablesTable, VariablesColumn),
 	)
 }
+func newSyncStatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SyncStatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SyncStatesTable, SyncStatesColumn),
+	)
+}
+func newRequiredVariablesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RequiredVariablesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RequiredVariablesTable, RequiredVariablesColumn),
+	)
+}
+func newMergeStatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MergeStatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MergeStatesTable, MergeStatesColumn),
+	)
+}
+func newSchemaStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SchemaInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SchemaTable, SchemaColumn),
+	)
+}
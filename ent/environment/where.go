@@ -3,6 +3,8 @@
 package environment
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/kechako/envoke/ent/predicate"
@@ -53,6 +55,16 @@ func IDLTE(id int) predicate.Environment {
 	return predicate.Environment(sql.FieldLTE(FieldID, id))
 }
 
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.Environment {
 	return predicate.Environment(sql.FieldEQ(FieldName, v))
@@ -63,6 +75,111 @@ func Description(v string) predicate.Environment {
 	return predicate.Environment(sql.FieldEQ(FieldDescription, v))
 }
 
+// SchemaID applies equality check predicate on the "schema_id" field. It's identical to SchemaIDEQ.
+func SchemaID(v int) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldSchemaID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.Environment {
+	return predicate.Environment(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.Environment {
+	return predicate.Environment(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.Environment {
+	return predicate.Environment(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.Environment {
+	return predicate.Environment(sql.FieldNotNull(FieldUpdatedAt))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Environment {
 	return predicate.Environment(sql.FieldEQ(FieldName, v))
@@ -203,6 +320,36 @@ func DescriptionContainsFold(v string) predicate.Environment {
 	return predicate.Environment(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// SchemaIDEQ applies the EQ predicate on the "schema_id" field.
+func SchemaIDEQ(v int) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldSchemaID, v))
+}
+
+// SchemaIDNEQ applies the NEQ predicate on the "schema_id" field.
+func SchemaIDNEQ(v int) predicate.Environment {
+	return predicate.Environment(sql.FieldNEQ(FieldSchemaID, v))
+}
+
+// SchemaIDIn applies the In predicate on the "schema_id" field.
+func SchemaIDIn(vs ...int) predicate.Environment {
+	return predicate.Environment(sql.FieldIn(FieldSchemaID, vs...))
+}
+
+// SchemaIDNotIn applies the NotIn predicate on the "schema_id" field.
+func SchemaIDNotIn(vs ...int) predicate.Environment {
+	return predicate.Environment(sql.FieldNotIn(FieldSchemaID, vs...))
+}
+
+// SchemaIDIsNil applies the IsNil predicate on the "schema_id" field.
+func SchemaIDIsNil() predicate.Environment {
+	return predicate.Environment(sql.FieldIsNull(FieldSchemaID))
+}
+
+// SchemaIDNotNil applies the NotNil predicate on the "schema_id" field.
+func SchemaIDNotNil() predicate.Environment {
+	return predicate.Environment(sql.FieldNotNull(FieldSchemaID))
+}
+
 // HasVariables applies the HasEdge predicate on the "variables" edge.
 func HasVariables() predicate.Environment {
 	return predicate.Environment(func(s *sql.Selector) {
@@ -226,6 +373,98 @@ func HasVariablesWith(preds ...predicate.Variable) predicate.Environment {
 	})
 }
 
+// HasSyncStates applies the HasEdge predicate on the "sync_states" edge.
+func HasSyncStates() predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SyncStatesTable, SyncStatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSyncStatesWith applies the HasEdge predicate on the "sync_states" edge with a given conditions (other predicates).
+func HasSyncStatesWith(preds ...predicate.SyncState) predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		step := newSyncStatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRequiredVariables applies the HasEdge predicate on the "required_variables" edge.
+func HasRequiredVariables() predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RequiredVariablesTable, RequiredVariablesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRequiredVariablesWith applies the HasEdge predicate on the "required_variables" edge with a given conditions (other predicates).
+func HasRequiredVariablesWith(preds ...predicate.RequiredVariable) predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		step := newRequiredVariablesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMergeStates applies the HasEdge predicate on the "merge_states" edge.
+func HasMergeStates() predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MergeStatesTable, MergeStatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMergeStatesWith applies the HasEdge predicate on the "merge_states" edge with a given conditions (other predicates).
+func HasMergeStatesWith(preds ...predicate.MergeState) predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		step := newMergeStatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSchema applies the HasEdge predicate on the "schema" edge.
+func HasSchema() predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SchemaTable, SchemaColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSchemaWith applies the HasEdge predicate on the "schema" edge with a given conditions (other predicates).
+func HasSchemaWith(preds ...predicate.EnvironmentSchema) predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		step := newSchemaStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Environment) predicate.Environment {
 	return predicate.Environment(sql.AndPredicates(predicates...))
@@ -6,11 +6,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/environmentschema"
+	"github.com/kechako/envoke/ent/mergestate"
+	"github.com/kechako/envoke/ent/requiredvariable"
+	"github.com/kechako/envoke/ent/syncstate"
 	"github.com/kechako/envoke/ent/variable"
 )
 
@@ -22,6 +27,34 @@ type EnvironmentCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (ec *EnvironmentCreate) SetCreatedAt(t time.Time) *EnvironmentCreate {
+	ec.mutation.SetCreatedAt(t)
+	return ec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ec *EnvironmentCreate) SetNillableCreatedAt(t *time.Time) *EnvironmentCreate {
+	if t != nil {
+		ec.SetCreatedAt(*t)
+	}
+	return ec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ec *EnvironmentCreate) SetUpdatedAt(t time.Time) *EnvironmentCreate {
+	ec.mutation.SetUpdatedAt(t)
+	return ec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ec *EnvironmentCreate) SetNillableUpdatedAt(t *time.Time) *EnvironmentCreate {
+	if t != nil {
+		ec.SetUpdatedAt(*t)
+	}
+	return ec
+}
+
 // SetName sets the "name" field.
 func (ec *EnvironmentCreate) SetName(s string) *EnvironmentCreate {
 	ec.mutation.SetName(s)
@@ -42,6 +75,20 @@ func (ec *EnvironmentCreate) SetNillableDescription(s *string) *EnvironmentCreat
 	return ec
 }
 
+// SetSchemaID sets the "schema_id" field.
+func (ec *EnvironmentCreate) SetSchemaID(i int) *EnvironmentCreate {
+	ec.mutation.SetSchemaID(i)
+	return ec
+}
+
+// SetNillableSchemaID sets the "schema_id" field if the given value is not nil.
+func (ec *EnvironmentCreate) SetNillableSchemaID(i *int) *EnvironmentCreate {
+	if i != nil {
+		ec.SetSchemaID(*i)
+	}
+	return ec
+}
+
 // AddVariableIDs adds the "variables" edge to the Variable entity by IDs.
 func (ec *EnvironmentCreate) AddVariableIDs(ids ...int) *EnvironmentCreate {
 	ec.mutation.AddVariableIDs(ids...)
@@ -57,6 +104,56 @@ func (ec *EnvironmentCreate) AddVariables(v ...*Variable) *EnvironmentCreate {
 	return ec.AddVariableIDs(ids...)
 }
 
+// AddSyncStateIDs adds the "sync_states" edge to the SyncState entity by IDs.
+func (ec *EnvironmentCreate) AddSyncStateIDs(ids ...int) *EnvironmentCreate {
+	ec.mutation.AddSyncStateIDs(ids...)
+	return ec
+}
+
+// AddSyncStates adds the "sync_states" edges to the SyncState entity.
+func (ec *EnvironmentCreate) AddSyncStates(s ...*SyncState) *EnvironmentCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ec.AddSyncStateIDs(ids...)
+}
+
+// AddRequiredVariableIDs adds the "required_variables" edge to the RequiredVariable entity by IDs.
+func (ec *EnvironmentCreate) AddRequiredVariableIDs(ids ...int) *EnvironmentCreate {
+	ec.mutation.AddRequiredVariableIDs(ids...)
+	return ec
+}
+
+// AddRequiredVariables adds the "required_variables" edges to the RequiredVariable entity.
+func (ec *EnvironmentCreate) AddRequiredVariables(r ...*RequiredVariable) *EnvironmentCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ec.AddRequiredVariableIDs(ids...)
+}
+
+// AddMergeStateIDs adds the "merge_states" edge to the MergeState entity by IDs.
+func (ec *EnvironmentCreate) AddMergeStateIDs(ids ...int) *EnvironmentCreate {
+	ec.mutation.AddMergeStateIDs(ids...)
+	return ec
+}
+
+// AddMergeStates adds the "merge_states" edges to the MergeState entity.
+func (ec *EnvironmentCreate) AddMergeStates(m ...*MergeState) *EnvironmentCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return ec.AddMergeStateIDs(ids...)
+}
+
+// SetSchema sets the "schema" edge to the EnvironmentSchema entity.
+func (ec *EnvironmentCreate) SetSchema(e *EnvironmentSchema) *EnvironmentCreate {
+	return ec.SetSchemaID(e.ID)
+}
+
 // Mutation returns the EnvironmentMutation object of the builder.
 func (ec *EnvironmentCreate) Mutation() *EnvironmentMutation {
 	return ec.mutation
@@ -64,6 +161,7 @@ func (ec *EnvironmentCreate) Mutation() *EnvironmentMutation {
 
 // Save creates the Environment in the database.
 func (ec *EnvironmentCreate) Save(ctx context.Context) (*Environment, error) {
+	ec.defaults()
 	return withHooks(ctx, ec.sqlSave, ec.mutation, ec.hooks)
 }
 
@@ -89,6 +187,18 @@ func (ec *EnvironmentCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (ec *EnvironmentCreate) defaults() {
+	if _, ok := ec.mutation.CreatedAt(); !ok {
+		v := environment.DefaultCreatedAt()
+		ec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ec.mutation.UpdatedAt(); !ok {
+		v := environment.DefaultUpdatedAt()
+		ec.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (ec *EnvironmentCreate) check() error {
 	if _, ok := ec.mutation.Name(); !ok {
@@ -126,6 +236,14 @@ func (ec *EnvironmentCreate) createSpec() (*Environment, *sqlgraph.CreateSpec) {
 		_spec = sqlgraph.NewCreateSpec(environment.Table, sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt))
 	)
 	_spec.OnConflict = ec.conflict
+	if value, ok := ec.mutation.CreatedAt(); ok {
+		_spec.SetField(environment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ec.mutation.UpdatedAt(); ok {
+		_spec.SetField(environment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
 	if value, ok := ec.mutation.Name(); ok {
 		_spec.SetField(environment.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -150,6 +268,71 @@ func (ec *EnvironmentCreate) createSpec() (*Environment, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ec.mutation.SyncStatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.SyncStatesTable,
+			Columns: []string{environment.SyncStatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncstate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.RequiredVariablesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.RequiredVariablesTable,
+			Columns: []string{environment.RequiredVariablesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(requiredvariable.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.MergeStatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.MergeStatesTable,
+			Columns: []string{environment.MergeStatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mergestate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.SchemaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   environment.SchemaTable,
+			Columns: []string{environment.SchemaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environmentschema.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SchemaID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -157,7 +340,7 @@ func (ec *EnvironmentCreate) createSpec() (*Environment, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.Environment.Create().
-//		SetName(v).
+//		SetCreatedAt(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -166,7 +349,7 @@ func (ec *EnvironmentCreate) createSpec() (*Environment, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.EnvironmentUpsert) {
-//			SetName(v+v).
+//			SetCreatedAt(v+v).
 //		}).
 //		Exec(ctx)
 func (ec *EnvironmentCreate) OnConflict(opts ...sql.ConflictOption) *EnvironmentUpsertOne {
@@ -202,6 +385,24 @@ type (
 	}
 )
 
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EnvironmentUpsert) SetUpdatedAt(v time.Time) *EnvironmentUpsert {
+	u.Set(environment.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EnvironmentUpsert) UpdateUpdatedAt() *EnvironmentUpsert {
+	u.SetExcluded(environment.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *EnvironmentUpsert) ClearUpdatedAt() *EnvironmentUpsert {
+	u.SetNull(environment.FieldUpdatedAt)
+	return u
+}
+
 // SetName sets the "name" field.
 func (u *EnvironmentUpsert) SetName(v string) *EnvironmentUpsert {
 	u.Set(environment.FieldName, v)
@@ -232,6 +433,24 @@ func (u *EnvironmentUpsert) ClearDescription() *EnvironmentUpsert {
 	return u
 }
 
+// SetSchemaID sets the "schema_id" field.
+func (u *EnvironmentUpsert) SetSchemaID(v int) *EnvironmentUpsert {
+	u.Set(environment.FieldSchemaID, v)
+	return u
+}
+
+// UpdateSchemaID sets the "schema_id" field to the value that was provided on create.
+func (u *EnvironmentUpsert) UpdateSchemaID() *EnvironmentUpsert {
+	u.SetExcluded(environment.FieldSchemaID)
+	return u
+}
+
+// ClearSchemaID clears the value of the "schema_id" field.
+func (u *EnvironmentUpsert) ClearSchemaID() *EnvironmentUpsert {
+	u.SetNull(environment.FieldSchemaID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -242,6 +461,11 @@ func (u *EnvironmentUpsert) ClearDescription() *EnvironmentUpsert {
 //		Exec(ctx)
 func (u *EnvironmentUpsertOne) UpdateNewValues() *EnvironmentUpsertOne {
 	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(environment.FieldCreatedAt)
+		}
+	}))
 	return u
 }
 
@@ -272,6 +496,27 @@ func (u *EnvironmentUpsertOne) Update(set func(*EnvironmentUpsert)) *Environment
 	return u
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EnvironmentUpsertOne) SetUpdatedAt(v time.Time) *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EnvironmentUpsertOne) UpdateUpdatedAt() *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *EnvironmentUpsertOne) ClearUpdatedAt() *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *EnvironmentUpsertOne) SetName(v string) *EnvironmentUpsertOne {
 	return u.Update(func(s *EnvironmentUpsert) {
@@ -307,6 +552,27 @@ func (u *EnvironmentUpsertOne) ClearDescription() *EnvironmentUpsertOne {
 	})
 }
 
+// SetSchemaID sets the "schema_id" field.
+func (u *EnvironmentUpsertOne) SetSchemaID(v int) *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.SetSchemaID(v)
+	})
+}
+
+// UpdateSchemaID sets the "schema_id" field to the value that was provided on create.
+func (u *EnvironmentUpsertOne) UpdateSchemaID() *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.UpdateSchemaID()
+	})
+}
+
+// ClearSchemaID clears the value of the "schema_id" field.
+func (u *EnvironmentUpsertOne) ClearSchemaID() *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.ClearSchemaID()
+	})
+}
+
 // Exec executes the query.
 func (u *EnvironmentUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -359,6 +625,7 @@ func (ecb *EnvironmentCreateBulk) Save(ctx context.Context) ([]*Environment, err
 	for i := range ecb.builders {
 		func(i int, root context.Context) {
 			builder := ecb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*EnvironmentMutation)
 				if !ok {
@@ -441,7 +708,7 @@ func (ecb *EnvironmentCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.EnvironmentUpsert) {
-//			SetName(v+v).
+//			SetCreatedAt(v+v).
 //		}).
 //		Exec(ctx)
 func (ecb *EnvironmentCreateBulk) OnConflict(opts ...sql.ConflictOption) *EnvironmentUpsertBulk {
@@ -480,6 +747,13 @@ type EnvironmentUpsertBulk struct {
 //		Exec(ctx)
 func (u *EnvironmentUpsertBulk) UpdateNewValues() *EnvironmentUpsertBulk {
 	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(environment.FieldCreatedAt)
+			}
+		}
+	}))
 	return u
 }
 
@@ -510,6 +784,27 @@ func (u *EnvironmentUpsertBulk) Update(set func(*EnvironmentUpsert)) *Environmen
 	return u
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EnvironmentUpsertBulk) SetUpdatedAt(v time.Time) *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EnvironmentUpsertBulk) UpdateUpdatedAt() *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *EnvironmentUpsertBulk) ClearUpdatedAt() *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *EnvironmentUpsertBulk) SetName(v string) *EnvironmentUpsertBulk {
 	return u.Update(func(s *EnvironmentUpsert) {
@@ -545,6 +840,27 @@ func (u *EnvironmentUpsertBulk) ClearDescription() *EnvironmentUpsertBulk {
 	})
 }
 
+// SetSchemaID sets the "schema_id" field.
+func (u *EnvironmentUpsertBulk) SetSchemaID(v int) *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.SetSchemaID(v)
+	})
+}
+
+// UpdateSchemaID sets the "schema_id" field to the value that was provided on create.
+func (u *EnvironmentUpsertBulk) UpdateSchemaID() *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.UpdateSchemaID()
+	})
+}
+
+// ClearSchemaID clears the value of the "schema_id" field.
+func (u *EnvironmentUpsertBulk) ClearSchemaID() *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.ClearSchemaID()
+	})
+}
+
 // Exec executes the query.
 func (u *EnvironmentUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -13,18 +13,26 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/environmentschema"
+	"github.com/kechako/envoke/ent/mergestate"
 	"github.com/kechako/envoke/ent/predicate"
+	"github.com/kechako/envoke/ent/requiredvariable"
+	"github.com/kechako/envoke/ent/syncstate"
 	"github.com/kechako/envoke/ent/variable"
 )
 
 // EnvironmentQuery is the builder for querying Environment entities.
 type EnvironmentQuery struct {
 	config
-	ctx           *QueryContext
-	order         []environment.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.Environment
-	withVariables *VariableQuery
+	ctx                   *QueryContext
+	order                 []environment.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.Environment
+	withVariables         *VariableQuery
+	withSyncStates        *SyncStateQuery
+	withRequiredVariables *RequiredVariableQuery
+	withMergeStates       *MergeStateQuery
+	withSchema            *EnvironmentSchemaQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +91,94 @@ func (eq *EnvironmentQuery) QueryVariables() *VariableQuery {
 	return query
 }
 
+// QuerySyncStates chains the current query on the "sync_states" edge.
+func (eq *EnvironmentQuery) QuerySyncStates() *SyncStateQuery {
+	query := (&SyncStateClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, selector),
+			sqlgraph.To(syncstate.Table, syncstate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environment.SyncStatesTable, environment.SyncStatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRequiredVariables chains the current query on the "required_variables" edge.
+func (eq *EnvironmentQuery) QueryRequiredVariables() *RequiredVariableQuery {
+	query := (&RequiredVariableClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, selector),
+			sqlgraph.To(requiredvariable.Table, requiredvariable.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environment.RequiredVariablesTable, environment.RequiredVariablesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMergeStates chains the current query on the "merge_states" edge.
+func (eq *EnvironmentQuery) QueryMergeStates() *MergeStateQuery {
+	query := (&MergeStateClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, selector),
+			sqlgraph.To(mergestate.Table, mergestate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environment.MergeStatesTable, environment.MergeStatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySchema chains the current query on the "schema" edge.
+func (eq *EnvironmentQuery) QuerySchema() *EnvironmentSchemaQuery {
+	query := (&EnvironmentSchemaClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, selector),
+			sqlgraph.To(environmentschema.Table, environmentschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, environment.SchemaTable, environment.SchemaColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Environment entity from the query.
 // Returns a *NotFoundError when no Environment was found.
 func (eq *EnvironmentQuery) First(ctx context.Context) (*Environment, error) {
@@ -270,12 +366,16 @@ func (eq *EnvironmentQuery) Clone() *EnvironmentQuery {
 		return nil
 	}
 	return &EnvironmentQuery{
-		config:        eq.config,
-		ctx:           eq.ctx.Clone(),
-		order:         append([]environment.OrderOption{}, eq.order...),
-		inters:        append([]Interceptor{}, eq.inters...),
-		predicates:    append([]predicate.Environment{}, eq.predicates...),
-		withVariables: eq.withVariables.Clone(),
+		config:                eq.config,
+		ctx:                   eq.ctx.Clone(),
+		order:                 append([]environment.OrderOption{}, eq.order...),
+		inters:                append([]Interceptor{}, eq.inters...),
+		predicates:            append([]predicate.Environment{}, eq.predicates...),
+		withVariables:         eq.withVariables.Clone(),
+		withSyncStates:        eq.withSyncStates.Clone(),
+		withRequiredVariables: eq.withRequiredVariables.Clone(),
+		withMergeStates:       eq.withMergeStates.Clone(),
+		withSchema:            eq.withSchema.Clone(),
 		// clone intermediate query.
 		sql:  eq.sql.Clone(),
 		path: eq.path,
@@ -293,18 +393,62 @@ func (eq *EnvironmentQuery) WithVariables(opts ...func(*VariableQuery)) *Environ
 	return eq
 }
 
+// WithSyncStates tells the query-builder to eager-load the nodes that are connected to
+// the "sync_states" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EnvironmentQuery) WithSyncStates(opts ...func(*SyncStateQuery)) *EnvironmentQuery {
+	query := (&SyncStateClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withSyncStates = query
+	return eq
+}
+
+// WithRequiredVariables tells the query-builder to eager-load the nodes that are connected to
+// the "required_variables" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EnvironmentQuery) WithRequiredVariables(opts ...func(*RequiredVariableQuery)) *EnvironmentQuery {
+	query := (&RequiredVariableClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withRequiredVariables = query
+	return eq
+}
+
+// WithMergeStates tells the query-builder to eager-load the nodes that are connected to
+// the "merge_states" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EnvironmentQuery) WithMergeStates(opts ...func(*MergeStateQuery)) *EnvironmentQuery {
+	query := (&MergeStateClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withMergeStates = query
+	return eq
+}
+
+// WithSchema tells the query-builder to eager-load the nodes that are connected to
+// the "schema" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EnvironmentQuery) WithSchema(opts ...func(*EnvironmentSchemaQuery)) *EnvironmentQuery {
+	query := (&EnvironmentSchemaClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withSchema = query
+	return eq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Environment.Query().
-//		GroupBy(environment.FieldName).
+//		GroupBy(environment.FieldCreatedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (eq *EnvironmentQuery) GroupBy(field string, fields ...string) *EnvironmentGroupBy {
@@ -322,11 +466,11 @@ func (eq *EnvironmentQuery) GroupBy(field string, fields ...string) *Environment
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
 //	client.Environment.Query().
-//		Select(environment.FieldName).
+//		Select(environment.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (eq *EnvironmentQuery) Select(fields ...string) *EnvironmentSelect {
 	eq.ctx.Fields = append(eq.ctx.Fields, fields...)
@@ -371,8 +515,12 @@ func (eq *EnvironmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	var (
 		nodes       = []*Environment{}
 		_spec       = eq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [5]bool{
 			eq.withVariables != nil,
+			eq.withSyncStates != nil,
+			eq.withRequiredVariables != nil,
+			eq.withMergeStates != nil,
+			eq.withSchema != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -400,6 +548,35 @@ func (eq *EnvironmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := eq.withSyncStates; query != nil {
+		if err := eq.loadSyncStates(ctx, query, nodes,
+			func(n *Environment) { n.Edges.SyncStates = []*SyncState{} },
+			func(n *Environment, e *SyncState) { n.Edges.SyncStates = append(n.Edges.SyncStates, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withRequiredVariables; query != nil {
+		if err := eq.loadRequiredVariables(ctx, query, nodes,
+			func(n *Environment) { n.Edges.RequiredVariables = []*RequiredVariable{} },
+			func(n *Environment, e *RequiredVariable) {
+				n.Edges.RequiredVariables = append(n.Edges.RequiredVariables, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withMergeStates; query != nil {
+		if err := eq.loadMergeStates(ctx, query, nodes,
+			func(n *Environment) { n.Edges.MergeStates = []*MergeState{} },
+			func(n *Environment, e *MergeState) { n.Edges.MergeStates = append(n.Edges.MergeStates, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withSchema; query != nil {
+		if err := eq.loadSchema(ctx, query, nodes, nil,
+			func(n *Environment, e *EnvironmentSchema) { n.Edges.Schema = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -433,6 +610,128 @@ func (eq *EnvironmentQuery) loadVariables(ctx context.Context, query *VariableQu
 	}
 	return nil
 }
+func (eq *EnvironmentQuery) loadSyncStates(ctx context.Context, query *SyncStateQuery, nodes []*Environment, init func(*Environment), assign func(*Environment, *SyncState)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Environment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(syncstate.FieldEnvironmentID)
+	}
+	query.Where(predicate.SyncState(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(environment.SyncStatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EnvironmentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "environment_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EnvironmentQuery) loadRequiredVariables(ctx context.Context, query *RequiredVariableQuery, nodes []*Environment, init func(*Environment), assign func(*Environment, *RequiredVariable)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Environment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(requiredvariable.FieldEnvironmentID)
+	}
+	query.Where(predicate.RequiredVariable(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(environment.RequiredVariablesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EnvironmentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "environment_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EnvironmentQuery) loadMergeStates(ctx context.Context, query *MergeStateQuery, nodes []*Environment, init func(*Environment), assign func(*Environment, *MergeState)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Environment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(mergestate.FieldEnvironmentID)
+	}
+	query.Where(predicate.MergeState(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(environment.MergeStatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EnvironmentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "environment_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EnvironmentQuery) loadSchema(ctx context.Context, query *EnvironmentSchemaQuery, nodes []*Environment, init func(*Environment), assign func(*Environment, *EnvironmentSchema)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Environment)
+	for i := range nodes {
+		if nodes[i].SchemaID == nil {
+			continue
+		}
+		fk := *nodes[i].SchemaID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(environmentschema.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "schema_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (eq *EnvironmentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := eq.querySpec()
@@ -459,6 +758,9 @@ func (eq *EnvironmentQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if eq.withSchema != nil {
+			_spec.Node.AddColumnOnce(environment.FieldSchemaID)
+		}
 	}
 	if ps := eq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
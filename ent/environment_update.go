@@ -6,12 +6,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/environmentschema"
+	"github.com/kechako/envoke/ent/mergestate"
 	"github.com/kechako/envoke/ent/predicate"
+	"github.com/kechako/envoke/ent/requiredvariable"
+	"github.com/kechako/envoke/ent/syncstate"
 	"github.com/kechako/envoke/ent/variable"
 )
 
@@ -28,6 +33,18 @@ func (eu *EnvironmentUpdate) Where(ps ...predicate.Environment) *EnvironmentUpda
 	return eu
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (eu *EnvironmentUpdate) SetUpdatedAt(t time.Time) *EnvironmentUpdate {
+	eu.mutation.SetUpdatedAt(t)
+	return eu
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (eu *EnvironmentUpdate) ClearUpdatedAt() *EnvironmentUpdate {
+	eu.mutation.ClearUpdatedAt()
+	return eu
+}
+
 // SetName sets the "name" field.
 func (eu *EnvironmentUpdate) SetName(s string) *EnvironmentUpdate {
 	eu.mutation.SetName(s)
@@ -62,6 +79,26 @@ func (eu *EnvironmentUpdate) ClearDescription() *EnvironmentUpdate {
 	return eu
 }
 
+// SetSchemaID sets the "schema_id" field.
+func (eu *EnvironmentUpdate) SetSchemaID(i int) *EnvironmentUpdate {
+	eu.mutation.SetSchemaID(i)
+	return eu
+}
+
+// SetNillableSchemaID sets the "schema_id" field if the given value is not nil.
+func (eu *EnvironmentUpdate) SetNillableSchemaID(i *int) *EnvironmentUpdate {
+	if i != nil {
+		eu.SetSchemaID(*i)
+	}
+	return eu
+}
+
+// ClearSchemaID clears the value of the "schema_id" field.
+func (eu *EnvironmentUpdate) ClearSchemaID() *EnvironmentUpdate {
+	eu.mutation.ClearSchemaID()
+	return eu
+}
+
 // AddVariableIDs adds the "variables" edge to the Variable entity by IDs.
 func (eu *EnvironmentUpdate) AddVariableIDs(ids ...int) *EnvironmentUpdate {
 	eu.mutation.AddVariableIDs(ids...)
@@ -77,6 +114,56 @@ func (eu *EnvironmentUpdate) AddVariables(v ...*Variable) *EnvironmentUpdate {
 	return eu.AddVariableIDs(ids...)
 }
 
+// AddSyncStateIDs adds the "sync_states" edge to the SyncState entity by IDs.
+func (eu *EnvironmentUpdate) AddSyncStateIDs(ids ...int) *EnvironmentUpdate {
+	eu.mutation.AddSyncStateIDs(ids...)
+	return eu
+}
+
+// AddSyncStates adds the "sync_states" edges to the SyncState entity.
+func (eu *EnvironmentUpdate) AddSyncStates(s ...*SyncState) *EnvironmentUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return eu.AddSyncStateIDs(ids...)
+}
+
+// AddRequiredVariableIDs adds the "required_variables" edge to the RequiredVariable entity by IDs.
+func (eu *EnvironmentUpdate) AddRequiredVariableIDs(ids ...int) *EnvironmentUpdate {
+	eu.mutation.AddRequiredVariableIDs(ids...)
+	return eu
+}
+
+// AddRequiredVariables adds the "required_variables" edges to the RequiredVariable entity.
+func (eu *EnvironmentUpdate) AddRequiredVariables(r ...*RequiredVariable) *EnvironmentUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return eu.AddRequiredVariableIDs(ids...)
+}
+
+// AddMergeStateIDs adds the "merge_states" edge to the MergeState entity by IDs.
+func (eu *EnvironmentUpdate) AddMergeStateIDs(ids ...int) *EnvironmentUpdate {
+	eu.mutation.AddMergeStateIDs(ids...)
+	return eu
+}
+
+// AddMergeStates adds the "merge_states" edges to the MergeState entity.
+func (eu *EnvironmentUpdate) AddMergeStates(m ...*MergeState) *EnvironmentUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return eu.AddMergeStateIDs(ids...)
+}
+
+// SetSchema sets the "schema" edge to the EnvironmentSchema entity.
+func (eu *EnvironmentUpdate) SetSchema(e *EnvironmentSchema) *EnvironmentUpdate {
+	return eu.SetSchemaID(e.ID)
+}
+
 // Mutation returns the EnvironmentMutation object of the builder.
 func (eu *EnvironmentUpdate) Mutation() *EnvironmentMutation {
 	return eu.mutation
@@ -103,8 +190,78 @@ func (eu *EnvironmentUpdate) RemoveVariables(v ...*Variable) *EnvironmentUpdate
 	return eu.RemoveVariableIDs(ids...)
 }
 
+// ClearSyncStates clears all "sync_states" edges to the SyncState entity.
+func (eu *EnvironmentUpdate) ClearSyncStates() *EnvironmentUpdate {
+	eu.mutation.ClearSyncStates()
+	return eu
+}
+
+// RemoveSyncStateIDs removes the "sync_states" edge to SyncState entities by IDs.
+func (eu *EnvironmentUpdate) RemoveSyncStateIDs(ids ...int) *EnvironmentUpdate {
+	eu.mutation.RemoveSyncStateIDs(ids...)
+	return eu
+}
+
+// RemoveSyncStates removes "sync_states" edges to SyncState entities.
+func (eu *EnvironmentUpdate) RemoveSyncStates(s ...*SyncState) *EnvironmentUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return eu.RemoveSyncStateIDs(ids...)
+}
+
+// ClearRequiredVariables clears all "required_variables" edges to the RequiredVariable entity.
+func (eu *EnvironmentUpdate) ClearRequiredVariables() *EnvironmentUpdate {
+	eu.mutation.ClearRequiredVariables()
+	return eu
+}
+
+// RemoveRequiredVariableIDs removes the "required_variables" edge to RequiredVariable entities by IDs.
+func (eu *EnvironmentUpdate) RemoveRequiredVariableIDs(ids ...int) *EnvironmentUpdate {
+	eu.mutation.RemoveRequiredVariableIDs(ids...)
+	return eu
+}
+
+// RemoveRequiredVariables removes "required_variables" edges to RequiredVariable entities.
+func (eu *EnvironmentUpdate) RemoveRequiredVariables(r ...*RequiredVariable) *EnvironmentUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return eu.RemoveRequiredVariableIDs(ids...)
+}
+
+// ClearMergeStates clears all "merge_states" edges to the MergeState entity.
+func (eu *EnvironmentUpdate) ClearMergeStates() *EnvironmentUpdate {
+	eu.mutation.ClearMergeStates()
+	return eu
+}
+
+// RemoveMergeStateIDs removes the "merge_states" edge to MergeState entities by IDs.
+func (eu *EnvironmentUpdate) RemoveMergeStateIDs(ids ...int) *EnvironmentUpdate {
+	eu.mutation.RemoveMergeStateIDs(ids...)
+	return eu
+}
+
+// RemoveMergeStates removes "merge_states" edges to MergeState entities.
+func (eu *EnvironmentUpdate) RemoveMergeStates(m ...*MergeState) *EnvironmentUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return eu.RemoveMergeStateIDs(ids...)
+}
+
+// ClearSchema clears the "schema" edge to the EnvironmentSchema entity.
+func (eu *EnvironmentUpdate) ClearSchema() *EnvironmentUpdate {
+	eu.mutation.ClearSchema()
+	return eu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (eu *EnvironmentUpdate) Save(ctx context.Context) (int, error) {
+	eu.defaults()
 	return withHooks(ctx, eu.sqlSave, eu.mutation, eu.hooks)
 }
 
@@ -130,6 +287,14 @@ func (eu *EnvironmentUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (eu *EnvironmentUpdate) defaults() {
+	if _, ok := eu.mutation.UpdatedAt(); !ok && !eu.mutation.UpdatedAtCleared() {
+		v := environment.UpdateDefaultUpdatedAt()
+		eu.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (eu *EnvironmentUpdate) check() error {
 	if v, ok := eu.mutation.Name(); ok {
@@ -152,6 +317,15 @@ func (eu *EnvironmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if eu.mutation.CreatedAtCleared() {
+		_spec.ClearField(environment.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := eu.mutation.UpdatedAt(); ok {
+		_spec.SetField(environment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if eu.mutation.UpdatedAtCleared() {
+		_spec.ClearField(environment.FieldUpdatedAt, field.TypeTime)
+	}
 	if value, ok := eu.mutation.Name(); ok {
 		_spec.SetField(environment.FieldName, field.TypeString, value)
 	}
@@ -206,6 +380,170 @@ func (eu *EnvironmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if eu.mutation.SyncStatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.SyncStatesTable,
+			Columns: []string{environment.SyncStatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncstate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedSyncStatesIDs(); len(nodes) > 0 && !eu.mutation.SyncStatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.SyncStatesTable,
+			Columns: []string{environment.SyncStatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncstate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.SyncStatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.SyncStatesTable,
+			Columns: []string{environment.SyncStatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncstate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.RequiredVariablesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.RequiredVariablesTable,
+			Columns: []string{environment.RequiredVariablesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(requiredvariable.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedRequiredVariablesIDs(); len(nodes) > 0 && !eu.mutation.RequiredVariablesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.RequiredVariablesTable,
+			Columns: []string{environment.RequiredVariablesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(requiredvariable.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RequiredVariablesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.RequiredVariablesTable,
+			Columns: []string{environment.RequiredVariablesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(requiredvariable.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.MergeStatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.MergeStatesTable,
+			Columns: []string{environment.MergeStatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mergestate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedMergeStatesIDs(); len(nodes) > 0 && !eu.mutation.MergeStatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.MergeStatesTable,
+			Columns: []string{environment.MergeStatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mergestate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.MergeStatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.MergeStatesTable,
+			Columns: []string{environment.MergeStatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mergestate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.SchemaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   environment.SchemaTable,
+			Columns: []string{environment.SchemaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environmentschema.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.SchemaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   environment.SchemaTable,
+			Columns: []string{environment.SchemaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environmentschema.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{environment.Label}
@@ -226,6 +564,18 @@ type EnvironmentUpdateOne struct {
 	mutation *EnvironmentMutation
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (euo *EnvironmentUpdateOne) SetUpdatedAt(t time.Time) *EnvironmentUpdateOne {
+	euo.mutation.SetUpdatedAt(t)
+	return euo
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (euo *EnvironmentUpdateOne) ClearUpdatedAt() *EnvironmentUpdateOne {
+	euo.mutation.ClearUpdatedAt()
+	return euo
+}
+
 // SetName sets the "name" field.
 func (euo *EnvironmentUpdateOne) SetName(s string) *EnvironmentUpdateOne {
 	euo.mutation.SetName(s)
@@ -260,6 +610,26 @@ func (euo *EnvironmentUpdateOne) ClearDescription() *EnvironmentUpdateOne {
 	return euo
 }
 
+// SetSchemaID sets the "schema_id" field.
+func (euo *EnvironmentUpdateOne) SetSchemaID(i int) *EnvironmentUpdateOne {
+	euo.mutation.SetSchemaID(i)
+	return euo
+}
+
+// SetNillableSchemaID sets the "schema_id" field if the given value is not nil.
+func (euo *EnvironmentUpdateOne) SetNillableSchemaID(i *int) *EnvironmentUpdateOne {
+	if i != nil {
+		euo.SetSchemaID(*i)
+	}
+	return euo
+}
+
+// ClearSchemaID clears the value of the "schema_id" field.
+func (euo *EnvironmentUpdateOne) ClearSchemaID() *EnvironmentUpdateOne {
+	euo.mutation.ClearSchemaID()
+	return euo
+}
+
 // AddVariableIDs adds the "variables" edge to the Variable entity by IDs.
 func (euo *EnvironmentUpdateOne) AddVariableIDs(ids ...int) *EnvironmentUpdateOne {
 	euo.mutation.AddVariableIDs(ids...)
@@ -275,6 +645,56 @@ func (euo *EnvironmentUpdateOne) AddVariables(v ...*Variable) *EnvironmentUpdate
 	return euo.AddVariableIDs(ids...)
 }
 
+// AddSyncStateIDs adds the "sync_states" edge to the SyncState entity by IDs.
+func (euo *EnvironmentUpdateOne) AddSyncStateIDs(ids ...int) *EnvironmentUpdateOne {
+	euo.mutation.AddSyncStateIDs(ids...)
+	return euo
+}
+
+// AddSyncStates adds the "sync_states" edges to the SyncState entity.
+func (euo *EnvironmentUpdateOne) AddSyncStates(s ...*SyncState) *EnvironmentUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return euo.AddSyncStateIDs(ids...)
+}
+
+// AddRequiredVariableIDs adds the "required_variables" edge to the RequiredVariable entity by IDs.
+func (euo *EnvironmentUpdateOne) AddRequiredVariableIDs(ids ...int) *EnvironmentUpdateOne {
+	euo.mutation.AddRequiredVariableIDs(ids...)
+	return euo
+}
+
+// AddRequiredVariables adds the "required_variables" edges to the RequiredVariable entity.
+func (euo *EnvironmentUpdateOne) AddRequiredVariables(r ...*RequiredVariable) *EnvironmentUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return euo.AddRequiredVariableIDs(ids...)
+}
+
+// AddMergeStateIDs adds the "merge_states" edge to the MergeState entity by IDs.
+func (euo *EnvironmentUpdateOne) AddMergeStateIDs(ids ...int) *EnvironmentUpdateOne {
+	euo.mutation.AddMergeStateIDs(ids...)
+	return euo
+}
+
+// AddMergeStates adds the "merge_states" edges to the MergeState entity.
+func (euo *EnvironmentUpdateOne) AddMergeStates(m ...*MergeState) *EnvironmentUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return euo.AddMergeStateIDs(ids...)
+}
+
+// SetSchema sets the "schema" edge to the EnvironmentSchema entity.
+func (euo *EnvironmentUpdateOne) SetSchema(e *EnvironmentSchema) *EnvironmentUpdateOne {
+	return euo.SetSchemaID(e.ID)
+}
+
 // Mutation returns the EnvironmentMutation object of the builder.
 func (euo *EnvironmentUpdateOne) Mutation() *EnvironmentMutation {
 	return euo.mutation
@@ -301,6 +721,75 @@ func (euo *EnvironmentUpdateOne) RemoveVariables(v ...*Variable) *EnvironmentUpd
 	return euo.RemoveVariableIDs(ids...)
 }
 
+// ClearSyncStates clears all "sync_states" edges to the SyncState entity.
+func (euo *EnvironmentUpdateOne) ClearSyncStates() *EnvironmentUpdateOne {
+	euo.mutation.ClearSyncStates()
+	return euo
+}
+
+// RemoveSyncStateIDs removes the "sync_states" edge to SyncState entities by IDs.
+func (euo *EnvironmentUpdateOne) RemoveSyncStateIDs(ids ...int) *EnvironmentUpdateOne {
+	euo.mutation.RemoveSyncStateIDs(ids...)
+	return euo
+}
+
+// RemoveSyncStates removes "sync_states" edges to SyncState entities.
+func (euo *EnvironmentUpdateOne) RemoveSyncStates(s ...*SyncState) *EnvironmentUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return euo.RemoveSyncStateIDs(ids...)
+}
+
+// ClearRequiredVariables clears all "required_variables" edges to the RequiredVariable entity.
+func (euo *EnvironmentUpdateOne) ClearRequiredVariables() *EnvironmentUpdateOne {
+	euo.mutation.ClearRequiredVariables()
+	return euo
+}
+
+// RemoveRequiredVariableIDs removes the "required_variables" edge to RequiredVariable entities by IDs.
+func (euo *EnvironmentUpdateOne) RemoveRequiredVariableIDs(ids ...int) *EnvironmentUpdateOne {
+	euo.mutation.RemoveRequiredVariableIDs(ids...)
+	return euo
+}
+
+// RemoveRequiredVariables removes "required_variables" edges to RequiredVariable entities.
+func (euo *EnvironmentUpdateOne) RemoveRequiredVariables(r ...*RequiredVariable) *EnvironmentUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return euo.RemoveRequiredVariableIDs(ids...)
+}
+
+// ClearMergeStates clears all "merge_states" edges to the MergeState entity.
+func (euo *EnvironmentUpdateOne) ClearMergeStates() *EnvironmentUpdateOne {
+	euo.mutation.ClearMergeStates()
+	return euo
+}
+
+// RemoveMergeStateIDs removes the "merge_states" edge to MergeState entities by IDs.
+func (euo *EnvironmentUpdateOne) RemoveMergeStateIDs(ids ...int) *EnvironmentUpdateOne {
+	euo.mutation.RemoveMergeStateIDs(ids...)
+	return euo
+}
+
+// RemoveMergeStates removes "merge_states" edges to MergeState entities.
+func (euo *EnvironmentUpdateOne) RemoveMergeStates(m ...*MergeState) *EnvironmentUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return euo.RemoveMergeStateIDs(ids...)
+}
+
+// ClearSchema clears the "schema" edge to the EnvironmentSchema entity.
+func (euo *EnvironmentUpdateOne) ClearSchema() *EnvironmentUpdateOne {
+	euo.mutation.ClearSchema()
+	return euo
+}
+
 // Where appends a list predicates to the EnvironmentUpdate builder.
 func (euo *EnvironmentUpdateOne) Where(ps ...predicate.Environment) *EnvironmentUpdateOne {
 	euo.mutation.Where(ps...)
@@ -316,6 +805,7 @@ func (euo *EnvironmentUpdateOne) Select(field string, fields ...string) *Environ
 
 // Save executes the query and returns the updated Environment entity.
 func (euo *EnvironmentUpdateOne) Save(ctx context.Context) (*Environment, error) {
+	euo.defaults()
 	return withHooks(ctx, euo.sqlSave, euo.mutation, euo.hooks)
 }
 
@@ -341,6 +831,14 @@ func (euo *EnvironmentUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (euo *EnvironmentUpdateOne) defaults() {
+	if _, ok := euo.mutation.UpdatedAt(); !ok && !euo.mutation.UpdatedAtCleared() {
+		v := environment.UpdateDefaultUpdatedAt()
+		euo.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (euo *EnvironmentUpdateOne) check() error {
 	if v, ok := euo.mutation.Name(); ok {
@@ -380,6 +878,15 @@ func (euo *EnvironmentUpdateOne) sqlSave(ctx context.Context) (_node *Environmen
 			}
 		}
 	}
+	if euo.mutation.CreatedAtCleared() {
+		_spec.ClearField(environment.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := euo.mutation.UpdatedAt(); ok {
+		_spec.SetField(environment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if euo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(environment.FieldUpdatedAt, field.TypeTime)
+	}
 	if value, ok := euo.mutation.Name(); ok {
 		_spec.SetField(environment.FieldName, field.TypeString, value)
 	}
@@ -434,6 +941,170 @@ func (euo *EnvironmentUpdateOne) sqlSave(ctx context.Context) (_node *Environmen
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if euo.mutation.SyncStatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.SyncStatesTable,
+			Columns: []string{environment.SyncStatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncstate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedSyncStatesIDs(); len(nodes) > 0 && !euo.mutation.SyncStatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.SyncStatesTable,
+			Columns: []string{environment.SyncStatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncstate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.SyncStatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.SyncStatesTable,
+			Columns: []string{environment.SyncStatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncstate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.RequiredVariablesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.RequiredVariablesTable,
+			Columns: []string{environment.RequiredVariablesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(requiredvariable.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedRequiredVariablesIDs(); len(nodes) > 0 && !euo.mutation.RequiredVariablesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.RequiredVariablesTable,
+			Columns: []string{environment.RequiredVariablesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(requiredvariable.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RequiredVariablesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.RequiredVariablesTable,
+			Columns: []string{environment.RequiredVariablesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(requiredvariable.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.MergeStatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.MergeStatesTable,
+			Columns: []string{environment.MergeStatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mergestate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedMergeStatesIDs(); len(nodes) > 0 && !euo.mutation.MergeStatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.MergeStatesTable,
+			Columns: []string{environment.MergeStatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mergestate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.MergeStatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.MergeStatesTable,
+			Columns: []string{environment.MergeStatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mergestate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.SchemaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   environment.SchemaTable,
+			Columns: []string{environment.SchemaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environmentschema.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.SchemaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   environment.SchemaTable,
+			Columns: []string{environment.SchemaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environmentschema.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Environment{config: euo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/envoke/ent/environmentschema"
+)
+
+// EnvironmentSchema is the model entity for the EnvironmentSchema schema.
+type EnvironmentSchema struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EnvironmentSchemaQuery when eager-loading is set.
+	Edges        EnvironmentSchemaEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EnvironmentSchemaEdges holds the relations/edges for other nodes in the graph.
+type EnvironmentSchemaEdges struct {
+	// Keys holds the value of the keys edge.
+	Keys []*SchemaKey `json:"keys,omitempty"`
+	// Environments holds the value of the environments edge.
+	Environments []*Environment `json:"environments,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// KeysOrErr returns the Keys value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnvironmentSchemaEdges) KeysOrErr() ([]*SchemaKey, error) {
+	if e.loadedTypes[0] {
+		return e.Keys, nil
+	}
+	return nil, &NotLoadedError{edge: "keys"}
+}
+
+// EnvironmentsOrErr returns the Environments value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnvironmentSchemaEdges) EnvironmentsOrErr() ([]*Environment, error) {
+	if e.loadedTypes[1] {
+		return e.Environments, nil
+	}
+	return nil, &NotLoadedError{edge: "environments"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EnvironmentSchema) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case environmentschema.FieldID:
+			values[i] = new(sql.NullInt64)
+		case environmentschema.FieldName, environmentschema.FieldDescription:
+			values[i] = new(sql.NullString)
+		case environmentschema.FieldCreatedAt, environmentschema.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EnvironmentSchema fields.
+func (es *EnvironmentSchema) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case environmentschema.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			es.ID = int(value.Int64)
+		case environmentschema.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				es.CreatedAt = value.Time
+			}
+		case environmentschema.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				es.UpdatedAt = value.Time
+			}
+		case environmentschema.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				es.Name = value.String
+			}
+		case environmentschema.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				es.Description = value.String
+			}
+		default:
+			es.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EnvironmentSchema.
+// This includes values selected through modifiers, order, etc.
+func (es *EnvironmentSchema) Value(name string) (ent.Value, error) {
+	return es.selectValues.Get(name)
+}
+
+// QueryKeys queries the "keys" edge of the EnvironmentSchema entity.
+func (es *EnvironmentSchema) QueryKeys() *SchemaKeyQuery {
+	return NewEnvironmentSchemaClient(es.config).QueryKeys(es)
+}
+
+// QueryEnvironments queries the "environments" edge of the EnvironmentSchema entity.
+func (es *EnvironmentSchema) QueryEnvironments() *EnvironmentQuery {
+	return NewEnvironmentSchemaClient(es.config).QueryEnvironments(es)
+}
+
+// Update returns a builder for updating this EnvironmentSchema.
+// Note that you need to call EnvironmentSchema.Unwrap() before calling this method if this EnvironmentSchema
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (es *EnvironmentSchema) Update() *EnvironmentSchemaUpdateOne {
+	return NewEnvironmentSchemaClient(es.config).UpdateOne(es)
+}
+
+// Unwrap unwraps the EnvironmentSchema entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (es *EnvironmentSchema) Unwrap() *EnvironmentSchema {
+	_tx, ok := es.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EnvironmentSchema is not a transactional entity")
+	}
+	es.config.driver = _tx.drv
+	return es
+}
+
+// String implements the fmt.Stringer.
+func (es *EnvironmentSchema) String() string {
+	var builder strings.Builder
+	builder.WriteString("EnvironmentSchema(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", es.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(es.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(es.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(es.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(es.Description)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EnvironmentSchemas is a parsable slice of EnvironmentSchema.
+type EnvironmentSchemas []*EnvironmentSchema
@@ -0,0 +1,724 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/environmentschema"
+	"github.com/kechako/envoke/ent/schemakey"
+)
+
+// EnvironmentSchemaCreate is the builder for creating a EnvironmentSchema entity.
+type EnvironmentSchemaCreate struct {
+	config
+	mutation *EnvironmentSchemaMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (esc *EnvironmentSchemaCreate) SetCreatedAt(t time.Time) *EnvironmentSchemaCreate {
+	esc.mutation.SetCreatedAt(t)
+	return esc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (esc *EnvironmentSchemaCreate) SetNillableCreatedAt(t *time.Time) *EnvironmentSchemaCreate {
+	if t != nil {
+		esc.SetCreatedAt(*t)
+	}
+	return esc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (esc *EnvironmentSchemaCreate) SetUpdatedAt(t time.Time) *EnvironmentSchemaCreate {
+	esc.mutation.SetUpdatedAt(t)
+	return esc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (esc *EnvironmentSchemaCreate) SetNillableUpdatedAt(t *time.Time) *EnvironmentSchemaCreate {
+	if t != nil {
+		esc.SetUpdatedAt(*t)
+	}
+	return esc
+}
+
+// SetName sets the "name" field.
+func (esc *EnvironmentSchemaCreate) SetName(s string) *EnvironmentSchemaCreate {
+	esc.mutation.SetName(s)
+	return esc
+}
+
+// SetDescription sets the "description" field.
+func (esc *EnvironmentSchemaCreate) SetDescription(s string) *EnvironmentSchemaCreate {
+	esc.mutation.SetDescription(s)
+	return esc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (esc *EnvironmentSchemaCreate) SetNillableDescription(s *string) *EnvironmentSchemaCreate {
+	if s != nil {
+		esc.SetDescription(*s)
+	}
+	return esc
+}
+
+// AddKeyIDs adds the "keys" edge to the SchemaKey entity by IDs.
+func (esc *EnvironmentSchemaCreate) AddKeyIDs(ids ...int) *EnvironmentSchemaCreate {
+	esc.mutation.AddKeyIDs(ids...)
+	return esc
+}
+
+// AddKeys adds the "keys" edges to the SchemaKey entity.
+func (esc *EnvironmentSchemaCreate) AddKeys(s ...*SchemaKey) *EnvironmentSchemaCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return esc.AddKeyIDs(ids...)
+}
+
+// AddEnvironmentIDs adds the "environments" edge to the Environment entity by IDs.
+func (esc *EnvironmentSchemaCreate) AddEnvironmentIDs(ids ...int) *EnvironmentSchemaCreate {
+	esc.mutation.AddEnvironmentIDs(ids...)
+	return esc
+}
+
+// AddEnvironments adds the "environments" edges to the Environment entity.
+func (esc *EnvironmentSchemaCreate) AddEnvironments(e ...*Environment) *EnvironmentSchemaCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return esc.AddEnvironmentIDs(ids...)
+}
+
+// Mutation returns the EnvironmentSchemaMutation object of the builder.
+func (esc *EnvironmentSchemaCreate) Mutation() *EnvironmentSchemaMutation {
+	return esc.mutation
+}
+
+// Save creates the EnvironmentSchema in the database.
+func (esc *EnvironmentSchemaCreate) Save(ctx context.Context) (*EnvironmentSchema, error) {
+	esc.defaults()
+	return withHooks(ctx, esc.sqlSave, esc.mutation, esc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (esc *EnvironmentSchemaCreate) SaveX(ctx context.Context) *EnvironmentSchema {
+	v, err := esc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (esc *EnvironmentSchemaCreate) Exec(ctx context.Context) error {
+	_, err := esc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esc *EnvironmentSchemaCreate) ExecX(ctx context.Context) {
+	if err := esc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (esc *EnvironmentSchemaCreate) defaults() {
+	if _, ok := esc.mutation.CreatedAt(); !ok {
+		v := environmentschema.DefaultCreatedAt()
+		esc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := esc.mutation.UpdatedAt(); !ok {
+		v := environmentschema.DefaultUpdatedAt()
+		esc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (esc *EnvironmentSchemaCreate) check() error {
+	if _, ok := esc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "EnvironmentSchema.name"`)}
+	}
+	if v, ok := esc.mutation.Name(); ok {
+		if err := environmentschema.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EnvironmentSchema.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (esc *EnvironmentSchemaCreate) sqlSave(ctx context.Context) (*EnvironmentSchema, error) {
+	if err := esc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := esc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, esc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	esc.mutation.id = &_node.ID
+	esc.mutation.done = true
+	return _node, nil
+}
+
+func (esc *EnvironmentSchemaCreate) createSpec() (*EnvironmentSchema, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EnvironmentSchema{config: esc.config}
+		_spec = sqlgraph.NewCreateSpec(environmentschema.Table, sqlgraph.NewFieldSpec(environmentschema.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = esc.conflict
+	if value, ok := esc.mutation.CreatedAt(); ok {
+		_spec.SetField(environmentschema.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := esc.mutation.UpdatedAt(); ok {
+		_spec.SetField(environmentschema.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := esc.mutation.Name(); ok {
+		_spec.SetField(environmentschema.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := esc.mutation.Description(); ok {
+		_spec.SetField(environmentschema.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if nodes := esc.mutation.KeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environmentschema.KeysTable,
+			Columns: []string{environmentschema.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schemakey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := esc.mutation.EnvironmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environmentschema.EnvironmentsTable,
+			Columns: []string{environmentschema.EnvironmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EnvironmentSchema.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EnvironmentSchemaUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (esc *EnvironmentSchemaCreate) OnConflict(opts ...sql.ConflictOption) *EnvironmentSchemaUpsertOne {
+	esc.conflict = opts
+	return &EnvironmentSchemaUpsertOne{
+		create: esc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EnvironmentSchema.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (esc *EnvironmentSchemaCreate) OnConflictColumns(columns ...string) *EnvironmentSchemaUpsertOne {
+	esc.conflict = append(esc.conflict, sql.ConflictColumns(columns...))
+	return &EnvironmentSchemaUpsertOne{
+		create: esc,
+	}
+}
+
+type (
+	// EnvironmentSchemaUpsertOne is the builder for "upsert"-ing
+	//  one EnvironmentSchema node.
+	EnvironmentSchemaUpsertOne struct {
+		create *EnvironmentSchemaCreate
+	}
+
+	// EnvironmentSchemaUpsert is the "OnConflict" setter.
+	EnvironmentSchemaUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EnvironmentSchemaUpsert) SetUpdatedAt(v time.Time) *EnvironmentSchemaUpsert {
+	u.Set(environmentschema.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EnvironmentSchemaUpsert) UpdateUpdatedAt() *EnvironmentSchemaUpsert {
+	u.SetExcluded(environmentschema.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *EnvironmentSchemaUpsert) ClearUpdatedAt() *EnvironmentSchemaUpsert {
+	u.SetNull(environmentschema.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *EnvironmentSchemaUpsert) SetName(v string) *EnvironmentSchemaUpsert {
+	u.Set(environmentschema.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EnvironmentSchemaUpsert) UpdateName() *EnvironmentSchemaUpsert {
+	u.SetExcluded(environmentschema.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *EnvironmentSchemaUpsert) SetDescription(v string) *EnvironmentSchemaUpsert {
+	u.Set(environmentschema.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *EnvironmentSchemaUpsert) UpdateDescription() *EnvironmentSchemaUpsert {
+	u.SetExcluded(environmentschema.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *EnvironmentSchemaUpsert) ClearDescription() *EnvironmentSchemaUpsert {
+	u.SetNull(environmentschema.FieldDescription)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.EnvironmentSchema.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EnvironmentSchemaUpsertOne) UpdateNewValues() *EnvironmentSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(environmentschema.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EnvironmentSchema.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EnvironmentSchemaUpsertOne) Ignore() *EnvironmentSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EnvironmentSchemaUpsertOne) DoNothing() *EnvironmentSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EnvironmentSchemaCreate.OnConflict
+// documentation for more info.
+func (u *EnvironmentSchemaUpsertOne) Update(set func(*EnvironmentSchemaUpsert)) *EnvironmentSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EnvironmentSchemaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EnvironmentSchemaUpsertOne) SetUpdatedAt(v time.Time) *EnvironmentSchemaUpsertOne {
+	return u.Update(func(s *EnvironmentSchemaUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EnvironmentSchemaUpsertOne) UpdateUpdatedAt() *EnvironmentSchemaUpsertOne {
+	return u.Update(func(s *EnvironmentSchemaUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *EnvironmentSchemaUpsertOne) ClearUpdatedAt() *EnvironmentSchemaUpsertOne {
+	return u.Update(func(s *EnvironmentSchemaUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *EnvironmentSchemaUpsertOne) SetName(v string) *EnvironmentSchemaUpsertOne {
+	return u.Update(func(s *EnvironmentSchemaUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EnvironmentSchemaUpsertOne) UpdateName() *EnvironmentSchemaUpsertOne {
+	return u.Update(func(s *EnvironmentSchemaUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *EnvironmentSchemaUpsertOne) SetDescription(v string) *EnvironmentSchemaUpsertOne {
+	return u.Update(func(s *EnvironmentSchemaUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *EnvironmentSchemaUpsertOne) UpdateDescription() *EnvironmentSchemaUpsertOne {
+	return u.Update(func(s *EnvironmentSchemaUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *EnvironmentSchemaUpsertOne) ClearDescription() *EnvironmentSchemaUpsertOne {
+	return u.Update(func(s *EnvironmentSchemaUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *EnvironmentSchemaUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EnvironmentSchemaCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EnvironmentSchemaUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EnvironmentSchemaUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EnvironmentSchemaUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EnvironmentSchemaCreateBulk is the builder for creating many EnvironmentSchema entities in bulk.
+type EnvironmentSchemaCreateBulk struct {
+	config
+	err      error
+	builders []*EnvironmentSchemaCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EnvironmentSchema entities in the database.
+func (escb *EnvironmentSchemaCreateBulk) Save(ctx context.Context) ([]*EnvironmentSchema, error) {
+	if escb.err != nil {
+		return nil, escb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(escb.builders))
+	nodes := make([]*EnvironmentSchema, len(escb.builders))
+	mutators := make([]Mutator, len(escb.builders))
+	for i := range escb.builders {
+		func(i int, root context.Context) {
+			builder := escb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EnvironmentSchemaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, escb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = escb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, escb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, escb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (escb *EnvironmentSchemaCreateBulk) SaveX(ctx context.Context) []*EnvironmentSchema {
+	v, err := escb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (escb *EnvironmentSchemaCreateBulk) Exec(ctx context.Context) error {
+	_, err := escb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (escb *EnvironmentSchemaCreateBulk) ExecX(ctx context.Context) {
+	if err := escb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EnvironmentSchema.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EnvironmentSchemaUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (escb *EnvironmentSchemaCreateBulk) OnConflict(opts ...sql.ConflictOption) *EnvironmentSchemaUpsertBulk {
+	escb.conflict = opts
+	return &EnvironmentSchemaUpsertBulk{
+		create: escb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EnvironmentSchema.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (escb *EnvironmentSchemaCreateBulk) OnConflictColumns(columns ...string) *EnvironmentSchemaUpsertBulk {
+	escb.conflict = append(escb.conflict, sql.ConflictColumns(columns...))
+	return &EnvironmentSchemaUpsertBulk{
+		create: escb,
+	}
+}
+
+// EnvironmentSchemaUpsertBulk is the builder for "upsert"-ing
+// a bulk of EnvironmentSchema nodes.
+type EnvironmentSchemaUpsertBulk struct {
+	create *EnvironmentSchemaCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EnvironmentSchema.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EnvironmentSchemaUpsertBulk) UpdateNewValues() *EnvironmentSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(environmentschema.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EnvironmentSchema.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EnvironmentSchemaUpsertBulk) Ignore() *EnvironmentSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EnvironmentSchemaUpsertBulk) DoNothing() *EnvironmentSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EnvironmentSchemaCreateBulk.OnConflict
+// documentation for more info.
+func (u *EnvironmentSchemaUpsertBulk) Update(set func(*EnvironmentSchemaUpsert)) *EnvironmentSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EnvironmentSchemaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EnvironmentSchemaUpsertBulk) SetUpdatedAt(v time.Time) *EnvironmentSchemaUpsertBulk {
+	return u.Update(func(s *EnvironmentSchemaUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EnvironmentSchemaUpsertBulk) UpdateUpdatedAt() *EnvironmentSchemaUpsertBulk {
+	return u.Update(func(s *EnvironmentSchemaUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *EnvironmentSchemaUpsertBulk) ClearUpdatedAt() *EnvironmentSchemaUpsertBulk {
+	return u.Update(func(s *EnvironmentSchemaUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *EnvironmentSchemaUpsertBulk) SetName(v string) *EnvironmentSchemaUpsertBulk {
+	return u.Update(func(s *EnvironmentSchemaUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EnvironmentSchemaUpsertBulk) UpdateName() *EnvironmentSchemaUpsertBulk {
+	return u.Update(func(s *EnvironmentSchemaUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *EnvironmentSchemaUpsertBulk) SetDescription(v string) *EnvironmentSchemaUpsertBulk {
+	return u.Update(func(s *EnvironmentSchemaUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *EnvironmentSchemaUpsertBulk) UpdateDescription() *EnvironmentSchemaUpsertBulk {
+	return u.Update(func(s *EnvironmentSchemaUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *EnvironmentSchemaUpsertBulk) ClearDescription() *EnvironmentSchemaUpsertBulk {
+	return u.Update(func(s *EnvironmentSchemaUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *EnvironmentSchemaUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EnvironmentSchemaCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EnvironmentSchemaCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EnvironmentSchemaUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/envoke/ent/environmentschema"
+	"github.com/kechako/envoke/ent/predicate"
+)
+
+// EnvironmentSchemaDelete is the builder for deleting a EnvironmentSchema entity.
+type EnvironmentSchemaDelete struct {
+	config
+	hooks    []Hook
+	mutation *EnvironmentSchemaMutation
+}
+
+// Where appends a list predicates to the EnvironmentSchemaDelete builder.
+func (esd *EnvironmentSchemaDelete) Where(ps ...predicate.EnvironmentSchema) *EnvironmentSchemaDelete {
+	esd.mutation.Where(ps...)
+	return esd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (esd *EnvironmentSchemaDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, esd.sqlExec, esd.mutation, esd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esd *EnvironmentSchemaDelete) ExecX(ctx context.Context) int {
+	n, err := esd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (esd *EnvironmentSchemaDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(environmentschema.Table, sqlgraph.NewFieldSpec(environmentschema.FieldID, field.TypeInt))
+	if ps := esd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, esd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	esd.mutation.done = true
+	return affected, err
+}
+
+// EnvironmentSchemaDeleteOne is the builder for deleting a single EnvironmentSchema entity.
+type EnvironmentSchemaDeleteOne struct {
+	esd *EnvironmentSchemaDelete
+}
+
+// Where appends a list predicates to the EnvironmentSchemaDelete builder.
+func (esdo *EnvironmentSchemaDeleteOne) Where(ps ...predicate.EnvironmentSchema) *EnvironmentSchemaDeleteOne {
+	esdo.esd.mutation.Where(ps...)
+	return esdo
+}
+
+// Exec executes the deletion query.
+func (esdo *EnvironmentSchemaDeleteOne) Exec(ctx context.Context) error {
+	n, err := esdo.esd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{environmentschema.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esdo *EnvironmentSchemaDeleteOne) ExecX(ctx context.Context) {
+	if err := esdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
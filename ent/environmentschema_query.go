@@ -0,0 +1,683 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/environmentschema"
+	"github.com/kechako/envoke/ent/predicate"
+	"github.com/kechako/envoke/ent/schemakey"
+)
+
+// EnvironmentSchemaQuery is the builder for querying EnvironmentSchema entities.
+type EnvironmentSchemaQuery struct {
+	config
+	ctx              *QueryContext
+	order            []environmentschema.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.EnvironmentSchema
+	withKeys         *SchemaKeyQuery
+	withEnvironments *EnvironmentQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EnvironmentSchemaQuery builder.
+func (esq *EnvironmentSchemaQuery) Where(ps ...predicate.EnvironmentSchema) *EnvironmentSchemaQuery {
+	esq.predicates = append(esq.predicates, ps...)
+	return esq
+}
+
+// Limit the number of records to be returned by this query.
+func (esq *EnvironmentSchemaQuery) Limit(limit int) *EnvironmentSchemaQuery {
+	esq.ctx.Limit = &limit
+	return esq
+}
+
+// Offset to start from.
+func (esq *EnvironmentSchemaQuery) Offset(offset int) *EnvironmentSchemaQuery {
+	esq.ctx.Offset = &offset
+	return esq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (esq *EnvironmentSchemaQuery) Unique(unique bool) *EnvironmentSchemaQuery {
+	esq.ctx.Unique = &unique
+	return esq
+}
+
+// Order specifies how the records should be ordered.
+func (esq *EnvironmentSchemaQuery) Order(o ...environmentschema.OrderOption) *EnvironmentSchemaQuery {
+	esq.order = append(esq.order, o...)
+	return esq
+}
+
+// QueryKeys chains the current query on the "keys" edge.
+func (esq *EnvironmentSchemaQuery) QueryKeys() *SchemaKeyQuery {
+	query := (&SchemaKeyClient{config: esq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := esq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := esq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environmentschema.Table, environmentschema.FieldID, selector),
+			sqlgraph.To(schemakey.Table, schemakey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environmentschema.KeysTable, environmentschema.KeysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(esq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEnvironments chains the current query on the "environments" edge.
+func (esq *EnvironmentSchemaQuery) QueryEnvironments() *EnvironmentQuery {
+	query := (&EnvironmentClient{config: esq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := esq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := esq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environmentschema.Table, environmentschema.FieldID, selector),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environmentschema.EnvironmentsTable, environmentschema.EnvironmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(esq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first EnvironmentSchema entity from the query.
+// Returns a *NotFoundError when no EnvironmentSchema was found.
+func (esq *EnvironmentSchemaQuery) First(ctx context.Context) (*EnvironmentSchema, error) {
+	nodes, err := esq.Limit(1).All(setContextOp(ctx, esq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{environmentschema.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (esq *EnvironmentSchemaQuery) FirstX(ctx context.Context) *EnvironmentSchema {
+	node, err := esq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EnvironmentSchema ID from the query.
+// Returns a *NotFoundError when no EnvironmentSchema ID was found.
+func (esq *EnvironmentSchemaQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = esq.Limit(1).IDs(setContextOp(ctx, esq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{environmentschema.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (esq *EnvironmentSchemaQuery) FirstIDX(ctx context.Context) int {
+	id, err := esq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EnvironmentSchema entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EnvironmentSchema entity is found.
+// Returns a *NotFoundError when no EnvironmentSchema entities are found.
+func (esq *EnvironmentSchemaQuery) Only(ctx context.Context) (*EnvironmentSchema, error) {
+	nodes, err := esq.Limit(2).All(setContextOp(ctx, esq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{environmentschema.Label}
+	default:
+		return nil, &NotSingularError{environmentschema.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (esq *EnvironmentSchemaQuery) OnlyX(ctx context.Context) *EnvironmentSchema {
+	node, err := esq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EnvironmentSchema ID in the query.
+// Returns a *NotSingularError when more than one EnvironmentSchema ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (esq *EnvironmentSchemaQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = esq.Limit(2).IDs(setContextOp(ctx, esq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{environmentschema.Label}
+	default:
+		err = &NotSingularError{environmentschema.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (esq *EnvironmentSchemaQuery) OnlyIDX(ctx context.Context) int {
+	id, err := esq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EnvironmentSchemas.
+func (esq *EnvironmentSchemaQuery) All(ctx context.Context) ([]*EnvironmentSchema, error) {
+	ctx = setContextOp(ctx, esq.ctx, ent.OpQueryAll)
+	if err := esq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EnvironmentSchema, *EnvironmentSchemaQuery]()
+	return withInterceptors[[]*EnvironmentSchema](ctx, esq, qr, esq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (esq *EnvironmentSchemaQuery) AllX(ctx context.Context) []*EnvironmentSchema {
+	nodes, err := esq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EnvironmentSchema IDs.
+func (esq *EnvironmentSchemaQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if esq.ctx.Unique == nil && esq.path != nil {
+		esq.Unique(true)
+	}
+	ctx = setContextOp(ctx, esq.ctx, ent.OpQueryIDs)
+	if err = esq.Select(environmentschema.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (esq *EnvironmentSchemaQuery) IDsX(ctx context.Context) []int {
+	ids, err := esq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (esq *EnvironmentSchemaQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, esq.ctx, ent.OpQueryCount)
+	if err := esq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, esq, querierCount[*EnvironmentSchemaQuery](), esq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (esq *EnvironmentSchemaQuery) CountX(ctx context.Context) int {
+	count, err := esq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (esq *EnvironmentSchemaQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, esq.ctx, ent.OpQueryExist)
+	switch _, err := esq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (esq *EnvironmentSchemaQuery) ExistX(ctx context.Context) bool {
+	exist, err := esq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EnvironmentSchemaQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (esq *EnvironmentSchemaQuery) Clone() *EnvironmentSchemaQuery {
+	if esq == nil {
+		return nil
+	}
+	return &EnvironmentSchemaQuery{
+		config:           esq.config,
+		ctx:              esq.ctx.Clone(),
+		order:            append([]environmentschema.OrderOption{}, esq.order...),
+		inters:           append([]Interceptor{}, esq.inters...),
+		predicates:       append([]predicate.EnvironmentSchema{}, esq.predicates...),
+		withKeys:         esq.withKeys.Clone(),
+		withEnvironments: esq.withEnvironments.Clone(),
+		// clone intermediate query.
+		sql:  esq.sql.Clone(),
+		path: esq.path,
+	}
+}
+
+// WithKeys tells the query-builder to eager-load the nodes that are connected to
+// the "keys" edge. The optional arguments are used to configure the query builder of the edge.
+func (esq *EnvironmentSchemaQuery) WithKeys(opts ...func(*SchemaKeyQuery)) *EnvironmentSchemaQuery {
+	query := (&SchemaKeyClient{config: esq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	esq.withKeys = query
+	return esq
+}
+
+// WithEnvironments tells the query-builder to eager-load the nodes that are connected to
+// the "environments" edge. The optional arguments are used to configure the query builder of the edge.
+func (esq *EnvironmentSchemaQuery) WithEnvironments(opts ...func(*EnvironmentQuery)) *EnvironmentSchemaQuery {
+	query := (&EnvironmentClient{config: esq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	esq.withEnvironments = query
+	return esq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EnvironmentSchema.Query().
+//		GroupBy(environmentschema.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (esq *EnvironmentSchemaQuery) GroupBy(field string, fields ...string) *EnvironmentSchemaGroupBy {
+	esq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EnvironmentSchemaGroupBy{build: esq}
+	grbuild.flds = &esq.ctx.Fields
+	grbuild.label = environmentschema.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.EnvironmentSchema.Query().
+//		Select(environmentschema.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (esq *EnvironmentSchemaQuery) Select(fields ...string) *EnvironmentSchemaSelect {
+	esq.ctx.Fields = append(esq.ctx.Fields, fields...)
+	sbuild := &EnvironmentSchemaSelect{EnvironmentSchemaQuery: esq}
+	sbuild.label = environmentschema.Label
+	sbuild.flds, sbuild.scan = &esq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EnvironmentSchemaSelect configured with the given aggregations.
+func (esq *EnvironmentSchemaQuery) Aggregate(fns ...AggregateFunc) *EnvironmentSchemaSelect {
+	return esq.Select().Aggregate(fns...)
+}
+
+func (esq *EnvironmentSchemaQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range esq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, esq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range esq.ctx.Fields {
+		if !environmentschema.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if esq.path != nil {
+		prev, err := esq.path(ctx)
+		if err != nil {
+			return err
+		}
+		esq.sql = prev
+	}
+	return nil
+}
+
+func (esq *EnvironmentSchemaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EnvironmentSchema, error) {
+	var (
+		nodes       = []*EnvironmentSchema{}
+		_spec       = esq.querySpec()
+		loadedTypes = [2]bool{
+			esq.withKeys != nil,
+			esq.withEnvironments != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EnvironmentSchema).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EnvironmentSchema{config: esq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, esq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := esq.withKeys; query != nil {
+		if err := esq.loadKeys(ctx, query, nodes,
+			func(n *EnvironmentSchema) { n.Edges.Keys = []*SchemaKey{} },
+			func(n *EnvironmentSchema, e *SchemaKey) { n.Edges.Keys = append(n.Edges.Keys, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := esq.withEnvironments; query != nil {
+		if err := esq.loadEnvironments(ctx, query, nodes,
+			func(n *EnvironmentSchema) { n.Edges.Environments = []*Environment{} },
+			func(n *EnvironmentSchema, e *Environment) { n.Edges.Environments = append(n.Edges.Environments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (esq *EnvironmentSchemaQuery) loadKeys(ctx context.Context, query *SchemaKeyQuery, nodes []*EnvironmentSchema, init func(*EnvironmentSchema), assign func(*EnvironmentSchema, *SchemaKey)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*EnvironmentSchema)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(schemakey.FieldSchemaID)
+	}
+	query.Where(predicate.SchemaKey(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(environmentschema.KeysColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SchemaID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "schema_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (esq *EnvironmentSchemaQuery) loadEnvironments(ctx context.Context, query *EnvironmentQuery, nodes []*EnvironmentSchema, init func(*EnvironmentSchema), assign func(*EnvironmentSchema, *Environment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*EnvironmentSchema)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(environment.FieldSchemaID)
+	}
+	query.Where(predicate.Environment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(environmentschema.EnvironmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SchemaID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "schema_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "schema_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (esq *EnvironmentSchemaQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := esq.querySpec()
+	_spec.Node.Columns = esq.ctx.Fields
+	if len(esq.ctx.Fields) > 0 {
+		_spec.Unique = esq.ctx.Unique != nil && *esq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, esq.driver, _spec)
+}
+
+func (esq *EnvironmentSchemaQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(environmentschema.Table, environmentschema.Columns, sqlgraph.NewFieldSpec(environmentschema.FieldID, field.TypeInt))
+	_spec.From = esq.sql
+	if unique := esq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if esq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := esq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, environmentschema.FieldID)
+		for i := range fields {
+			if fields[i] != environmentschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := esq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := esq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := esq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := esq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (esq *EnvironmentSchemaQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(esq.driver.Dialect())
+	t1 := builder.Table(environmentschema.Table)
+	columns := esq.ctx.Fields
+	if len(columns) == 0 {
+		columns = environmentschema.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if esq.sql != nil {
+		selector = esq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if esq.ctx.Unique != nil && *esq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range esq.predicates {
+		p(selector)
+	}
+	for _, p := range esq.order {
+		p(selector)
+	}
+	if offset := esq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := esq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// EnvironmentSchemaGroupBy is the group-by builder for EnvironmentSchema entities.
+type EnvironmentSchemaGroupBy struct {
+	selector
+	build *EnvironmentSchemaQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (esgb *EnvironmentSchemaGroupBy) Aggregate(fns ...AggregateFunc) *EnvironmentSchemaGroupBy {
+	esgb.fns = append(esgb.fns, fns...)
+	return esgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (esgb *EnvironmentSchemaGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, esgb.build.ctx, ent.OpQueryGroupBy)
+	if err := esgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EnvironmentSchemaQuery, *EnvironmentSchemaGroupBy](ctx, esgb.build, esgb, esgb.build.inters, v)
+}
+
+func (esgb *EnvironmentSchemaGroupBy) sqlScan(ctx context.Context, root *EnvironmentSchemaQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(esgb.fns))
+	for _, fn := range esgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*esgb.flds)+len(esgb.fns))
+		for _, f := range *esgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*esgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := esgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EnvironmentSchemaSelect is the builder for selecting fields of EnvironmentSchema entities.
+type EnvironmentSchemaSelect struct {
+	*EnvironmentSchemaQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ess *EnvironmentSchemaSelect) Aggregate(fns ...AggregateFunc) *EnvironmentSchemaSelect {
+	ess.fns = append(ess.fns, fns...)
+	return ess
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ess *EnvironmentSchemaSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ess.ctx, ent.OpQuerySelect)
+	if err := ess.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EnvironmentSchemaQuery, *EnvironmentSchemaSelect](ctx, ess.EnvironmentSchemaQuery, ess, ess.inters, v)
+}
+
+func (ess *EnvironmentSchemaSelect) sqlScan(ctx context.Context, root *EnvironmentSchemaQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ess.fns))
+	for _, fn := range ess.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ess.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ess.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
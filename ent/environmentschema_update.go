@@ -0,0 +1,674 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/environmentschema"
+	"github.com/kechako/envoke/ent/predicate"
+	"github.com/kechako/envoke/ent/schemakey"
+)
+
+// EnvironmentSchemaUpdate is the builder for updating EnvironmentSchema entities.
+type EnvironmentSchemaUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EnvironmentSchemaMutation
+}
+
+// Where appends a list predicates to the EnvironmentSchemaUpdate builder.
+func (esu *EnvironmentSchemaUpdate) Where(ps ...predicate.EnvironmentSchema) *EnvironmentSchemaUpdate {
+	esu.mutation.Where(ps...)
+	return esu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (esu *EnvironmentSchemaUpdate) SetUpdatedAt(t time.Time) *EnvironmentSchemaUpdate {
+	esu.mutation.SetUpdatedAt(t)
+	return esu
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (esu *EnvironmentSchemaUpdate) ClearUpdatedAt() *EnvironmentSchemaUpdate {
+	esu.mutation.ClearUpdatedAt()
+	return esu
+}
+
+// SetName sets the "name" field.
+func (esu *EnvironmentSchemaUpdate) SetName(s string) *EnvironmentSchemaUpdate {
+	esu.mutation.SetName(s)
+	return esu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (esu *EnvironmentSchemaUpdate) SetNillableName(s *string) *EnvironmentSchemaUpdate {
+	if s != nil {
+		esu.SetName(*s)
+	}
+	return esu
+}
+
+// SetDescription sets the "description" field.
+func (esu *EnvironmentSchemaUpdate) SetDescription(s string) *EnvironmentSchemaUpdate {
+	esu.mutation.SetDescription(s)
+	return esu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (esu *EnvironmentSchemaUpdate) SetNillableDescription(s *string) *EnvironmentSchemaUpdate {
+	if s != nil {
+		esu.SetDescription(*s)
+	}
+	return esu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (esu *EnvironmentSchemaUpdate) ClearDescription() *EnvironmentSchemaUpdate {
+	esu.mutation.ClearDescription()
+	return esu
+}
+
+// AddKeyIDs adds the "keys" edge to the SchemaKey entity by IDs.
+func (esu *EnvironmentSchemaUpdate) AddKeyIDs(ids ...int) *EnvironmentSchemaUpdate {
+	esu.mutation.AddKeyIDs(ids...)
+	return esu
+}
+
+// AddKeys adds the "keys" edges to the SchemaKey entity.
+func (esu *EnvironmentSchemaUpdate) AddKeys(s ...*SchemaKey) *EnvironmentSchemaUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return esu.AddKeyIDs(ids...)
+}
+
+// AddEnvironmentIDs adds the "environments" edge to the Environment entity by IDs.
+func (esu *EnvironmentSchemaUpdate) AddEnvironmentIDs(ids ...int) *EnvironmentSchemaUpdate {
+	esu.mutation.AddEnvironmentIDs(ids...)
+	return esu
+}
+
+// AddEnvironments adds the "environments" edges to the Environment entity.
+func (esu *EnvironmentSchemaUpdate) AddEnvironments(e ...*Environment) *EnvironmentSchemaUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return esu.AddEnvironmentIDs(ids...)
+}
+
+// Mutation returns the EnvironmentSchemaMutation object of the builder.
+func (esu *EnvironmentSchemaUpdate) Mutation() *EnvironmentSchemaMutation {
+	return esu.mutation
+}
+
+// ClearKeys clears all "keys" edges to the SchemaKey entity.
+func (esu *EnvironmentSchemaUpdate) ClearKeys() *EnvironmentSchemaUpdate {
+	esu.mutation.ClearKeys()
+	return esu
+}
+
+// RemoveKeyIDs removes the "keys" edge to SchemaKey entities by IDs.
+func (esu *EnvironmentSchemaUpdate) RemoveKeyIDs(ids ...int) *EnvironmentSchemaUpdate {
+	esu.mutation.RemoveKeyIDs(ids...)
+	return esu
+}
+
+// RemoveKeys removes "keys" edges to SchemaKey entities.
+func (esu *EnvironmentSchemaUpdate) RemoveKeys(s ...*SchemaKey) *EnvironmentSchemaUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return esu.RemoveKeyIDs(ids...)
+}
+
+// ClearEnvironments clears all "environments" edges to the Environment entity.
+func (esu *EnvironmentSchemaUpdate) ClearEnvironments() *EnvironmentSchemaUpdate {
+	esu.mutation.ClearEnvironments()
+	return esu
+}
+
+// RemoveEnvironmentIDs removes the "environments" edge to Environment entities by IDs.
+func (esu *EnvironmentSchemaUpdate) RemoveEnvironmentIDs(ids ...int) *EnvironmentSchemaUpdate {
+	esu.mutation.RemoveEnvironmentIDs(ids...)
+	return esu
+}
+
+// RemoveEnvironments removes "environments" edges to Environment entities.
+func (esu *EnvironmentSchemaUpdate) RemoveEnvironments(e ...*Environment) *EnvironmentSchemaUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return esu.RemoveEnvironmentIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (esu *EnvironmentSchemaUpdate) Save(ctx context.Context) (int, error) {
+	esu.defaults()
+	return withHooks(ctx, esu.sqlSave, esu.mutation, esu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (esu *EnvironmentSchemaUpdate) SaveX(ctx context.Context) int {
+	affected, err := esu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (esu *EnvironmentSchemaUpdate) Exec(ctx context.Context) error {
+	_, err := esu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esu *EnvironmentSchemaUpdate) ExecX(ctx context.Context) {
+	if err := esu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (esu *EnvironmentSchemaUpdate) defaults() {
+	if _, ok := esu.mutation.UpdatedAt(); !ok && !esu.mutation.UpdatedAtCleared() {
+		v := environmentschema.UpdateDefaultUpdatedAt()
+		esu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (esu *EnvironmentSchemaUpdate) check() error {
+	if v, ok := esu.mutation.Name(); ok {
+		if err := environmentschema.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EnvironmentSchema.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (esu *EnvironmentSchemaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := esu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(environmentschema.Table, environmentschema.Columns, sqlgraph.NewFieldSpec(environmentschema.FieldID, field.TypeInt))
+	if ps := esu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if esu.mutation.CreatedAtCleared() {
+		_spec.ClearField(environmentschema.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := esu.mutation.UpdatedAt(); ok {
+		_spec.SetField(environmentschema.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if esu.mutation.UpdatedAtCleared() {
+		_spec.ClearField(environmentschema.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := esu.mutation.Name(); ok {
+		_spec.SetField(environmentschema.FieldName, field.TypeString, value)
+	}
+	if value, ok := esu.mutation.Description(); ok {
+		_spec.SetField(environmentschema.FieldDescription, field.TypeString, value)
+	}
+	if esu.mutation.DescriptionCleared() {
+		_spec.ClearField(environmentschema.FieldDescription, field.TypeString)
+	}
+	if esu.mutation.KeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environmentschema.KeysTable,
+			Columns: []string{environmentschema.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schemakey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := esu.mutation.RemovedKeysIDs(); len(nodes) > 0 && !esu.mutation.KeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environmentschema.KeysTable,
+			Columns: []string{environmentschema.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schemakey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := esu.mutation.KeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environmentschema.KeysTable,
+			Columns: []string{environmentschema.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schemakey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if esu.mutation.EnvironmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environmentschema.EnvironmentsTable,
+			Columns: []string{environmentschema.EnvironmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := esu.mutation.RemovedEnvironmentsIDs(); len(nodes) > 0 && !esu.mutation.EnvironmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environmentschema.EnvironmentsTable,
+			Columns: []string{environmentschema.EnvironmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := esu.mutation.EnvironmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environmentschema.EnvironmentsTable,
+			Columns: []string{environmentschema.EnvironmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, esu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{environmentschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	esu.mutation.done = true
+	return n, nil
+}
+
+// EnvironmentSchemaUpdateOne is the builder for updating a single EnvironmentSchema entity.
+type EnvironmentSchemaUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EnvironmentSchemaMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (esuo *EnvironmentSchemaUpdateOne) SetUpdatedAt(t time.Time) *EnvironmentSchemaUpdateOne {
+	esuo.mutation.SetUpdatedAt(t)
+	return esuo
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (esuo *EnvironmentSchemaUpdateOne) ClearUpdatedAt() *EnvironmentSchemaUpdateOne {
+	esuo.mutation.ClearUpdatedAt()
+	return esuo
+}
+
+// SetName sets the "name" field.
+func (esuo *EnvironmentSchemaUpdateOne) SetName(s string) *EnvironmentSchemaUpdateOne {
+	esuo.mutation.SetName(s)
+	return esuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (esuo *EnvironmentSchemaUpdateOne) SetNillableName(s *string) *EnvironmentSchemaUpdateOne {
+	if s != nil {
+		esuo.SetName(*s)
+	}
+	return esuo
+}
+
+// SetDescription sets the "description" field.
+func (esuo *EnvironmentSchemaUpdateOne) SetDescription(s string) *EnvironmentSchemaUpdateOne {
+	esuo.mutation.SetDescription(s)
+	return esuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (esuo *EnvironmentSchemaUpdateOne) SetNillableDescription(s *string) *EnvironmentSchemaUpdateOne {
+	if s != nil {
+		esuo.SetDescription(*s)
+	}
+	return esuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (esuo *EnvironmentSchemaUpdateOne) ClearDescription() *EnvironmentSchemaUpdateOne {
+	esuo.mutation.ClearDescription()
+	return esuo
+}
+
+// AddKeyIDs adds the "keys" edge to the SchemaKey entity by IDs.
+func (esuo *EnvironmentSchemaUpdateOne) AddKeyIDs(ids ...int) *EnvironmentSchemaUpdateOne {
+	esuo.mutation.AddKeyIDs(ids...)
+	return esuo
+}
+
+// AddKeys adds the "keys" edges to the SchemaKey entity.
+func (esuo *EnvironmentSchemaUpdateOne) AddKeys(s ...*SchemaKey) *EnvironmentSchemaUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return esuo.AddKeyIDs(ids...)
+}
+
+// AddEnvironmentIDs adds the "environments" edge to the Environment entity by IDs.
+func (esuo *EnvironmentSchemaUpdateOne) AddEnvironmentIDs(ids ...int) *EnvironmentSchemaUpdateOne {
+	esuo.mutation.AddEnvironmentIDs(ids...)
+	return esuo
+}
+
+// AddEnvironments adds the "environments" edges to the Environment entity.
+func (esuo *EnvironmentSchemaUpdateOne) AddEnvironments(e ...*Environment) *EnvironmentSchemaUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return esuo.AddEnvironmentIDs(ids...)
+}
+
+// Mutation returns the EnvironmentSchemaMutation object of the builder.
+func (esuo *EnvironmentSchemaUpdateOne) Mutation() *EnvironmentSchemaMutation {
+	return esuo.mutation
+}
+
+// ClearKeys clears all "keys" edges to the SchemaKey entity.
+func (esuo *EnvironmentSchemaUpdateOne) ClearKeys() *EnvironmentSchemaUpdateOne {
+	esuo.mutation.ClearKeys()
+	return esuo
+}
+
+// RemoveKeyIDs removes the "keys" edge to SchemaKey entities by IDs.
+func (esuo *EnvironmentSchemaUpdateOne) RemoveKeyIDs(ids ...int) *EnvironmentSchemaUpdateOne {
+	esuo.mutation.RemoveKeyIDs(ids...)
+	return esuo
+}
+
+// RemoveKeys removes "keys" edges to SchemaKey entities.
+func (esuo *EnvironmentSchemaUpdateOne) RemoveKeys(s ...*SchemaKey) *EnvironmentSchemaUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return esuo.RemoveKeyIDs(ids...)
+}
+
+// ClearEnvironments clears all "environments" edges to the Environment entity.
+func (esuo *EnvironmentSchemaUpdateOne) ClearEnvironments() *EnvironmentSchemaUpdateOne {
+	esuo.mutation.ClearEnvironments()
+	return esuo
+}
+
+// RemoveEnvironmentIDs removes the "environments" edge to Environment entities by IDs.
+func (esuo *EnvironmentSchemaUpdateOne) RemoveEnvironmentIDs(ids ...int) *EnvironmentSchemaUpdateOne {
+	esuo.mutation.RemoveEnvironmentIDs(ids...)
+	return esuo
+}
+
+// RemoveEnvironments removes "environments" edges to Environment entities.
+func (esuo *EnvironmentSchemaUpdateOne) RemoveEnvironments(e ...*Environment) *EnvironmentSchemaUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return esuo.RemoveEnvironmentIDs(ids...)
+}
+
+// Where appends a list predicates to the EnvironmentSchemaUpdate builder.
+func (esuo *EnvironmentSchemaUpdateOne) Where(ps ...predicate.EnvironmentSchema) *EnvironmentSchemaUpdateOne {
+	esuo.mutation.Where(ps...)
+	return esuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (esuo *EnvironmentSchemaUpdateOne) Select(field string, fields ...string) *EnvironmentSchemaUpdateOne {
+	esuo.fields = append([]string{field}, fields...)
+	return esuo
+}
+
+// Save executes the query and returns the updated EnvironmentSchema entity.
+func (esuo *EnvironmentSchemaUpdateOne) Save(ctx context.Context) (*EnvironmentSchema, error) {
+	esuo.defaults()
+	return withHooks(ctx, esuo.sqlSave, esuo.mutation, esuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (esuo *EnvironmentSchemaUpdateOne) SaveX(ctx context.Context) *EnvironmentSchema {
+	node, err := esuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (esuo *EnvironmentSchemaUpdateOne) Exec(ctx context.Context) error {
+	_, err := esuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esuo *EnvironmentSchemaUpdateOne) ExecX(ctx context.Context) {
+	if err := esuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (esuo *EnvironmentSchemaUpdateOne) defaults() {
+	if _, ok := esuo.mutation.UpdatedAt(); !ok && !esuo.mutation.UpdatedAtCleared() {
+		v := environmentschema.UpdateDefaultUpdatedAt()
+		esuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (esuo *EnvironmentSchemaUpdateOne) check() error {
+	if v, ok := esuo.mutation.Name(); ok {
+		if err := environmentschema.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EnvironmentSchema.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (esuo *EnvironmentSchemaUpdateOne) sqlSave(ctx context.Context) (_node *EnvironmentSchema, err error) {
+	if err := esuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(environmentschema.Table, environmentschema.Columns, sqlgraph.NewFieldSpec(environmentschema.FieldID, field.TypeInt))
+	id, ok := esuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EnvironmentSchema.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := esuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, environmentschema.FieldID)
+		for _, f := range fields {
+			if !environmentschema.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != environmentschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := esuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if esuo.mutation.CreatedAtCleared() {
+		_spec.ClearField(environmentschema.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := esuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(environmentschema.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if esuo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(environmentschema.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := esuo.mutation.Name(); ok {
+		_spec.SetField(environmentschema.FieldName, field.TypeString, value)
+	}
+	if value, ok := esuo.mutation.Description(); ok {
+		_spec.SetField(environmentschema.FieldDescription, field.TypeString, value)
+	}
+	if esuo.mutation.DescriptionCleared() {
+		_spec.ClearField(environmentschema.FieldDescription, field.TypeString)
+	}
+	if esuo.mutation.KeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environmentschema.KeysTable,
+			Columns: []string{environmentschema.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schemakey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := esuo.mutation.RemovedKeysIDs(); len(nodes) > 0 && !esuo.mutation.KeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environmentschema.KeysTable,
+			Columns: []string{environmentschema.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schemakey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := esuo.mutation.KeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environmentschema.KeysTable,
+			Columns: []string{environmentschema.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schemakey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if esuo.mutation.EnvironmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environmentschema.EnvironmentsTable,
+			Columns: []string{environmentschema.EnvironmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := esuo.mutation.RemovedEnvironmentsIDs(); len(nodes) > 0 && !esuo.mutation.EnvironmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environmentschema.EnvironmentsTable,
+			Columns: []string{environmentschema.EnvironmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := esuo.mutation.EnvironmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environmentschema.EnvironmentsTable,
+			Columns: []string{environmentschema.EnvironmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EnvironmentSchema{config: esuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, esuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{environmentschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	esuo.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,18 @@ import (
 	"github.com/kechako/envoke/ent"
 )
 
+// The AllowedProjectFunc type is an adapter to allow the use of ordinary
+// function as AllowedProject mutator.
+type AllowedProjectFunc func(context.Context, *ent.AllowedProjectMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AllowedProjectFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AllowedProjectMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AllowedProjectMutation", m)
+}
+
 // The EnvironmentFunc type is an adapter to allow the use of ordinary
 // function as Environment mutator.
 type EnvironmentFunc func(context.Context, *ent.EnvironmentMutation) (ent.Value, error)
@@ -21,6 +33,66 @@ func (f EnvironmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EnvironmentMutation", m)
 }
 
+// The EnvironmentSchemaFunc type is an adapter to allow the use of ordinary
+// function as EnvironmentSchema mutator.
+type EnvironmentSchemaFunc func(context.Context, *ent.EnvironmentSchemaMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EnvironmentSchemaFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EnvironmentSchemaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EnvironmentSchemaMutation", m)
+}
+
+// The MergeStateFunc type is an adapter to allow the use of ordinary
+// function as MergeState mutator.
+type MergeStateFunc func(context.Context, *ent.MergeStateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MergeStateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MergeStateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MergeStateMutation", m)
+}
+
+// The RequiredVariableFunc type is an adapter to allow the use of ordinary
+// function as RequiredVariable mutator.
+type RequiredVariableFunc func(context.Context, *ent.RequiredVariableMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RequiredVariableFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RequiredVariableMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RequiredVariableMutation", m)
+}
+
+// The SchemaKeyFunc type is an adapter to allow the use of ordinary
+// function as SchemaKey mutator.
+type SchemaKeyFunc func(context.Context, *ent.SchemaKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SchemaKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SchemaKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SchemaKeyMutation", m)
+}
+
+// The SyncStateFunc type is an adapter to allow the use of ordinary
+// function as SyncState mutator.
+type SyncStateFunc func(context.Context, *ent.SyncStateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SyncStateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SyncStateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SyncStateMutation", m)
+}
+
 // The VariableFunc type is an adapter to allow the use of ordinary
 // function as Variable mutator.
 type VariableFunc func(context.Context, *ent.VariableMutation) (ent.Value, error)
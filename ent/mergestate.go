@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/mergestate"
+)
+
+// MergeState is the model entity for the MergeState schema.
+type MergeState struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID int `json:"environment_id,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// Local holds the value of the "local" field.
+	Local map[string]time.Time `json:"local,omitempty"`
+	// Remote holds the value of the "remote" field.
+	Remote map[string]time.Time `json:"remote,omitempty"`
+	// MergedAt holds the value of the "merged_at" field.
+	MergedAt time.Time `json:"merged_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MergeStateQuery when eager-loading is set.
+	Edges        MergeStateEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MergeStateEdges holds the relations/edges for other nodes in the graph.
+type MergeStateEdges struct {
+	// Environment holds the value of the environment edge.
+	Environment *Environment `json:"environment,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// EnvironmentOrErr returns the Environment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MergeStateEdges) EnvironmentOrErr() (*Environment, error) {
+	if e.Environment != nil {
+		return e.Environment, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: environment.Label}
+	}
+	return nil, &NotLoadedError{edge: "environment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MergeState) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mergestate.FieldLocal, mergestate.FieldRemote:
+			values[i] = new([]byte)
+		case mergestate.FieldID, mergestate.FieldEnvironmentID:
+			values[i] = new(sql.NullInt64)
+		case mergestate.FieldPath:
+			values[i] = new(sql.NullString)
+		case mergestate.FieldMergedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MergeState fields.
+func (ms *MergeState) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mergestate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ms.ID = int(value.Int64)
+		case mergestate.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				ms.EnvironmentID = int(value.Int64)
+			}
+		case mergestate.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				ms.Path = value.String
+			}
+		case mergestate.FieldLocal:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field local", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ms.Local); err != nil {
+					return fmt.Errorf("unmarshal field local: %w", err)
+				}
+			}
+		case mergestate.FieldRemote:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field remote", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ms.Remote); err != nil {
+					return fmt.Errorf("unmarshal field remote: %w", err)
+				}
+			}
+		case mergestate.FieldMergedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field merged_at", values[i])
+			} else if value.Valid {
+				ms.MergedAt = value.Time
+			}
+		default:
+			ms.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MergeState.
+// This includes values selected through modifiers, order, etc.
+func (ms *MergeState) Value(name string) (ent.Value, error) {
+	return ms.selectValues.Get(name)
+}
+
+// QueryEnvironment queries the "environment" edge of the MergeState entity.
+func (ms *MergeState) QueryEnvironment() *EnvironmentQuery {
+	return NewMergeStateClient(ms.config).QueryEnvironment(ms)
+}
+
+// Update returns a builder for updating this MergeState.
+// Note that you need to call MergeState.Unwrap() before calling this method if this MergeState
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ms *MergeState) Update() *MergeStateUpdateOne {
+	return NewMergeStateClient(ms.config).UpdateOne(ms)
+}
+
+// Unwrap unwraps the MergeState entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ms *MergeState) Unwrap() *MergeState {
+	_tx, ok := ms.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MergeState is not a transactional entity")
+	}
+	ms.config.driver = _tx.drv
+	return ms
+}
+
+// String implements the fmt.Stringer.
+func (ms *MergeState) String() string {
+	var builder strings.Builder
+	builder.WriteString("MergeState(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ms.ID))
+	builder.WriteString("environment_id=")
+	builder.WriteString(fmt.Sprintf("%v", ms.EnvironmentID))
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(ms.Path)
+	builder.WriteString(", ")
+	builder.WriteString("local=")
+	builder.WriteString(fmt.Sprintf("%v", ms.Local))
+	builder.WriteString(", ")
+	builder.WriteString("remote=")
+	builder.WriteString(fmt.Sprintf("%v", ms.Remote))
+	builder.WriteString(", ")
+	builder.WriteString("merged_at=")
+	builder.WriteString(ms.MergedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MergeStates is a parsable slice of MergeState.
+type MergeStates []*MergeState
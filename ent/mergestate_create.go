@@ -0,0 +1,712 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/mergestate"
+)
+
+// MergeStateCreate is the builder for creating a MergeState entity.
+type MergeStateCreate struct {
+	config
+	mutation *MergeStateMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (msc *MergeStateCreate) SetEnvironmentID(i int) *MergeStateCreate {
+	msc.mutation.SetEnvironmentID(i)
+	return msc
+}
+
+// SetPath sets the "path" field.
+func (msc *MergeStateCreate) SetPath(s string) *MergeStateCreate {
+	msc.mutation.SetPath(s)
+	return msc
+}
+
+// SetLocal sets the "local" field.
+func (msc *MergeStateCreate) SetLocal(m map[string]time.Time) *MergeStateCreate {
+	msc.mutation.SetLocal(m)
+	return msc
+}
+
+// SetRemote sets the "remote" field.
+func (msc *MergeStateCreate) SetRemote(m map[string]time.Time) *MergeStateCreate {
+	msc.mutation.SetRemote(m)
+	return msc
+}
+
+// SetMergedAt sets the "merged_at" field.
+func (msc *MergeStateCreate) SetMergedAt(t time.Time) *MergeStateCreate {
+	msc.mutation.SetMergedAt(t)
+	return msc
+}
+
+// SetNillableMergedAt sets the "merged_at" field if the given value is not nil.
+func (msc *MergeStateCreate) SetNillableMergedAt(t *time.Time) *MergeStateCreate {
+	if t != nil {
+		msc.SetMergedAt(*t)
+	}
+	return msc
+}
+
+// SetEnvironment sets the "environment" edge to the Environment entity.
+func (msc *MergeStateCreate) SetEnvironment(e *Environment) *MergeStateCreate {
+	return msc.SetEnvironmentID(e.ID)
+}
+
+// Mutation returns the MergeStateMutation object of the builder.
+func (msc *MergeStateCreate) Mutation() *MergeStateMutation {
+	return msc.mutation
+}
+
+// Save creates the MergeState in the database.
+func (msc *MergeStateCreate) Save(ctx context.Context) (*MergeState, error) {
+	msc.defaults()
+	return withHooks(ctx, msc.sqlSave, msc.mutation, msc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (msc *MergeStateCreate) SaveX(ctx context.Context) *MergeState {
+	v, err := msc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (msc *MergeStateCreate) Exec(ctx context.Context) error {
+	_, err := msc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msc *MergeStateCreate) ExecX(ctx context.Context) {
+	if err := msc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (msc *MergeStateCreate) defaults() {
+	if _, ok := msc.mutation.MergedAt(); !ok {
+		v := mergestate.DefaultMergedAt()
+		msc.mutation.SetMergedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (msc *MergeStateCreate) check() error {
+	if _, ok := msc.mutation.EnvironmentID(); !ok {
+		return &ValidationError{Name: "environment_id", err: errors.New(`ent: missing required field "MergeState.environment_id"`)}
+	}
+	if _, ok := msc.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "MergeState.path"`)}
+	}
+	if v, ok := msc.mutation.Path(); ok {
+		if err := mergestate.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "MergeState.path": %w`, err)}
+		}
+	}
+	if _, ok := msc.mutation.Local(); !ok {
+		return &ValidationError{Name: "local", err: errors.New(`ent: missing required field "MergeState.local"`)}
+	}
+	if _, ok := msc.mutation.Remote(); !ok {
+		return &ValidationError{Name: "remote", err: errors.New(`ent: missing required field "MergeState.remote"`)}
+	}
+	if _, ok := msc.mutation.MergedAt(); !ok {
+		return &ValidationError{Name: "merged_at", err: errors.New(`ent: missing required field "MergeState.merged_at"`)}
+	}
+	if len(msc.mutation.EnvironmentIDs()) == 0 {
+		return &ValidationError{Name: "environment", err: errors.New(`ent: missing required edge "MergeState.environment"`)}
+	}
+	return nil
+}
+
+func (msc *MergeStateCreate) sqlSave(ctx context.Context) (*MergeState, error) {
+	if err := msc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := msc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, msc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	msc.mutation.id = &_node.ID
+	msc.mutation.done = true
+	return _node, nil
+}
+
+func (msc *MergeStateCreate) createSpec() (*MergeState, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MergeState{config: msc.config}
+		_spec = sqlgraph.NewCreateSpec(mergestate.Table, sqlgraph.NewFieldSpec(mergestate.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = msc.conflict
+	if value, ok := msc.mutation.Path(); ok {
+		_spec.SetField(mergestate.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := msc.mutation.Local(); ok {
+		_spec.SetField(mergestate.FieldLocal, field.TypeJSON, value)
+		_node.Local = value
+	}
+	if value, ok := msc.mutation.Remote(); ok {
+		_spec.SetField(mergestate.FieldRemote, field.TypeJSON, value)
+		_node.Remote = value
+	}
+	if value, ok := msc.mutation.MergedAt(); ok {
+		_spec.SetField(mergestate.FieldMergedAt, field.TypeTime, value)
+		_node.MergedAt = value
+	}
+	if nodes := msc.mutation.EnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mergestate.EnvironmentTable,
+			Columns: []string{mergestate.EnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EnvironmentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MergeState.Create().
+//		SetEnvironmentID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MergeStateUpsert) {
+//			SetEnvironmentID(v+v).
+//		}).
+//		Exec(ctx)
+func (msc *MergeStateCreate) OnConflict(opts ...sql.ConflictOption) *MergeStateUpsertOne {
+	msc.conflict = opts
+	return &MergeStateUpsertOne{
+		create: msc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MergeState.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (msc *MergeStateCreate) OnConflictColumns(columns ...string) *MergeStateUpsertOne {
+	msc.conflict = append(msc.conflict, sql.ConflictColumns(columns...))
+	return &MergeStateUpsertOne{
+		create: msc,
+	}
+}
+
+type (
+	// MergeStateUpsertOne is the builder for "upsert"-ing
+	//  one MergeState node.
+	MergeStateUpsertOne struct {
+		create *MergeStateCreate
+	}
+
+	// MergeStateUpsert is the "OnConflict" setter.
+	MergeStateUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEnvironmentID sets the "environment_id" field.
+func (u *MergeStateUpsert) SetEnvironmentID(v int) *MergeStateUpsert {
+	u.Set(mergestate.FieldEnvironmentID, v)
+	return u
+}
+
+// UpdateEnvironmentID sets the "environment_id" field to the value that was provided on create.
+func (u *MergeStateUpsert) UpdateEnvironmentID() *MergeStateUpsert {
+	u.SetExcluded(mergestate.FieldEnvironmentID)
+	return u
+}
+
+// SetPath sets the "path" field.
+func (u *MergeStateUpsert) SetPath(v string) *MergeStateUpsert {
+	u.Set(mergestate.FieldPath, v)
+	return u
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *MergeStateUpsert) UpdatePath() *MergeStateUpsert {
+	u.SetExcluded(mergestate.FieldPath)
+	return u
+}
+
+// SetLocal sets the "local" field.
+func (u *MergeStateUpsert) SetLocal(v map[string]time.Time) *MergeStateUpsert {
+	u.Set(mergestate.FieldLocal, v)
+	return u
+}
+
+// UpdateLocal sets the "local" field to the value that was provided on create.
+func (u *MergeStateUpsert) UpdateLocal() *MergeStateUpsert {
+	u.SetExcluded(mergestate.FieldLocal)
+	return u
+}
+
+// SetRemote sets the "remote" field.
+func (u *MergeStateUpsert) SetRemote(v map[string]time.Time) *MergeStateUpsert {
+	u.Set(mergestate.FieldRemote, v)
+	return u
+}
+
+// UpdateRemote sets the "remote" field to the value that was provided on create.
+func (u *MergeStateUpsert) UpdateRemote() *MergeStateUpsert {
+	u.SetExcluded(mergestate.FieldRemote)
+	return u
+}
+
+// SetMergedAt sets the "merged_at" field.
+func (u *MergeStateUpsert) SetMergedAt(v time.Time) *MergeStateUpsert {
+	u.Set(mergestate.FieldMergedAt, v)
+	return u
+}
+
+// UpdateMergedAt sets the "merged_at" field to the value that was provided on create.
+func (u *MergeStateUpsert) UpdateMergedAt() *MergeStateUpsert {
+	u.SetExcluded(mergestate.FieldMergedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.MergeState.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *MergeStateUpsertOne) UpdateNewValues() *MergeStateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MergeState.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MergeStateUpsertOne) Ignore() *MergeStateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MergeStateUpsertOne) DoNothing() *MergeStateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MergeStateCreate.OnConflict
+// documentation for more info.
+func (u *MergeStateUpsertOne) Update(set func(*MergeStateUpsert)) *MergeStateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MergeStateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (u *MergeStateUpsertOne) SetEnvironmentID(v int) *MergeStateUpsertOne {
+	return u.Update(func(s *MergeStateUpsert) {
+		s.SetEnvironmentID(v)
+	})
+}
+
+// UpdateEnvironmentID sets the "environment_id" field to the value that was provided on create.
+func (u *MergeStateUpsertOne) UpdateEnvironmentID() *MergeStateUpsertOne {
+	return u.Update(func(s *MergeStateUpsert) {
+		s.UpdateEnvironmentID()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *MergeStateUpsertOne) SetPath(v string) *MergeStateUpsertOne {
+	return u.Update(func(s *MergeStateUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *MergeStateUpsertOne) UpdatePath() *MergeStateUpsertOne {
+	return u.Update(func(s *MergeStateUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetLocal sets the "local" field.
+func (u *MergeStateUpsertOne) SetLocal(v map[string]time.Time) *MergeStateUpsertOne {
+	return u.Update(func(s *MergeStateUpsert) {
+		s.SetLocal(v)
+	})
+}
+
+// UpdateLocal sets the "local" field to the value that was provided on create.
+func (u *MergeStateUpsertOne) UpdateLocal() *MergeStateUpsertOne {
+	return u.Update(func(s *MergeStateUpsert) {
+		s.UpdateLocal()
+	})
+}
+
+// SetRemote sets the "remote" field.
+func (u *MergeStateUpsertOne) SetRemote(v map[string]time.Time) *MergeStateUpsertOne {
+	return u.Update(func(s *MergeStateUpsert) {
+		s.SetRemote(v)
+	})
+}
+
+// UpdateRemote sets the "remote" field to the value that was provided on create.
+func (u *MergeStateUpsertOne) UpdateRemote() *MergeStateUpsertOne {
+	return u.Update(func(s *MergeStateUpsert) {
+		s.UpdateRemote()
+	})
+}
+
+// SetMergedAt sets the "merged_at" field.
+func (u *MergeStateUpsertOne) SetMergedAt(v time.Time) *MergeStateUpsertOne {
+	return u.Update(func(s *MergeStateUpsert) {
+		s.SetMergedAt(v)
+	})
+}
+
+// UpdateMergedAt sets the "merged_at" field to the value that was provided on create.
+func (u *MergeStateUpsertOne) UpdateMergedAt() *MergeStateUpsertOne {
+	return u.Update(func(s *MergeStateUpsert) {
+		s.UpdateMergedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MergeStateUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MergeStateCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MergeStateUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MergeStateUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MergeStateUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MergeStateCreateBulk is the builder for creating many MergeState entities in bulk.
+type MergeStateCreateBulk struct {
+	config
+	err      error
+	builders []*MergeStateCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MergeState entities in the database.
+func (mscb *MergeStateCreateBulk) Save(ctx context.Context) ([]*MergeState, error) {
+	if mscb.err != nil {
+		return nil, mscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mscb.builders))
+	nodes := make([]*MergeState, len(mscb.builders))
+	mutators := make([]Mutator, len(mscb.builders))
+	for i := range mscb.builders {
+		func(i int, root context.Context) {
+			builder := mscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MergeStateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mscb *MergeStateCreateBulk) SaveX(ctx context.Context) []*MergeState {
+	v, err := mscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mscb *MergeStateCreateBulk) Exec(ctx context.Context) error {
+	_, err := mscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mscb *MergeStateCreateBulk) ExecX(ctx context.Context) {
+	if err := mscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MergeState.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MergeStateUpsert) {
+//			SetEnvironmentID(v+v).
+//		}).
+//		Exec(ctx)
+func (mscb *MergeStateCreateBulk) OnConflict(opts ...sql.ConflictOption) *MergeStateUpsertBulk {
+	mscb.conflict = opts
+	return &MergeStateUpsertBulk{
+		create: mscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MergeState.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mscb *MergeStateCreateBulk) OnConflictColumns(columns ...string) *MergeStateUpsertBulk {
+	mscb.conflict = append(mscb.conflict, sql.ConflictColumns(columns...))
+	return &MergeStateUpsertBulk{
+		create: mscb,
+	}
+}
+
+// MergeStateUpsertBulk is the builder for "upsert"-ing
+// a bulk of MergeState nodes.
+type MergeStateUpsertBulk struct {
+	create *MergeStateCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MergeState.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *MergeStateUpsertBulk) UpdateNewValues() *MergeStateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MergeState.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MergeStateUpsertBulk) Ignore() *MergeStateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MergeStateUpsertBulk) DoNothing() *MergeStateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MergeStateCreateBulk.OnConflict
+// documentation for more info.
+func (u *MergeStateUpsertBulk) Update(set func(*MergeStateUpsert)) *MergeStateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MergeStateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (u *MergeStateUpsertBulk) SetEnvironmentID(v int) *MergeStateUpsertBulk {
+	return u.Update(func(s *MergeStateUpsert) {
+		s.SetEnvironmentID(v)
+	})
+}
+
+// UpdateEnvironmentID sets the "environment_id" field to the value that was provided on create.
+func (u *MergeStateUpsertBulk) UpdateEnvironmentID() *MergeStateUpsertBulk {
+	return u.Update(func(s *MergeStateUpsert) {
+		s.UpdateEnvironmentID()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *MergeStateUpsertBulk) SetPath(v string) *MergeStateUpsertBulk {
+	return u.Update(func(s *MergeStateUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *MergeStateUpsertBulk) UpdatePath() *MergeStateUpsertBulk {
+	return u.Update(func(s *MergeStateUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetLocal sets the "local" field.
+func (u *MergeStateUpsertBulk) SetLocal(v map[string]time.Time) *MergeStateUpsertBulk {
+	return u.Update(func(s *MergeStateUpsert) {
+		s.SetLocal(v)
+	})
+}
+
+// UpdateLocal sets the "local" field to the value that was provided on create.
+func (u *MergeStateUpsertBulk) UpdateLocal() *MergeStateUpsertBulk {
+	return u.Update(func(s *MergeStateUpsert) {
+		s.UpdateLocal()
+	})
+}
+
+// SetRemote sets the "remote" field.
+func (u *MergeStateUpsertBulk) SetRemote(v map[string]time.Time) *MergeStateUpsertBulk {
+	return u.Update(func(s *MergeStateUpsert) {
+		s.SetRemote(v)
+	})
+}
+
+// UpdateRemote sets the "remote" field to the value that was provided on create.
+func (u *MergeStateUpsertBulk) UpdateRemote() *MergeStateUpsertBulk {
+	return u.Update(func(s *MergeStateUpsert) {
+		s.UpdateRemote()
+	})
+}
+
+// SetMergedAt sets the "merged_at" field.
+func (u *MergeStateUpsertBulk) SetMergedAt(v time.Time) *MergeStateUpsertBulk {
+	return u.Update(func(s *MergeStateUpsert) {
+		s.SetMergedAt(v)
+	})
+}
+
+// UpdateMergedAt sets the "merged_at" field to the value that was provided on create.
+func (u *MergeStateUpsertBulk) UpdateMergedAt() *MergeStateUpsertBulk {
+	return u.Update(func(s *MergeStateUpsert) {
+		s.UpdateMergedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MergeStateUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MergeStateCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MergeStateCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MergeStateUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
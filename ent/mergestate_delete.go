@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/envoke/ent/mergestate"
+	"github.com/kechako/envoke/ent/predicate"
+)
+
+// MergeStateDelete is the builder for deleting a MergeState entity.
+type MergeStateDelete struct {
+	config
+	hooks    []Hook
+	mutation *MergeStateMutation
+}
+
+// Where appends a list predicates to the MergeStateDelete builder.
+func (msd *MergeStateDelete) Where(ps ...predicate.MergeState) *MergeStateDelete {
+	msd.mutation.Where(ps...)
+	return msd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (msd *MergeStateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, msd.sqlExec, msd.mutation, msd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msd *MergeStateDelete) ExecX(ctx context.Context) int {
+	n, err := msd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (msd *MergeStateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(mergestate.Table, sqlgraph.NewFieldSpec(mergestate.FieldID, field.TypeInt))
+	if ps := msd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, msd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	msd.mutation.done = true
+	return affected, err
+}
+
+// MergeStateDeleteOne is the builder for deleting a single MergeState entity.
+type MergeStateDeleteOne struct {
+	msd *MergeStateDelete
+}
+
+// Where appends a list predicates to the MergeStateDelete builder.
+func (msdo *MergeStateDeleteOne) Where(ps ...predicate.MergeState) *MergeStateDeleteOne {
+	msdo.msd.mutation.Where(ps...)
+	return msdo
+}
+
+// Exec executes the deletion query.
+func (msdo *MergeStateDeleteOne) Exec(ctx context.Context) error {
+	n, err := msdo.msd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{mergestate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msdo *MergeStateDeleteOne) ExecX(ctx context.Context) {
+	if err := msdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/mergestate"
+	"github.com/kechako/envoke/ent/predicate"
+)
+
+// MergeStateQuery is the builder for querying MergeState entities.
+type MergeStateQuery struct {
+	config
+	ctx             *QueryContext
+	order           []mergestate.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.MergeState
+	withEnvironment *EnvironmentQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MergeStateQuery builder.
+func (msq *MergeStateQuery) Where(ps ...predicate.MergeState) *MergeStateQuery {
+	msq.predicates = append(msq.predicates, ps...)
+	return msq
+}
+
+// Limit the number of records to be returned by this query.
+func (msq *MergeStateQuery) Limit(limit int) *MergeStateQuery {
+	msq.ctx.Limit = &limit
+	return msq
+}
+
+// Offset to start from.
+func (msq *MergeStateQuery) Offset(offset int) *MergeStateQuery {
+	msq.ctx.Offset = &offset
+	return msq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (msq *MergeStateQuery) Unique(unique bool) *MergeStateQuery {
+	msq.ctx.Unique = &unique
+	return msq
+}
+
+// Order specifies how the records should be ordered.
+func (msq *MergeStateQuery) Order(o ...mergestate.OrderOption) *MergeStateQuery {
+	msq.order = append(msq.order, o...)
+	return msq
+}
+
+// QueryEnvironment chains the current query on the "environment" edge.
+func (msq *MergeStateQuery) QueryEnvironment() *EnvironmentQuery {
+	query := (&EnvironmentClient{config: msq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := msq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := msq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mergestate.Table, mergestate.FieldID, selector),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, mergestate.EnvironmentTable, mergestate.EnvironmentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(msq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MergeState entity from the query.
+// Returns a *NotFoundError when no MergeState was found.
+func (msq *MergeStateQuery) First(ctx context.Context) (*MergeState, error) {
+	nodes, err := msq.Limit(1).All(setContextOp(ctx, msq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{mergestate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (msq *MergeStateQuery) FirstX(ctx context.Context) *MergeState {
+	node, err := msq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MergeState ID from the query.
+// Returns a *NotFoundError when no MergeState ID was found.
+func (msq *MergeStateQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = msq.Limit(1).IDs(setContextOp(ctx, msq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{mergestate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (msq *MergeStateQuery) FirstIDX(ctx context.Context) int {
+	id, err := msq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MergeState entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MergeState entity is found.
+// Returns a *NotFoundError when no MergeState entities are found.
+func (msq *MergeStateQuery) Only(ctx context.Context) (*MergeState, error) {
+	nodes, err := msq.Limit(2).All(setContextOp(ctx, msq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{mergestate.Label}
+	default:
+		return nil, &NotSingularError{mergestate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (msq *MergeStateQuery) OnlyX(ctx context.Context) *MergeState {
+	node, err := msq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MergeState ID in the query.
+// Returns a *NotSingularError when more than one MergeState ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (msq *MergeStateQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = msq.Limit(2).IDs(setContextOp(ctx, msq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{mergestate.Label}
+	default:
+		err = &NotSingularError{mergestate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (msq *MergeStateQuery) OnlyIDX(ctx context.Context) int {
+	id, err := msq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MergeStates.
+func (msq *MergeStateQuery) All(ctx context.Context) ([]*MergeState, error) {
+	ctx = setContextOp(ctx, msq.ctx, ent.OpQueryAll)
+	if err := msq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MergeState, *MergeStateQuery]()
+	return withInterceptors[[]*MergeState](ctx, msq, qr, msq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (msq *MergeStateQuery) AllX(ctx context.Context) []*MergeState {
+	nodes, err := msq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MergeState IDs.
+func (msq *MergeStateQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if msq.ctx.Unique == nil && msq.path != nil {
+		msq.Unique(true)
+	}
+	ctx = setContextOp(ctx, msq.ctx, ent.OpQueryIDs)
+	if err = msq.Select(mergestate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (msq *MergeStateQuery) IDsX(ctx context.Context) []int {
+	ids, err := msq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (msq *MergeStateQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, msq.ctx, ent.OpQueryCount)
+	if err := msq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, msq, querierCount[*MergeStateQuery](), msq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (msq *MergeStateQuery) CountX(ctx context.Context) int {
+	count, err := msq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (msq *MergeStateQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, msq.ctx, ent.OpQueryExist)
+	switch _, err := msq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (msq *MergeStateQuery) ExistX(ctx context.Context) bool {
+	exist, err := msq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MergeStateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (msq *MergeStateQuery) Clone() *MergeStateQuery {
+	if msq == nil {
+		return nil
+	}
+	return &MergeStateQuery{
+		config:          msq.config,
+		ctx:             msq.ctx.Clone(),
+		order:           append([]mergestate.OrderOption{}, msq.order...),
+		inters:          append([]Interceptor{}, msq.inters...),
+		predicates:      append([]predicate.MergeState{}, msq.predicates...),
+		withEnvironment: msq.withEnvironment.Clone(),
+		// clone intermediate query.
+		sql:  msq.sql.Clone(),
+		path: msq.path,
+	}
+}
+
+// WithEnvironment tells the query-builder to eager-load the nodes that are connected to
+// the "environment" edge. The optional arguments are used to configure the query builder of the edge.
+func (msq *MergeStateQuery) WithEnvironment(opts ...func(*EnvironmentQuery)) *MergeStateQuery {
+	query := (&EnvironmentClient{config: msq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	msq.withEnvironment = query
+	return msq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		EnvironmentID int `json:"environment_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MergeState.Query().
+//		GroupBy(mergestate.FieldEnvironmentID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (msq *MergeStateQuery) GroupBy(field string, fields ...string) *MergeStateGroupBy {
+	msq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MergeStateGroupBy{build: msq}
+	grbuild.flds = &msq.ctx.Fields
+	grbuild.label = mergestate.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		EnvironmentID int `json:"environment_id,omitempty"`
+//	}
+//
+//	client.MergeState.Query().
+//		Select(mergestate.FieldEnvironmentID).
+//		Scan(ctx, &v)
+func (msq *MergeStateQuery) Select(fields ...string) *MergeStateSelect {
+	msq.ctx.Fields = append(msq.ctx.Fields, fields...)
+	sbuild := &MergeStateSelect{MergeStateQuery: msq}
+	sbuild.label = mergestate.Label
+	sbuild.flds, sbuild.scan = &msq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MergeStateSelect configured with the given aggregations.
+func (msq *MergeStateQuery) Aggregate(fns ...AggregateFunc) *MergeStateSelect {
+	return msq.Select().Aggregate(fns...)
+}
+
+func (msq *MergeStateQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range msq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, msq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range msq.ctx.Fields {
+		if !mergestate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if msq.path != nil {
+		prev, err := msq.path(ctx)
+		if err != nil {
+			return err
+		}
+		msq.sql = prev
+	}
+	return nil
+}
+
+func (msq *MergeStateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MergeState, error) {
+	var (
+		nodes       = []*MergeState{}
+		_spec       = msq.querySpec()
+		loadedTypes = [1]bool{
+			msq.withEnvironment != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MergeState).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MergeState{config: msq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, msq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := msq.withEnvironment; query != nil {
+		if err := msq.loadEnvironment(ctx, query, nodes, nil,
+			func(n *MergeState, e *Environment) { n.Edges.Environment = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (msq *MergeStateQuery) loadEnvironment(ctx context.Context, query *EnvironmentQuery, nodes []*MergeState, init func(*MergeState), assign func(*MergeState, *Environment)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*MergeState)
+	for i := range nodes {
+		fk := nodes[i].EnvironmentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(environment.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "environment_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (msq *MergeStateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := msq.querySpec()
+	_spec.Node.Columns = msq.ctx.Fields
+	if len(msq.ctx.Fields) > 0 {
+		_spec.Unique = msq.ctx.Unique != nil && *msq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, msq.driver, _spec)
+}
+
+func (msq *MergeStateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(mergestate.Table, mergestate.Columns, sqlgraph.NewFieldSpec(mergestate.FieldID, field.TypeInt))
+	_spec.From = msq.sql
+	if unique := msq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if msq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := msq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mergestate.FieldID)
+		for i := range fields {
+			if fields[i] != mergestate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if msq.withEnvironment != nil {
+			_spec.Node.AddColumnOnce(mergestate.FieldEnvironmentID)
+		}
+	}
+	if ps := msq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := msq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := msq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := msq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (msq *MergeStateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(msq.driver.Dialect())
+	t1 := builder.Table(mergestate.Table)
+	columns := msq.ctx.Fields
+	if len(columns) == 0 {
+		columns = mergestate.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if msq.sql != nil {
+		selector = msq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if msq.ctx.Unique != nil && *msq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range msq.predicates {
+		p(selector)
+	}
+	for _, p := range msq.order {
+		p(selector)
+	}
+	if offset := msq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := msq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MergeStateGroupBy is the group-by builder for MergeState entities.
+type MergeStateGroupBy struct {
+	selector
+	build *MergeStateQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (msgb *MergeStateGroupBy) Aggregate(fns ...AggregateFunc) *MergeStateGroupBy {
+	msgb.fns = append(msgb.fns, fns...)
+	return msgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (msgb *MergeStateGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, msgb.build.ctx, ent.OpQueryGroupBy)
+	if err := msgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MergeStateQuery, *MergeStateGroupBy](ctx, msgb.build, msgb, msgb.build.inters, v)
+}
+
+func (msgb *MergeStateGroupBy) sqlScan(ctx context.Context, root *MergeStateQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(msgb.fns))
+	for _, fn := range msgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*msgb.flds)+len(msgb.fns))
+		for _, f := range *msgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*msgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := msgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MergeStateSelect is the builder for selecting fields of MergeState entities.
+type MergeStateSelect struct {
+	*MergeStateQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mss *MergeStateSelect) Aggregate(fns ...AggregateFunc) *MergeStateSelect {
+	mss.fns = append(mss.fns, fns...)
+	return mss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mss *MergeStateSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mss.ctx, ent.OpQuerySelect)
+	if err := mss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MergeStateQuery, *MergeStateSelect](ctx, mss.MergeStateQuery, mss, mss.inters, v)
+}
+
+func (mss *MergeStateSelect) sqlScan(ctx context.Context, root *MergeStateQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mss.fns))
+	for _, fn := range mss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
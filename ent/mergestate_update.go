@@ -0,0 +1,423 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/mergestate"
+	"github.com/kechako/envoke/ent/predicate"
+)
+
+// MergeStateUpdate is the builder for updating MergeState entities.
+type MergeStateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MergeStateMutation
+}
+
+// Where appends a list predicates to the MergeStateUpdate builder.
+func (msu *MergeStateUpdate) Where(ps ...predicate.MergeState) *MergeStateUpdate {
+	msu.mutation.Where(ps...)
+	return msu
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (msu *MergeStateUpdate) SetEnvironmentID(i int) *MergeStateUpdate {
+	msu.mutation.SetEnvironmentID(i)
+	return msu
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (msu *MergeStateUpdate) SetNillableEnvironmentID(i *int) *MergeStateUpdate {
+	if i != nil {
+		msu.SetEnvironmentID(*i)
+	}
+	return msu
+}
+
+// SetPath sets the "path" field.
+func (msu *MergeStateUpdate) SetPath(s string) *MergeStateUpdate {
+	msu.mutation.SetPath(s)
+	return msu
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (msu *MergeStateUpdate) SetNillablePath(s *string) *MergeStateUpdate {
+	if s != nil {
+		msu.SetPath(*s)
+	}
+	return msu
+}
+
+// SetLocal sets the "local" field.
+func (msu *MergeStateUpdate) SetLocal(m map[string]time.Time) *MergeStateUpdate {
+	msu.mutation.SetLocal(m)
+	return msu
+}
+
+// SetRemote sets the "remote" field.
+func (msu *MergeStateUpdate) SetRemote(m map[string]time.Time) *MergeStateUpdate {
+	msu.mutation.SetRemote(m)
+	return msu
+}
+
+// SetMergedAt sets the "merged_at" field.
+func (msu *MergeStateUpdate) SetMergedAt(t time.Time) *MergeStateUpdate {
+	msu.mutation.SetMergedAt(t)
+	return msu
+}
+
+// SetEnvironment sets the "environment" edge to the Environment entity.
+func (msu *MergeStateUpdate) SetEnvironment(e *Environment) *MergeStateUpdate {
+	return msu.SetEnvironmentID(e.ID)
+}
+
+// Mutation returns the MergeStateMutation object of the builder.
+func (msu *MergeStateUpdate) Mutation() *MergeStateMutation {
+	return msu.mutation
+}
+
+// ClearEnvironment clears the "environment" edge to the Environment entity.
+func (msu *MergeStateUpdate) ClearEnvironment() *MergeStateUpdate {
+	msu.mutation.ClearEnvironment()
+	return msu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (msu *MergeStateUpdate) Save(ctx context.Context) (int, error) {
+	msu.defaults()
+	return withHooks(ctx, msu.sqlSave, msu.mutation, msu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (msu *MergeStateUpdate) SaveX(ctx context.Context) int {
+	affected, err := msu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (msu *MergeStateUpdate) Exec(ctx context.Context) error {
+	_, err := msu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msu *MergeStateUpdate) ExecX(ctx context.Context) {
+	if err := msu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (msu *MergeStateUpdate) defaults() {
+	if _, ok := msu.mutation.MergedAt(); !ok {
+		v := mergestate.UpdateDefaultMergedAt()
+		msu.mutation.SetMergedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (msu *MergeStateUpdate) check() error {
+	if v, ok := msu.mutation.Path(); ok {
+		if err := mergestate.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "MergeState.path": %w`, err)}
+		}
+	}
+	if msu.mutation.EnvironmentCleared() && len(msu.mutation.EnvironmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MergeState.environment"`)
+	}
+	return nil
+}
+
+func (msu *MergeStateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := msu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mergestate.Table, mergestate.Columns, sqlgraph.NewFieldSpec(mergestate.FieldID, field.TypeInt))
+	if ps := msu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := msu.mutation.Path(); ok {
+		_spec.SetField(mergestate.FieldPath, field.TypeString, value)
+	}
+	if value, ok := msu.mutation.Local(); ok {
+		_spec.SetField(mergestate.FieldLocal, field.TypeJSON, value)
+	}
+	if value, ok := msu.mutation.Remote(); ok {
+		_spec.SetField(mergestate.FieldRemote, field.TypeJSON, value)
+	}
+	if value, ok := msu.mutation.MergedAt(); ok {
+		_spec.SetField(mergestate.FieldMergedAt, field.TypeTime, value)
+	}
+	if msu.mutation.EnvironmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mergestate.EnvironmentTable,
+			Columns: []string{mergestate.EnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := msu.mutation.EnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mergestate.EnvironmentTable,
+			Columns: []string{mergestate.EnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, msu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mergestate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	msu.mutation.done = true
+	return n, nil
+}
+
+// MergeStateUpdateOne is the builder for updating a single MergeState entity.
+type MergeStateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MergeStateMutation
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (msuo *MergeStateUpdateOne) SetEnvironmentID(i int) *MergeStateUpdateOne {
+	msuo.mutation.SetEnvironmentID(i)
+	return msuo
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (msuo *MergeStateUpdateOne) SetNillableEnvironmentID(i *int) *MergeStateUpdateOne {
+	if i != nil {
+		msuo.SetEnvironmentID(*i)
+	}
+	return msuo
+}
+
+// SetPath sets the "path" field.
+func (msuo *MergeStateUpdateOne) SetPath(s string) *MergeStateUpdateOne {
+	msuo.mutation.SetPath(s)
+	return msuo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (msuo *MergeStateUpdateOne) SetNillablePath(s *string) *MergeStateUpdateOne {
+	if s != nil {
+		msuo.SetPath(*s)
+	}
+	return msuo
+}
+
+// SetLocal sets the "local" field.
+func (msuo *MergeStateUpdateOne) SetLocal(m map[string]time.Time) *MergeStateUpdateOne {
+	msuo.mutation.SetLocal(m)
+	return msuo
+}
+
+// SetRemote sets the "remote" field.
+func (msuo *MergeStateUpdateOne) SetRemote(m map[string]time.Time) *MergeStateUpdateOne {
+	msuo.mutation.SetRemote(m)
+	return msuo
+}
+
+// SetMergedAt sets the "merged_at" field.
+func (msuo *MergeStateUpdateOne) SetMergedAt(t time.Time) *MergeStateUpdateOne {
+	msuo.mutation.SetMergedAt(t)
+	return msuo
+}
+
+// SetEnvironment sets the "environment" edge to the Environment entity.
+func (msuo *MergeStateUpdateOne) SetEnvironment(e *Environment) *MergeStateUpdateOne {
+	return msuo.SetEnvironmentID(e.ID)
+}
+
+// Mutation returns the MergeStateMutation object of the builder.
+func (msuo *MergeStateUpdateOne) Mutation() *MergeStateMutation {
+	return msuo.mutation
+}
+
+// ClearEnvironment clears the "environment" edge to the Environment entity.
+func (msuo *MergeStateUpdateOne) ClearEnvironment() *MergeStateUpdateOne {
+	msuo.mutation.ClearEnvironment()
+	return msuo
+}
+
+// Where appends a list predicates to the MergeStateUpdate builder.
+func (msuo *MergeStateUpdateOne) Where(ps ...predicate.MergeState) *MergeStateUpdateOne {
+	msuo.mutation.Where(ps...)
+	return msuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (msuo *MergeStateUpdateOne) Select(field string, fields ...string) *MergeStateUpdateOne {
+	msuo.fields = append([]string{field}, fields...)
+	return msuo
+}
+
+// Save executes the query and returns the updated MergeState entity.
+func (msuo *MergeStateUpdateOne) Save(ctx context.Context) (*MergeState, error) {
+	msuo.defaults()
+	return withHooks(ctx, msuo.sqlSave, msuo.mutation, msuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (msuo *MergeStateUpdateOne) SaveX(ctx context.Context) *MergeState {
+	node, err := msuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (msuo *MergeStateUpdateOne) Exec(ctx context.Context) error {
+	_, err := msuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msuo *MergeStateUpdateOne) ExecX(ctx context.Context) {
+	if err := msuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (msuo *MergeStateUpdateOne) defaults() {
+	if _, ok := msuo.mutation.MergedAt(); !ok {
+		v := mergestate.UpdateDefaultMergedAt()
+		msuo.mutation.SetMergedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (msuo *MergeStateUpdateOne) check() error {
+	if v, ok := msuo.mutation.Path(); ok {
+		if err := mergestate.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "MergeState.path": %w`, err)}
+		}
+	}
+	if msuo.mutation.EnvironmentCleared() && len(msuo.mutation.EnvironmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MergeState.environment"`)
+	}
+	return nil
+}
+
+func (msuo *MergeStateUpdateOne) sqlSave(ctx context.Context) (_node *MergeState, err error) {
+	if err := msuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mergestate.Table, mergestate.Columns, sqlgraph.NewFieldSpec(mergestate.FieldID, field.TypeInt))
+	id, ok := msuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MergeState.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := msuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mergestate.FieldID)
+		for _, f := range fields {
+			if !mergestate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mergestate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := msuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := msuo.mutation.Path(); ok {
+		_spec.SetField(mergestate.FieldPath, field.TypeString, value)
+	}
+	if value, ok := msuo.mutation.Local(); ok {
+		_spec.SetField(mergestate.FieldLocal, field.TypeJSON, value)
+	}
+	if value, ok := msuo.mutation.Remote(); ok {
+		_spec.SetField(mergestate.FieldRemote, field.TypeJSON, value)
+	}
+	if value, ok := msuo.mutation.MergedAt(); ok {
+		_spec.SetField(mergestate.FieldMergedAt, field.TypeTime, value)
+	}
+	if msuo.mutation.EnvironmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mergestate.EnvironmentTable,
+			Columns: []string{mergestate.EnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := msuo.mutation.EnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mergestate.EnvironmentTable,
+			Columns: []string{mergestate.EnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MergeState{config: msuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, msuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mergestate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	msuo.mutation.done = true
+	return _node, nil
+}
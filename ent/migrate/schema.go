@@ -8,25 +8,198 @@ import (
 )
 
 var (
+	// AllowedProjectsColumns holds the columns for the "allowed_projects" table.
+	AllowedProjectsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "path", Type: field.TypeString, Unique: true},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "allowed_at", Type: field.TypeTime},
+	}
+	// AllowedProjectsTable holds the schema information for the "allowed_projects" table.
+	AllowedProjectsTable = &schema.Table{
+		Name:       "allowed_projects",
+		Columns:    AllowedProjectsColumns,
+		PrimaryKey: []*schema.Column{AllowedProjectsColumns[0]},
+	}
 	// EnvironmentsColumns holds the columns for the "environments" table.
 	EnvironmentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "name", Type: field.TypeString, Unique: true},
 		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "schema_id", Type: field.TypeInt, Nullable: true},
 	}
 	// EnvironmentsTable holds the schema information for the "environments" table.
 	EnvironmentsTable = &schema.Table{
 		Name:       "environments",
 		Columns:    EnvironmentsColumns,
 		PrimaryKey: []*schema.Column{EnvironmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "environments_environment_schemas_environments",
+				Columns:    []*schema.Column{EnvironmentsColumns[5]},
+				RefColumns: []*schema.Column{EnvironmentSchemasColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// EnvironmentSchemasColumns holds the columns for the "environment_schemas" table.
+	EnvironmentSchemasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+	}
+	// EnvironmentSchemasTable holds the schema information for the "environment_schemas" table.
+	EnvironmentSchemasTable = &schema.Table{
+		Name:       "environment_schemas",
+		Columns:    EnvironmentSchemasColumns,
+		PrimaryKey: []*schema.Column{EnvironmentSchemasColumns[0]},
+	}
+	// MergeStatesColumns holds the columns for the "merge_states" table.
+	MergeStatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "path", Type: field.TypeString},
+		{Name: "local", Type: field.TypeJSON},
+		{Name: "remote", Type: field.TypeJSON},
+		{Name: "merged_at", Type: field.TypeTime},
+		{Name: "environment_id", Type: field.TypeInt},
+	}
+	// MergeStatesTable holds the schema information for the "merge_states" table.
+	MergeStatesTable = &schema.Table{
+		Name:       "merge_states",
+		Columns:    MergeStatesColumns,
+		PrimaryKey: []*schema.Column{MergeStatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "merge_states_environments_merge_states",
+				Columns:    []*schema.Column{MergeStatesColumns[5]},
+				RefColumns: []*schema.Column{EnvironmentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "mergestate_environment_id_path",
+				Unique:  true,
+				Columns: []*schema.Column{MergeStatesColumns[5], MergeStatesColumns[1]},
+			},
+		},
+	}
+	// RequiredVariablesColumns holds the columns for the "required_variables" table.
+	RequiredVariablesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "environment_id", Type: field.TypeInt},
+	}
+	// RequiredVariablesTable holds the schema information for the "required_variables" table.
+	RequiredVariablesTable = &schema.Table{
+		Name:       "required_variables",
+		Columns:    RequiredVariablesColumns,
+		PrimaryKey: []*schema.Column{RequiredVariablesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "required_variables_environments_required_variables",
+				Columns:    []*schema.Column{RequiredVariablesColumns[5]},
+				RefColumns: []*schema.Column{EnvironmentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "requiredvariable_environment_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{RequiredVariablesColumns[5], RequiredVariablesColumns[3]},
+			},
+		},
+	}
+	// SchemaKeysColumns holds the columns for the "schema_keys" table.
+	SchemaKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "default_value", Type: field.TypeString, Nullable: true},
+		{Name: "secret", Type: field.TypeBool, Nullable: true},
+		{Name: "optional", Type: field.TypeBool, Nullable: true},
+		{Name: "type", Type: field.TypeString, Default: "string"},
+		{Name: "pattern", Type: field.TypeString, Nullable: true},
+		{Name: "min", Type: field.TypeString, Nullable: true},
+		{Name: "max", Type: field.TypeString, Nullable: true},
+		{Name: "enum_values", Type: field.TypeJSON, Nullable: true},
+		{Name: "schema_id", Type: field.TypeInt},
+	}
+	// SchemaKeysTable holds the schema information for the "schema_keys" table.
+	SchemaKeysTable = &schema.Table{
+		Name:       "schema_keys",
+		Columns:    SchemaKeysColumns,
+		PrimaryKey: []*schema.Column{SchemaKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "schema_keys_environment_schemas_keys",
+				Columns:    []*schema.Column{SchemaKeysColumns[13]},
+				RefColumns: []*schema.Column{EnvironmentSchemasColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "schemakey_schema_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{SchemaKeysColumns[13], SchemaKeysColumns[3]},
+			},
+		},
+	}
+	// SyncStatesColumns holds the columns for the "sync_states" table.
+	SyncStatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "path", Type: field.TypeString},
+		{Name: "variables", Type: field.TypeJSON},
+		{Name: "synced_at", Type: field.TypeTime},
+		{Name: "environment_id", Type: field.TypeInt},
+	}
+	// SyncStatesTable holds the schema information for the "sync_states" table.
+	SyncStatesTable = &schema.Table{
+		Name:       "sync_states",
+		Columns:    SyncStatesColumns,
+		PrimaryKey: []*schema.Column{SyncStatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sync_states_environments_sync_states",
+				Columns:    []*schema.Column{SyncStatesColumns[4]},
+				RefColumns: []*schema.Column{EnvironmentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "syncstate_environment_id_path",
+				Unique:  true,
+				Columns: []*schema.Column{SyncStatesColumns[4], SyncStatesColumns[1]},
+			},
+		},
 	}
 	// VariablesColumns holds the columns for the "variables" table.
 	VariablesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "value", Type: field.TypeString},
 		{Name: "comment", Type: field.TypeString, Nullable: true},
 		{Name: "expand", Type: field.TypeBool, Nullable: true},
+		{Name: "secret", Type: field.TypeBool, Nullable: true},
+		{Name: "type", Type: field.TypeString, Default: "string"},
+		{Name: "pattern", Type: field.TypeString, Nullable: true},
+		{Name: "min", Type: field.TypeString, Nullable: true},
+		{Name: "max", Type: field.TypeString, Nullable: true},
+		{Name: "enum_values", Type: field.TypeJSON, Nullable: true},
 		{Name: "environment_id", Type: field.TypeInt},
 	}
 	// VariablesTable holds the schema information for the "variables" table.
@@ -37,7 +210,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "variables_environments_variables",
-				Columns:    []*schema.Column{VariablesColumns[5]},
+				Columns:    []*schema.Column{VariablesColumns[13]},
 				RefColumns: []*schema.Column{EnvironmentsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -46,17 +219,28 @@ var (
 			{
 				Name:    "variable_environment_id_name",
 				Unique:  true,
-				Columns: []*schema.Column{VariablesColumns[5], VariablesColumns[1]},
+				Columns: []*schema.Column{VariablesColumns[13], VariablesColumns[3]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AllowedProjectsTable,
 		EnvironmentsTable,
+		EnvironmentSchemasTable,
+		MergeStatesTable,
+		RequiredVariablesTable,
+		SchemaKeysTable,
+		SyncStatesTable,
 		VariablesTable,
 	}
 )
 
 func init() {
+	EnvironmentsTable.ForeignKeys[0].RefTable = EnvironmentSchemasTable
+	MergeStatesTable.ForeignKeys[0].RefTable = EnvironmentsTable
+	RequiredVariablesTable.ForeignKeys[0].RefTable = EnvironmentsTable
+	SchemaKeysTable.ForeignKeys[0].RefTable = EnvironmentSchemasTable
+	SyncStatesTable.ForeignKeys[0].RefTable = EnvironmentsTable
 	VariablesTable.ForeignKeys[0].RefTable = EnvironmentsTable
 }
@@ -7,11 +7,18 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/envoke/ent/allowedproject"
 	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/environmentschema"
+	"github.com/kechako/envoke/ent/mergestate"
 	"github.com/kechako/envoke/ent/predicate"
+	"github.com/kechako/envoke/ent/requiredvariable"
+	"github.com/kechako/envoke/ent/schemakey"
+	"github.com/kechako/envoke/ent/syncstate"
 	"github.com/kechako/envoke/ent/variable"
 )
 
@@ -24,38 +31,42 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeEnvironment = "Environment"
-	TypeVariable    = "Variable"
+	TypeAllowedProject    = "AllowedProject"
+	TypeEnvironment       = "Environment"
+	TypeEnvironmentSchema = "EnvironmentSchema"
+	TypeMergeState        = "MergeState"
+	TypeRequiredVariable  = "RequiredVariable"
+	TypeSchemaKey         = "SchemaKey"
+	TypeSyncState         = "SyncState"
+	TypeVariable          = "Variable"
 )
 
-// EnvironmentMutation represents an operation that mutates the Environment nodes in the graph.
-type EnvironmentMutation struct {
+// AllowedProjectMutation represents an operation that mutates the AllowedProject nodes in the graph.
+type AllowedProjectMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	name             *string
-	description      *string
-	clearedFields    map[string]struct{}
-	variables        map[int]struct{}
-	removedvariables map[int]struct{}
-	clearedvariables bool
-	done             bool
-	oldValue         func(context.Context) (*Environment, error)
-	predicates       []predicate.Environment
+	op            Op
+	typ           string
+	id            *int
+	_path         *string
+	hash          *string
+	allowed_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*AllowedProject, error)
+	predicates    []predicate.AllowedProject
 }
 
-var _ ent.Mutation = (*EnvironmentMutation)(nil)
+var _ ent.Mutation = (*AllowedProjectMutation)(nil)
 
-// environmentOption allows management of the mutation configuration using functional options.
-type environmentOption func(*EnvironmentMutation)
+// allowedprojectOption allows management of the mutation configuration using functional options.
+type allowedprojectOption func(*AllowedProjectMutation)
 
-// newEnvironmentMutation creates new mutation for the Environment entity.
-func newEnvironmentMutation(c config, op Op, opts ...environmentOption) *EnvironmentMutation {
-	m := &EnvironmentMutation{
+// newAllowedProjectMutation creates new mutation for the AllowedProject entity.
+func newAllowedProjectMutation(c config, op Op, opts ...allowedprojectOption) *AllowedProjectMutation {
+	m := &AllowedProjectMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEnvironment,
+		typ:           TypeAllowedProject,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -64,20 +75,20 @@ func newEnvironmentMutation(c config, op Op, opts ...environmentOption) *Environ
 	return m
 }
 
-// withEnvironmentID sets the ID field of the mutation.
-func withEnvironmentID(id int) environmentOption {
-	return func(m *EnvironmentMutation) {
+// withAllowedProjectID sets the ID field of the mutation.
+func withAllowedProjectID(id int) allowedprojectOption {
+	return func(m *AllowedProjectMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Environment
+			value *AllowedProject
 		)
-		m.oldValue = func(ctx context.Context) (*Environment, error) {
+		m.oldValue = func(ctx context.Context) (*AllowedProject, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Environment.Get(ctx, id)
+					value, err = m.Client().AllowedProject.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -86,10 +97,10 @@ func withEnvironmentID(id int) environmentOption {
 	}
 }
 
-// withEnvironment sets the old Environment of the mutation.
-func withEnvironment(node *Environment) environmentOption {
-	return func(m *EnvironmentMutation) {
-		m.oldValue = func(context.Context) (*Environment, error) {
+// withAllowedProject sets the old AllowedProject of the mutation.
+func withAllowedProject(node *AllowedProject) allowedprojectOption {
+	return func(m *AllowedProjectMutation) {
+		m.oldValue = func(context.Context) (*AllowedProject, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -98,7 +109,7 @@ func withEnvironment(node *Environment) environmentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EnvironmentMutation) Client() *Client {
+func (m AllowedProjectMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -106,7 +117,7 @@ func (m EnvironmentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EnvironmentMutation) Tx() (*Tx, error) {
+func (m AllowedProjectMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -117,7 +128,7 @@ func (m EnvironmentMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EnvironmentMutation) ID() (id int, exists bool) {
+func (m *AllowedProjectMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -128,7 +139,7 @@ func (m *EnvironmentMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EnvironmentMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AllowedProjectMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -137,160 +148,129 @@ func (m *EnvironmentMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Environment.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AllowedProject.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *EnvironmentMutation) SetName(s string) {
-	m.name = &s
+// SetPath sets the "path" field.
+func (m *AllowedProjectMutation) SetPath(s string) {
+	m._path = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *EnvironmentMutation) Name() (r string, exists bool) {
-	v := m.name
+// Path returns the value of the "path" field in the mutation.
+func (m *AllowedProjectMutation) Path() (r string, exists bool) {
+	v := m._path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Environment entity.
-// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// OldPath returns the old "path" field's value of the AllowedProject entity.
+// If the AllowedProject object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvironmentMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *AllowedProjectMutation) OldPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldPath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Path, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *EnvironmentMutation) ResetName() {
-	m.name = nil
+// ResetPath resets all changes to the "path" field.
+func (m *AllowedProjectMutation) ResetPath() {
+	m._path = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *EnvironmentMutation) SetDescription(s string) {
-	m.description = &s
+// SetHash sets the "hash" field.
+func (m *AllowedProjectMutation) SetHash(s string) {
+	m.hash = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *EnvironmentMutation) Description() (r string, exists bool) {
-	v := m.description
+// Hash returns the value of the "hash" field in the mutation.
+func (m *AllowedProjectMutation) Hash() (r string, exists bool) {
+	v := m.hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Environment entity.
-// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// OldHash returns the old "hash" field's value of the AllowedProject entity.
+// If the AllowedProject object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvironmentMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *AllowedProjectMutation) OldHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *EnvironmentMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[environment.FieldDescription] = struct{}{}
+	return oldValue.Hash, nil
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *EnvironmentMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[environment.FieldDescription]
-	return ok
+// ResetHash resets all changes to the "hash" field.
+func (m *AllowedProjectMutation) ResetHash() {
+	m.hash = nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *EnvironmentMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, environment.FieldDescription)
+// SetAllowedAt sets the "allowed_at" field.
+func (m *AllowedProjectMutation) SetAllowedAt(t time.Time) {
+	m.allowed_at = &t
 }
 
-// AddVariableIDs adds the "variables" edge to the Variable entity by ids.
-func (m *EnvironmentMutation) AddVariableIDs(ids ...int) {
-	if m.variables == nil {
-		m.variables = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.variables[ids[i]] = struct{}{}
+// AllowedAt returns the value of the "allowed_at" field in the mutation.
+func (m *AllowedProjectMutation) AllowedAt() (r time.Time, exists bool) {
+	v := m.allowed_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearVariables clears the "variables" edge to the Variable entity.
-func (m *EnvironmentMutation) ClearVariables() {
-	m.clearedvariables = true
-}
-
-// VariablesCleared reports if the "variables" edge to the Variable entity was cleared.
-func (m *EnvironmentMutation) VariablesCleared() bool {
-	return m.clearedvariables
-}
-
-// RemoveVariableIDs removes the "variables" edge to the Variable entity by IDs.
-func (m *EnvironmentMutation) RemoveVariableIDs(ids ...int) {
-	if m.removedvariables == nil {
-		m.removedvariables = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.variables, ids[i])
-		m.removedvariables[ids[i]] = struct{}{}
+// OldAllowedAt returns the old "allowed_at" field's value of the AllowedProject entity.
+// If the AllowedProject object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AllowedProjectMutation) OldAllowedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowedAt is only allowed on UpdateOne operations")
 	}
-}
-
-// RemovedVariables returns the removed IDs of the "variables" edge to the Variable entity.
-func (m *EnvironmentMutation) RemovedVariablesIDs() (ids []int) {
-	for id := range m.removedvariables {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowedAt requires an ID field in the mutation")
 	}
-	return
-}
-
-// VariablesIDs returns the "variables" edge IDs in the mutation.
-func (m *EnvironmentMutation) VariablesIDs() (ids []int) {
-	for id := range m.variables {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowedAt: %w", err)
 	}
-	return
+	return oldValue.AllowedAt, nil
 }
 
-// ResetVariables resets all changes to the "variables" edge.
-func (m *EnvironmentMutation) ResetVariables() {
-	m.variables = nil
-	m.clearedvariables = false
-	m.removedvariables = nil
+// ResetAllowedAt resets all changes to the "allowed_at" field.
+func (m *AllowedProjectMutation) ResetAllowedAt() {
+	m.allowed_at = nil
 }
 
-// Where appends a list predicates to the EnvironmentMutation builder.
-func (m *EnvironmentMutation) Where(ps ...predicate.Environment) {
+// Where appends a list predicates to the AllowedProjectMutation builder.
+func (m *AllowedProjectMutation) Where(ps ...predicate.AllowedProject) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EnvironmentMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AllowedProjectMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EnvironmentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Environment, len(ps))
+func (m *AllowedProjectMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AllowedProject, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -298,30 +278,33 @@ func (m *EnvironmentMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EnvironmentMutation) Op() Op {
+func (m *AllowedProjectMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EnvironmentMutation) SetOp(op Op) {
+func (m *AllowedProjectMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Environment).
-func (m *EnvironmentMutation) Type() string {
+// Type returns the node type of this mutation (AllowedProject).
+func (m *AllowedProjectMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EnvironmentMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.name != nil {
-		fields = append(fields, environment.FieldName)
+func (m *AllowedProjectMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m._path != nil {
+		fields = append(fields, allowedproject.FieldPath)
 	}
-	if m.description != nil {
-		fields = append(fields, environment.FieldDescription)
+	if m.hash != nil {
+		fields = append(fields, allowedproject.FieldHash)
+	}
+	if m.allowed_at != nil {
+		fields = append(fields, allowedproject.FieldAllowedAt)
 	}
 	return fields
 }
@@ -329,12 +312,14 @@ func (m *EnvironmentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EnvironmentMutation) Field(name string) (ent.Value, bool) {
+func (m *AllowedProjectMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case environment.FieldName:
-		return m.Name()
-	case environment.FieldDescription:
-		return m.Description()
+	case allowedproject.FieldPath:
+		return m.Path()
+	case allowedproject.FieldHash:
+		return m.Hash()
+	case allowedproject.FieldAllowedAt:
+		return m.AllowedAt()
 	}
 	return nil, false
 }
@@ -342,213 +327,4969 @@ func (m *EnvironmentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EnvironmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AllowedProjectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case environment.FieldName:
-		return m.OldName(ctx)
-	case environment.FieldDescription:
-		return m.OldDescription(ctx)
+	case allowedproject.FieldPath:
+		return m.OldPath(ctx)
+	case allowedproject.FieldHash:
+		return m.OldHash(ctx)
+	case allowedproject.FieldAllowedAt:
+		return m.OldAllowedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Environment field %s", name)
+	return nil, fmt.Errorf("unknown AllowedProject field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvironmentMutation) SetField(name string, value ent.Value) error {
+func (m *AllowedProjectMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case environment.FieldName:
+	case allowedproject.FieldPath:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetPath(v)
 		return nil
-	case environment.FieldDescription:
+	case allowedproject.FieldHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetHash(v)
+		return nil
+	case allowedproject.FieldAllowedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Environment field %s", name)
+	return fmt.Errorf("unknown AllowedProject field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EnvironmentMutation) AddedFields() []string {
+func (m *AllowedProjectMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EnvironmentMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AllowedProjectMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvironmentMutation) AddField(name string, value ent.Value) error {
+func (m *AllowedProjectMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Environment numeric field %s", name)
+	return fmt.Errorf("unknown AllowedProject numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EnvironmentMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(environment.FieldDescription) {
-		fields = append(fields, environment.FieldDescription)
-	}
-	return fields
+func (m *AllowedProjectMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EnvironmentMutation) FieldCleared(name string) bool {
+func (m *AllowedProjectMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EnvironmentMutation) ClearField(name string) error {
-	switch name {
-	case environment.FieldDescription:
-		m.ClearDescription()
-		return nil
-	}
-	return fmt.Errorf("unknown Environment nullable field %s", name)
+func (m *AllowedProjectMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AllowedProject nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EnvironmentMutation) ResetField(name string) error {
+func (m *AllowedProjectMutation) ResetField(name string) error {
 	switch name {
-	case environment.FieldName:
-		m.ResetName()
+	case allowedproject.FieldPath:
+		m.ResetPath()
 		return nil
-	case environment.FieldDescription:
-		m.ResetDescription()
+	case allowedproject.FieldHash:
+		m.ResetHash()
+		return nil
+	case allowedproject.FieldAllowedAt:
+		m.ResetAllowedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Environment field %s", name)
+	return fmt.Errorf("unknown AllowedProject field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EnvironmentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.variables != nil {
-		edges = append(edges, environment.EdgeVariables)
-	}
+func (m *AllowedProjectMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EnvironmentMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case environment.EdgeVariables:
-		ids := make([]ent.Value, 0, len(m.variables))
-		for id := range m.variables {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AllowedProjectMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EnvironmentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedvariables != nil {
-		edges = append(edges, environment.EdgeVariables)
-	}
+func (m *AllowedProjectMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EnvironmentMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case environment.EdgeVariables:
-		ids := make([]ent.Value, 0, len(m.removedvariables))
-		for id := range m.removedvariables {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AllowedProjectMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EnvironmentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedvariables {
-		edges = append(edges, environment.EdgeVariables)
-	}
+func (m *AllowedProjectMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EnvironmentMutation) EdgeCleared(name string) bool {
-	switch name {
-	case environment.EdgeVariables:
-		return m.clearedvariables
-	}
+func (m *AllowedProjectMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EnvironmentMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Environment unique edge %s", name)
+func (m *AllowedProjectMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AllowedProject unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EnvironmentMutation) ResetEdge(name string) error {
-	switch name {
-	case environment.EdgeVariables:
-		m.ResetVariables()
-		return nil
-	}
-	return fmt.Errorf("unknown Environment edge %s", name)
+func (m *AllowedProjectMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AllowedProject edge %s", name)
 }
 
-// VariableMutation represents an operation that mutates the Variable nodes in the graph.
-type VariableMutation struct {
+// EnvironmentMutation represents an operation that mutates the Environment nodes in the graph.
+type EnvironmentMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	name               *string
-	value              *string
-	comment            *string
-	expand             *bool
-	clearedFields      map[string]struct{}
-	environment        *int
-	clearedenvironment bool
-	done               bool
-	oldValue           func(context.Context) (*Variable, error)
-	predicates         []predicate.Variable
+	op                        Op
+	typ                       string
+	id                        *int
+	created_at                *time.Time
+	updated_at                *time.Time
+	name                      *string
+	description               *string
+	clearedFields             map[string]struct{}
+	variables                 map[int]struct{}
+	removedvariables          map[int]struct{}
+	clearedvariables          bool
+	sync_states               map[int]struct{}
+	removedsync_states        map[int]struct{}
+	clearedsync_states        bool
+	required_variables        map[int]struct{}
+	removedrequired_variables map[int]struct{}
+	clearedrequired_variables bool
+	merge_states              map[int]struct{}
+	removedmerge_states       map[int]struct{}
+	clearedmerge_states       bool
+	schema                    *int
+	clearedschema             bool
+	done                      bool
+	oldValue                  func(context.Context) (*Environment, error)
+	predicates                []predicate.Environment
 }
 
-var _ ent.Mutation = (*VariableMutation)(nil)
+var _ ent.Mutation = (*EnvironmentMutation)(nil)
 
-// variableOption allows management of the mutation configuration using functional options.
-type variableOption func(*VariableMutation)
+// environmentOption allows management of the mutation configuration using functional options.
+type environmentOption func(*EnvironmentMutation)
 
-// newVariableMutation creates new mutation for the Variable entity.
-func newVariableMutation(c config, op Op, opts ...variableOption) *VariableMutation {
-	m := &VariableMutation{
+// newEnvironmentMutation creates new mutation for the Environment entity.
+func newEnvironmentMutation(c config, op Op, opts ...environmentOption) *EnvironmentMutation {
+	m := &EnvironmentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEnvironment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEnvironmentID sets the ID field of the mutation.
+func withEnvironmentID(id int) environmentOption {
+	return func(m *EnvironmentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Environment
+		)
+		m.oldValue = func(ctx context.Context) (*Environment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Environment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEnvironment sets the old Environment of the mutation.
+func withEnvironment(node *Environment) environmentOption {
+	return func(m *EnvironmentMutation) {
+		m.oldValue = func(context.Context) (*Environment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EnvironmentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EnvironmentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EnvironmentMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EnvironmentMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Environment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EnvironmentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EnvironmentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *EnvironmentMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[environment.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *EnvironmentMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[environment.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EnvironmentMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, environment.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EnvironmentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EnvironmentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *EnvironmentMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[environment.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *EnvironmentMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[environment.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EnvironmentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, environment.FieldUpdatedAt)
+}
+
+// SetName sets the "name" field.
+func (m *EnvironmentMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *EnvironmentMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *EnvironmentMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *EnvironmentMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *EnvironmentMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *EnvironmentMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[environment.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *EnvironmentMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[environment.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *EnvironmentMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, environment.FieldDescription)
+}
+
+// SetSchemaID sets the "schema_id" field.
+func (m *EnvironmentMutation) SetSchemaID(i int) {
+	m.schema = &i
+}
+
+// SchemaID returns the value of the "schema_id" field in the mutation.
+func (m *EnvironmentMutation) SchemaID() (r int, exists bool) {
+	v := m.schema
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSchemaID returns the old "schema_id" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldSchemaID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchemaID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchemaID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchemaID: %w", err)
+	}
+	return oldValue.SchemaID, nil
+}
+
+// ClearSchemaID clears the value of the "schema_id" field.
+func (m *EnvironmentMutation) ClearSchemaID() {
+	m.schema = nil
+	m.clearedFields[environment.FieldSchemaID] = struct{}{}
+}
+
+// SchemaIDCleared returns if the "schema_id" field was cleared in this mutation.
+func (m *EnvironmentMutation) SchemaIDCleared() bool {
+	_, ok := m.clearedFields[environment.FieldSchemaID]
+	return ok
+}
+
+// ResetSchemaID resets all changes to the "schema_id" field.
+func (m *EnvironmentMutation) ResetSchemaID() {
+	m.schema = nil
+	delete(m.clearedFields, environment.FieldSchemaID)
+}
+
+// AddVariableIDs adds the "variables" edge to the Variable entity by ids.
+func (m *EnvironmentMutation) AddVariableIDs(ids ...int) {
+	if m.variables == nil {
+		m.variables = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.variables[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVariables clears the "variables" edge to the Variable entity.
+func (m *EnvironmentMutation) ClearVariables() {
+	m.clearedvariables = true
+}
+
+// VariablesCleared reports if the "variables" edge to the Variable entity was cleared.
+func (m *EnvironmentMutation) VariablesCleared() bool {
+	return m.clearedvariables
+}
+
+// RemoveVariableIDs removes the "variables" edge to the Variable entity by IDs.
+func (m *EnvironmentMutation) RemoveVariableIDs(ids ...int) {
+	if m.removedvariables == nil {
+		m.removedvariables = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.variables, ids[i])
+		m.removedvariables[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVariables returns the removed IDs of the "variables" edge to the Variable entity.
+func (m *EnvironmentMutation) RemovedVariablesIDs() (ids []int) {
+	for id := range m.removedvariables {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VariablesIDs returns the "variables" edge IDs in the mutation.
+func (m *EnvironmentMutation) VariablesIDs() (ids []int) {
+	for id := range m.variables {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVariables resets all changes to the "variables" edge.
+func (m *EnvironmentMutation) ResetVariables() {
+	m.variables = nil
+	m.clearedvariables = false
+	m.removedvariables = nil
+}
+
+// AddSyncStateIDs adds the "sync_states" edge to the SyncState entity by ids.
+func (m *EnvironmentMutation) AddSyncStateIDs(ids ...int) {
+	if m.sync_states == nil {
+		m.sync_states = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sync_states[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSyncStates clears the "sync_states" edge to the SyncState entity.
+func (m *EnvironmentMutation) ClearSyncStates() {
+	m.clearedsync_states = true
+}
+
+// SyncStatesCleared reports if the "sync_states" edge to the SyncState entity was cleared.
+func (m *EnvironmentMutation) SyncStatesCleared() bool {
+	return m.clearedsync_states
+}
+
+// RemoveSyncStateIDs removes the "sync_states" edge to the SyncState entity by IDs.
+func (m *EnvironmentMutation) RemoveSyncStateIDs(ids ...int) {
+	if m.removedsync_states == nil {
+		m.removedsync_states = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sync_states, ids[i])
+		m.removedsync_states[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSyncStates returns the removed IDs of the "sync_states" edge to the SyncState entity.
+func (m *EnvironmentMutation) RemovedSyncStatesIDs() (ids []int) {
+	for id := range m.removedsync_states {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SyncStatesIDs returns the "sync_states" edge IDs in the mutation.
+func (m *EnvironmentMutation) SyncStatesIDs() (ids []int) {
+	for id := range m.sync_states {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSyncStates resets all changes to the "sync_states" edge.
+func (m *EnvironmentMutation) ResetSyncStates() {
+	m.sync_states = nil
+	m.clearedsync_states = false
+	m.removedsync_states = nil
+}
+
+// AddRequiredVariableIDs adds the "required_variables" edge to the RequiredVariable entity by ids.
+func (m *EnvironmentMutation) AddRequiredVariableIDs(ids ...int) {
+	if m.required_variables == nil {
+		m.required_variables = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.required_variables[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRequiredVariables clears the "required_variables" edge to the RequiredVariable entity.
+func (m *EnvironmentMutation) ClearRequiredVariables() {
+	m.clearedrequired_variables = true
+}
+
+// RequiredVariablesCleared reports if the "required_variables" edge to the RequiredVariable entity was cleared.
+func (m *EnvironmentMutation) RequiredVariablesCleared() bool {
+	return m.clearedrequired_variables
+}
+
+// RemoveRequiredVariableIDs removes the "required_variables" edge to the RequiredVariable entity by IDs.
+func (m *EnvironmentMutation) RemoveRequiredVariableIDs(ids ...int) {
+	if m.removedrequired_variables == nil {
+		m.removedrequired_variables = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.required_variables, ids[i])
+		m.removedrequired_variables[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRequiredVariables returns the removed IDs of the "required_variables" edge to the RequiredVariable entity.
+func (m *EnvironmentMutation) RemovedRequiredVariablesIDs() (ids []int) {
+	for id := range m.removedrequired_variables {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RequiredVariablesIDs returns the "required_variables" edge IDs in the mutation.
+func (m *EnvironmentMutation) RequiredVariablesIDs() (ids []int) {
+	for id := range m.required_variables {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRequiredVariables resets all changes to the "required_variables" edge.
+func (m *EnvironmentMutation) ResetRequiredVariables() {
+	m.required_variables = nil
+	m.clearedrequired_variables = false
+	m.removedrequired_variables = nil
+}
+
+// AddMergeStateIDs adds the "merge_states" edge to the MergeState entity by ids.
+func (m *EnvironmentMutation) AddMergeStateIDs(ids ...int) {
+	if m.merge_states == nil {
+		m.merge_states = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.merge_states[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMergeStates clears the "merge_states" edge to the MergeState entity.
+func (m *EnvironmentMutation) ClearMergeStates() {
+	m.clearedmerge_states = true
+}
+
+// MergeStatesCleared reports if the "merge_states" edge to the MergeState entity was cleared.
+func (m *EnvironmentMutation) MergeStatesCleared() bool {
+	return m.clearedmerge_states
+}
+
+// RemoveMergeStateIDs removes the "merge_states" edge to the MergeState entity by IDs.
+func (m *EnvironmentMutation) RemoveMergeStateIDs(ids ...int) {
+	if m.removedmerge_states == nil {
+		m.removedmerge_states = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.merge_states, ids[i])
+		m.removedmerge_states[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMergeStates returns the removed IDs of the "merge_states" edge to the MergeState entity.
+func (m *EnvironmentMutation) RemovedMergeStatesIDs() (ids []int) {
+	for id := range m.removedmerge_states {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MergeStatesIDs returns the "merge_states" edge IDs in the mutation.
+func (m *EnvironmentMutation) MergeStatesIDs() (ids []int) {
+	for id := range m.merge_states {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMergeStates resets all changes to the "merge_states" edge.
+func (m *EnvironmentMutation) ResetMergeStates() {
+	m.merge_states = nil
+	m.clearedmerge_states = false
+	m.removedmerge_states = nil
+}
+
+// ClearSchema clears the "schema" edge to the EnvironmentSchema entity.
+func (m *EnvironmentMutation) ClearSchema() {
+	m.clearedschema = true
+	m.clearedFields[environment.FieldSchemaID] = struct{}{}
+}
+
+// SchemaCleared reports if the "schema" edge to the EnvironmentSchema entity was cleared.
+func (m *EnvironmentMutation) SchemaCleared() bool {
+	return m.SchemaIDCleared() || m.clearedschema
+}
+
+// SchemaIDs returns the "schema" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SchemaID instead. It exists only for internal usage by the builders.
+func (m *EnvironmentMutation) SchemaIDs() (ids []int) {
+	if id := m.schema; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSchema resets all changes to the "schema" edge.
+func (m *EnvironmentMutation) ResetSchema() {
+	m.schema = nil
+	m.clearedschema = false
+}
+
+// Where appends a list predicates to the EnvironmentMutation builder.
+func (m *EnvironmentMutation) Where(ps ...predicate.Environment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EnvironmentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnvironmentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Environment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EnvironmentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EnvironmentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Environment).
+func (m *EnvironmentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnvironmentMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, environment.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, environment.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, environment.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, environment.FieldDescription)
+	}
+	if m.schema != nil {
+		fields = append(fields, environment.FieldSchemaID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnvironmentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case environment.FieldCreatedAt:
+		return m.CreatedAt()
+	case environment.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case environment.FieldName:
+		return m.Name()
+	case environment.FieldDescription:
+		return m.Description()
+	case environment.FieldSchemaID:
+		return m.SchemaID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnvironmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case environment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case environment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case environment.FieldName:
+		return m.OldName(ctx)
+	case environment.FieldDescription:
+		return m.OldDescription(ctx)
+	case environment.FieldSchemaID:
+		return m.OldSchemaID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Environment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvironmentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case environment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case environment.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case environment.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case environment.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case environment.FieldSchemaID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchemaID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Environment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnvironmentMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnvironmentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvironmentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Environment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnvironmentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(environment.FieldCreatedAt) {
+		fields = append(fields, environment.FieldCreatedAt)
+	}
+	if m.FieldCleared(environment.FieldUpdatedAt) {
+		fields = append(fields, environment.FieldUpdatedAt)
+	}
+	if m.FieldCleared(environment.FieldDescription) {
+		fields = append(fields, environment.FieldDescription)
+	}
+	if m.FieldCleared(environment.FieldSchemaID) {
+		fields = append(fields, environment.FieldSchemaID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnvironmentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnvironmentMutation) ClearField(name string) error {
+	switch name {
+	case environment.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case environment.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case environment.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case environment.FieldSchemaID:
+		m.ClearSchemaID()
+		return nil
+	}
+	return fmt.Errorf("unknown Environment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnvironmentMutation) ResetField(name string) error {
+	switch name {
+	case environment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case environment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case environment.FieldName:
+		m.ResetName()
+		return nil
+	case environment.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case environment.FieldSchemaID:
+		m.ResetSchemaID()
+		return nil
+	}
+	return fmt.Errorf("unknown Environment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnvironmentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.variables != nil {
+		edges = append(edges, environment.EdgeVariables)
+	}
+	if m.sync_states != nil {
+		edges = append(edges, environment.EdgeSyncStates)
+	}
+	if m.required_variables != nil {
+		edges = append(edges, environment.EdgeRequiredVariables)
+	}
+	if m.merge_states != nil {
+		edges = append(edges, environment.EdgeMergeStates)
+	}
+	if m.schema != nil {
+		edges = append(edges, environment.EdgeSchema)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnvironmentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case environment.EdgeVariables:
+		ids := make([]ent.Value, 0, len(m.variables))
+		for id := range m.variables {
+			ids = append(ids, id)
+		}
+		return ids
+	case environment.EdgeSyncStates:
+		ids := make([]ent.Value, 0, len(m.sync_states))
+		for id := range m.sync_states {
+			ids = append(ids, id)
+		}
+		return ids
+	case environment.EdgeRequiredVariables:
+		ids := make([]ent.Value, 0, len(m.required_variables))
+		for id := range m.required_variables {
+			ids = append(ids, id)
+		}
+		return ids
+	case environment.EdgeMergeStates:
+		ids := make([]ent.Value, 0, len(m.merge_states))
+		for id := range m.merge_states {
+			ids = append(ids, id)
+		}
+		return ids
+	case environment.EdgeSchema:
+		if id := m.schema; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnvironmentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedvariables != nil {
+		edges = append(edges, environment.EdgeVariables)
+	}
+	if m.removedsync_states != nil {
+		edges = append(edges, environment.EdgeSyncStates)
+	}
+	if m.removedrequired_variables != nil {
+		edges = append(edges, environment.EdgeRequiredVariables)
+	}
+	if m.removedmerge_states != nil {
+		edges = append(edges, environment.EdgeMergeStates)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnvironmentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case environment.EdgeVariables:
+		ids := make([]ent.Value, 0, len(m.removedvariables))
+		for id := range m.removedvariables {
+			ids = append(ids, id)
+		}
+		return ids
+	case environment.EdgeSyncStates:
+		ids := make([]ent.Value, 0, len(m.removedsync_states))
+		for id := range m.removedsync_states {
+			ids = append(ids, id)
+		}
+		return ids
+	case environment.EdgeRequiredVariables:
+		ids := make([]ent.Value, 0, len(m.removedrequired_variables))
+		for id := range m.removedrequired_variables {
+			ids = append(ids, id)
+		}
+		return ids
+	case environment.EdgeMergeStates:
+		ids := make([]ent.Value, 0, len(m.removedmerge_states))
+		for id := range m.removedmerge_states {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnvironmentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedvariables {
+		edges = append(edges, environment.EdgeVariables)
+	}
+	if m.clearedsync_states {
+		edges = append(edges, environment.EdgeSyncStates)
+	}
+	if m.clearedrequired_variables {
+		edges = append(edges, environment.EdgeRequiredVariables)
+	}
+	if m.clearedmerge_states {
+		edges = append(edges, environment.EdgeMergeStates)
+	}
+	if m.clearedschema {
+		edges = append(edges, environment.EdgeSchema)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnvironmentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case environment.EdgeVariables:
+		return m.clearedvariables
+	case environment.EdgeSyncStates:
+		return m.clearedsync_states
+	case environment.EdgeRequiredVariables:
+		return m.clearedrequired_variables
+	case environment.EdgeMergeStates:
+		return m.clearedmerge_states
+	case environment.EdgeSchema:
+		return m.clearedschema
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnvironmentMutation) ClearEdge(name string) error {
+	switch name {
+	case environment.EdgeSchema:
+		m.ClearSchema()
+		return nil
+	}
+	return fmt.Errorf("unknown Environment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnvironmentMutation) ResetEdge(name string) error {
+	switch name {
+	case environment.EdgeVariables:
+		m.ResetVariables()
+		return nil
+	case environment.EdgeSyncStates:
+		m.ResetSyncStates()
+		return nil
+	case environment.EdgeRequiredVariables:
+		m.ResetRequiredVariables()
+		return nil
+	case environment.EdgeMergeStates:
+		m.ResetMergeStates()
+		return nil
+	case environment.EdgeSchema:
+		m.ResetSchema()
+		return nil
+	}
+	return fmt.Errorf("unknown Environment edge %s", name)
+}
+
+// EnvironmentSchemaMutation represents an operation that mutates the EnvironmentSchema nodes in the graph.
+type EnvironmentSchemaMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	created_at          *time.Time
+	updated_at          *time.Time
+	name                *string
+	description         *string
+	clearedFields       map[string]struct{}
+	keys                map[int]struct{}
+	removedkeys         map[int]struct{}
+	clearedkeys         bool
+	environments        map[int]struct{}
+	removedenvironments map[int]struct{}
+	clearedenvironments bool
+	done                bool
+	oldValue            func(context.Context) (*EnvironmentSchema, error)
+	predicates          []predicate.EnvironmentSchema
+}
+
+var _ ent.Mutation = (*EnvironmentSchemaMutation)(nil)
+
+// environmentschemaOption allows management of the mutation configuration using functional options.
+type environmentschemaOption func(*EnvironmentSchemaMutation)
+
+// newEnvironmentSchemaMutation creates new mutation for the EnvironmentSchema entity.
+func newEnvironmentSchemaMutation(c config, op Op, opts ...environmentschemaOption) *EnvironmentSchemaMutation {
+	m := &EnvironmentSchemaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEnvironmentSchema,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEnvironmentSchemaID sets the ID field of the mutation.
+func withEnvironmentSchemaID(id int) environmentschemaOption {
+	return func(m *EnvironmentSchemaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EnvironmentSchema
+		)
+		m.oldValue = func(ctx context.Context) (*EnvironmentSchema, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EnvironmentSchema.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEnvironmentSchema sets the old EnvironmentSchema of the mutation.
+func withEnvironmentSchema(node *EnvironmentSchema) environmentschemaOption {
+	return func(m *EnvironmentSchemaMutation) {
+		m.oldValue = func(context.Context) (*EnvironmentSchema, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EnvironmentSchemaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EnvironmentSchemaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EnvironmentSchemaMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EnvironmentSchemaMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EnvironmentSchema.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EnvironmentSchemaMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EnvironmentSchemaMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EnvironmentSchema entity.
+// If the EnvironmentSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentSchemaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *EnvironmentSchemaMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[environmentschema.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *EnvironmentSchemaMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[environmentschema.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EnvironmentSchemaMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, environmentschema.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EnvironmentSchemaMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EnvironmentSchemaMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the EnvironmentSchema entity.
+// If the EnvironmentSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentSchemaMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *EnvironmentSchemaMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[environmentschema.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *EnvironmentSchemaMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[environmentschema.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EnvironmentSchemaMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, environmentschema.FieldUpdatedAt)
+}
+
+// SetName sets the "name" field.
+func (m *EnvironmentSchemaMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *EnvironmentSchemaMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the EnvironmentSchema entity.
+// If the EnvironmentSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentSchemaMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *EnvironmentSchemaMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *EnvironmentSchemaMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *EnvironmentSchemaMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the EnvironmentSchema entity.
+// If the EnvironmentSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentSchemaMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *EnvironmentSchemaMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[environmentschema.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *EnvironmentSchemaMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[environmentschema.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *EnvironmentSchemaMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, environmentschema.FieldDescription)
+}
+
+// AddKeyIDs adds the "keys" edge to the SchemaKey entity by ids.
+func (m *EnvironmentSchemaMutation) AddKeyIDs(ids ...int) {
+	if m.keys == nil {
+		m.keys = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.keys[ids[i]] = struct{}{}
+	}
+}
+
+// ClearKeys clears the "keys" edge to the SchemaKey entity.
+func (m *EnvironmentSchemaMutation) ClearKeys() {
+	m.clearedkeys = true
+}
+
+// KeysCleared reports if the "keys" edge to the SchemaKey entity was cleared.
+func (m *EnvironmentSchemaMutation) KeysCleared() bool {
+	return m.clearedkeys
+}
+
+// RemoveKeyIDs removes the "keys" edge to the SchemaKey entity by IDs.
+func (m *EnvironmentSchemaMutation) RemoveKeyIDs(ids ...int) {
+	if m.removedkeys == nil {
+		m.removedkeys = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.keys, ids[i])
+		m.removedkeys[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedKeys returns the removed IDs of the "keys" edge to the SchemaKey entity.
+func (m *EnvironmentSchemaMutation) RemovedKeysIDs() (ids []int) {
+	for id := range m.removedkeys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// KeysIDs returns the "keys" edge IDs in the mutation.
+func (m *EnvironmentSchemaMutation) KeysIDs() (ids []int) {
+	for id := range m.keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetKeys resets all changes to the "keys" edge.
+func (m *EnvironmentSchemaMutation) ResetKeys() {
+	m.keys = nil
+	m.clearedkeys = false
+	m.removedkeys = nil
+}
+
+// AddEnvironmentIDs adds the "environments" edge to the Environment entity by ids.
+func (m *EnvironmentSchemaMutation) AddEnvironmentIDs(ids ...int) {
+	if m.environments == nil {
+		m.environments = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.environments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEnvironments clears the "environments" edge to the Environment entity.
+func (m *EnvironmentSchemaMutation) ClearEnvironments() {
+	m.clearedenvironments = true
+}
+
+// EnvironmentsCleared reports if the "environments" edge to the Environment entity was cleared.
+func (m *EnvironmentSchemaMutation) EnvironmentsCleared() bool {
+	return m.clearedenvironments
+}
+
+// RemoveEnvironmentIDs removes the "environments" edge to the Environment entity by IDs.
+func (m *EnvironmentSchemaMutation) RemoveEnvironmentIDs(ids ...int) {
+	if m.removedenvironments == nil {
+		m.removedenvironments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.environments, ids[i])
+		m.removedenvironments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEnvironments returns the removed IDs of the "environments" edge to the Environment entity.
+func (m *EnvironmentSchemaMutation) RemovedEnvironmentsIDs() (ids []int) {
+	for id := range m.removedenvironments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EnvironmentsIDs returns the "environments" edge IDs in the mutation.
+func (m *EnvironmentSchemaMutation) EnvironmentsIDs() (ids []int) {
+	for id := range m.environments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEnvironments resets all changes to the "environments" edge.
+func (m *EnvironmentSchemaMutation) ResetEnvironments() {
+	m.environments = nil
+	m.clearedenvironments = false
+	m.removedenvironments = nil
+}
+
+// Where appends a list predicates to the EnvironmentSchemaMutation builder.
+func (m *EnvironmentSchemaMutation) Where(ps ...predicate.EnvironmentSchema) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EnvironmentSchemaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnvironmentSchemaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EnvironmentSchema, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EnvironmentSchemaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EnvironmentSchemaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EnvironmentSchema).
+func (m *EnvironmentSchemaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnvironmentSchemaMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.created_at != nil {
+		fields = append(fields, environmentschema.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, environmentschema.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, environmentschema.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, environmentschema.FieldDescription)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnvironmentSchemaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case environmentschema.FieldCreatedAt:
+		return m.CreatedAt()
+	case environmentschema.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case environmentschema.FieldName:
+		return m.Name()
+	case environmentschema.FieldDescription:
+		return m.Description()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnvironmentSchemaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case environmentschema.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case environmentschema.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case environmentschema.FieldName:
+		return m.OldName(ctx)
+	case environmentschema.FieldDescription:
+		return m.OldDescription(ctx)
+	}
+	return nil, fmt.Errorf("unknown EnvironmentSchema field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvironmentSchemaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case environmentschema.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case environmentschema.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case environmentschema.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case environmentschema.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvironmentSchema field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnvironmentSchemaMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnvironmentSchemaMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvironmentSchemaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown EnvironmentSchema numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnvironmentSchemaMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(environmentschema.FieldCreatedAt) {
+		fields = append(fields, environmentschema.FieldCreatedAt)
+	}
+	if m.FieldCleared(environmentschema.FieldUpdatedAt) {
+		fields = append(fields, environmentschema.FieldUpdatedAt)
+	}
+	if m.FieldCleared(environmentschema.FieldDescription) {
+		fields = append(fields, environmentschema.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnvironmentSchemaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnvironmentSchemaMutation) ClearField(name string) error {
+	switch name {
+	case environmentschema.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case environmentschema.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case environmentschema.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvironmentSchema nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnvironmentSchemaMutation) ResetField(name string) error {
+	switch name {
+	case environmentschema.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case environmentschema.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case environmentschema.FieldName:
+		m.ResetName()
+		return nil
+	case environmentschema.FieldDescription:
+		m.ResetDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvironmentSchema field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnvironmentSchemaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.keys != nil {
+		edges = append(edges, environmentschema.EdgeKeys)
+	}
+	if m.environments != nil {
+		edges = append(edges, environmentschema.EdgeEnvironments)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnvironmentSchemaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case environmentschema.EdgeKeys:
+		ids := make([]ent.Value, 0, len(m.keys))
+		for id := range m.keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case environmentschema.EdgeEnvironments:
+		ids := make([]ent.Value, 0, len(m.environments))
+		for id := range m.environments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnvironmentSchemaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedkeys != nil {
+		edges = append(edges, environmentschema.EdgeKeys)
+	}
+	if m.removedenvironments != nil {
+		edges = append(edges, environmentschema.EdgeEnvironments)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnvironmentSchemaMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case environmentschema.EdgeKeys:
+		ids := make([]ent.Value, 0, len(m.removedkeys))
+		for id := range m.removedkeys {
+			ids = append(ids, id)
+		}
+		return ids
+	case environmentschema.EdgeEnvironments:
+		ids := make([]ent.Value, 0, len(m.removedenvironments))
+		for id := range m.removedenvironments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnvironmentSchemaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedkeys {
+		edges = append(edges, environmentschema.EdgeKeys)
+	}
+	if m.clearedenvironments {
+		edges = append(edges, environmentschema.EdgeEnvironments)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnvironmentSchemaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case environmentschema.EdgeKeys:
+		return m.clearedkeys
+	case environmentschema.EdgeEnvironments:
+		return m.clearedenvironments
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnvironmentSchemaMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown EnvironmentSchema unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnvironmentSchemaMutation) ResetEdge(name string) error {
+	switch name {
+	case environmentschema.EdgeKeys:
+		m.ResetKeys()
+		return nil
+	case environmentschema.EdgeEnvironments:
+		m.ResetEnvironments()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvironmentSchema edge %s", name)
+}
+
+// MergeStateMutation represents an operation that mutates the MergeState nodes in the graph.
+type MergeStateMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	_path              *string
+	local              *map[string]time.Time
+	remote             *map[string]time.Time
+	merged_at          *time.Time
+	clearedFields      map[string]struct{}
+	environment        *int
+	clearedenvironment bool
+	done               bool
+	oldValue           func(context.Context) (*MergeState, error)
+	predicates         []predicate.MergeState
+}
+
+var _ ent.Mutation = (*MergeStateMutation)(nil)
+
+// mergestateOption allows management of the mutation configuration using functional options.
+type mergestateOption func(*MergeStateMutation)
+
+// newMergeStateMutation creates new mutation for the MergeState entity.
+func newMergeStateMutation(c config, op Op, opts ...mergestateOption) *MergeStateMutation {
+	m := &MergeStateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMergeState,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMergeStateID sets the ID field of the mutation.
+func withMergeStateID(id int) mergestateOption {
+	return func(m *MergeStateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MergeState
+		)
+		m.oldValue = func(ctx context.Context) (*MergeState, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MergeState.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMergeState sets the old MergeState of the mutation.
+func withMergeState(node *MergeState) mergestateOption {
+	return func(m *MergeStateMutation) {
+		m.oldValue = func(context.Context) (*MergeState, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MergeStateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MergeStateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MergeStateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MergeStateMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MergeState.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (m *MergeStateMutation) SetEnvironmentID(i int) {
+	m.environment = &i
+}
+
+// EnvironmentID returns the value of the "environment_id" field in the mutation.
+func (m *MergeStateMutation) EnvironmentID() (r int, exists bool) {
+	v := m.environment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvironmentID returns the old "environment_id" field's value of the MergeState entity.
+// If the MergeState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MergeStateMutation) OldEnvironmentID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvironmentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvironmentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvironmentID: %w", err)
+	}
+	return oldValue.EnvironmentID, nil
+}
+
+// ResetEnvironmentID resets all changes to the "environment_id" field.
+func (m *MergeStateMutation) ResetEnvironmentID() {
+	m.environment = nil
+}
+
+// SetPath sets the "path" field.
+func (m *MergeStateMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *MergeStateMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the MergeState entity.
+// If the MergeState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MergeStateMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *MergeStateMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetLocal sets the "local" field.
+func (m *MergeStateMutation) SetLocal(value map[string]time.Time) {
+	m.local = &value
+}
+
+// Local returns the value of the "local" field in the mutation.
+func (m *MergeStateMutation) Local() (r map[string]time.Time, exists bool) {
+	v := m.local
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocal returns the old "local" field's value of the MergeState entity.
+// If the MergeState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MergeStateMutation) OldLocal(ctx context.Context) (v map[string]time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocal: %w", err)
+	}
+	return oldValue.Local, nil
+}
+
+// ResetLocal resets all changes to the "local" field.
+func (m *MergeStateMutation) ResetLocal() {
+	m.local = nil
+}
+
+// SetRemote sets the "remote" field.
+func (m *MergeStateMutation) SetRemote(value map[string]time.Time) {
+	m.remote = &value
+}
+
+// Remote returns the value of the "remote" field in the mutation.
+func (m *MergeStateMutation) Remote() (r map[string]time.Time, exists bool) {
+	v := m.remote
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemote returns the old "remote" field's value of the MergeState entity.
+// If the MergeState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MergeStateMutation) OldRemote(ctx context.Context) (v map[string]time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemote: %w", err)
+	}
+	return oldValue.Remote, nil
+}
+
+// ResetRemote resets all changes to the "remote" field.
+func (m *MergeStateMutation) ResetRemote() {
+	m.remote = nil
+}
+
+// SetMergedAt sets the "merged_at" field.
+func (m *MergeStateMutation) SetMergedAt(t time.Time) {
+	m.merged_at = &t
+}
+
+// MergedAt returns the value of the "merged_at" field in the mutation.
+func (m *MergeStateMutation) MergedAt() (r time.Time, exists bool) {
+	v := m.merged_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMergedAt returns the old "merged_at" field's value of the MergeState entity.
+// If the MergeState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MergeStateMutation) OldMergedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMergedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMergedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMergedAt: %w", err)
+	}
+	return oldValue.MergedAt, nil
+}
+
+// ResetMergedAt resets all changes to the "merged_at" field.
+func (m *MergeStateMutation) ResetMergedAt() {
+	m.merged_at = nil
+}
+
+// ClearEnvironment clears the "environment" edge to the Environment entity.
+func (m *MergeStateMutation) ClearEnvironment() {
+	m.clearedenvironment = true
+	m.clearedFields[mergestate.FieldEnvironmentID] = struct{}{}
+}
+
+// EnvironmentCleared reports if the "environment" edge to the Environment entity was cleared.
+func (m *MergeStateMutation) EnvironmentCleared() bool {
+	return m.clearedenvironment
+}
+
+// EnvironmentIDs returns the "environment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvironmentID instead. It exists only for internal usage by the builders.
+func (m *MergeStateMutation) EnvironmentIDs() (ids []int) {
+	if id := m.environment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEnvironment resets all changes to the "environment" edge.
+func (m *MergeStateMutation) ResetEnvironment() {
+	m.environment = nil
+	m.clearedenvironment = false
+}
+
+// Where appends a list predicates to the MergeStateMutation builder.
+func (m *MergeStateMutation) Where(ps ...predicate.MergeState) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MergeStateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MergeStateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MergeState, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MergeStateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MergeStateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MergeState).
+func (m *MergeStateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MergeStateMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.environment != nil {
+		fields = append(fields, mergestate.FieldEnvironmentID)
+	}
+	if m._path != nil {
+		fields = append(fields, mergestate.FieldPath)
+	}
+	if m.local != nil {
+		fields = append(fields, mergestate.FieldLocal)
+	}
+	if m.remote != nil {
+		fields = append(fields, mergestate.FieldRemote)
+	}
+	if m.merged_at != nil {
+		fields = append(fields, mergestate.FieldMergedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MergeStateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mergestate.FieldEnvironmentID:
+		return m.EnvironmentID()
+	case mergestate.FieldPath:
+		return m.Path()
+	case mergestate.FieldLocal:
+		return m.Local()
+	case mergestate.FieldRemote:
+		return m.Remote()
+	case mergestate.FieldMergedAt:
+		return m.MergedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MergeStateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mergestate.FieldEnvironmentID:
+		return m.OldEnvironmentID(ctx)
+	case mergestate.FieldPath:
+		return m.OldPath(ctx)
+	case mergestate.FieldLocal:
+		return m.OldLocal(ctx)
+	case mergestate.FieldRemote:
+		return m.OldRemote(ctx)
+	case mergestate.FieldMergedAt:
+		return m.OldMergedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MergeState field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MergeStateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mergestate.FieldEnvironmentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvironmentID(v)
+		return nil
+	case mergestate.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case mergestate.FieldLocal:
+		v, ok := value.(map[string]time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocal(v)
+		return nil
+	case mergestate.FieldRemote:
+		v, ok := value.(map[string]time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemote(v)
+		return nil
+	case mergestate.FieldMergedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMergedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MergeState field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MergeStateMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MergeStateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MergeStateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MergeState numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MergeStateMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MergeStateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MergeStateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MergeState nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MergeStateMutation) ResetField(name string) error {
+	switch name {
+	case mergestate.FieldEnvironmentID:
+		m.ResetEnvironmentID()
+		return nil
+	case mergestate.FieldPath:
+		m.ResetPath()
+		return nil
+	case mergestate.FieldLocal:
+		m.ResetLocal()
+		return nil
+	case mergestate.FieldRemote:
+		m.ResetRemote()
+		return nil
+	case mergestate.FieldMergedAt:
+		m.ResetMergedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MergeState field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MergeStateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.environment != nil {
+		edges = append(edges, mergestate.EdgeEnvironment)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MergeStateMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case mergestate.EdgeEnvironment:
+		if id := m.environment; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MergeStateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MergeStateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MergeStateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedenvironment {
+		edges = append(edges, mergestate.EdgeEnvironment)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MergeStateMutation) EdgeCleared(name string) bool {
+	switch name {
+	case mergestate.EdgeEnvironment:
+		return m.clearedenvironment
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MergeStateMutation) ClearEdge(name string) error {
+	switch name {
+	case mergestate.EdgeEnvironment:
+		m.ClearEnvironment()
+		return nil
+	}
+	return fmt.Errorf("unknown MergeState unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MergeStateMutation) ResetEdge(name string) error {
+	switch name {
+	case mergestate.EdgeEnvironment:
+		m.ResetEnvironment()
+		return nil
+	}
+	return fmt.Errorf("unknown MergeState edge %s", name)
+}
+
+// RequiredVariableMutation represents an operation that mutates the RequiredVariable nodes in the graph.
+type RequiredVariableMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	created_at         *time.Time
+	updated_at         *time.Time
+	name               *string
+	description        *string
+	clearedFields      map[string]struct{}
+	environment        *int
+	clearedenvironment bool
+	done               bool
+	oldValue           func(context.Context) (*RequiredVariable, error)
+	predicates         []predicate.RequiredVariable
+}
+
+var _ ent.Mutation = (*RequiredVariableMutation)(nil)
+
+// requiredvariableOption allows management of the mutation configuration using functional options.
+type requiredvariableOption func(*RequiredVariableMutation)
+
+// newRequiredVariableMutation creates new mutation for the RequiredVariable entity.
+func newRequiredVariableMutation(c config, op Op, opts ...requiredvariableOption) *RequiredVariableMutation {
+	m := &RequiredVariableMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRequiredVariable,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRequiredVariableID sets the ID field of the mutation.
+func withRequiredVariableID(id int) requiredvariableOption {
+	return func(m *RequiredVariableMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RequiredVariable
+		)
+		m.oldValue = func(ctx context.Context) (*RequiredVariable, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RequiredVariable.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRequiredVariable sets the old RequiredVariable of the mutation.
+func withRequiredVariable(node *RequiredVariable) requiredvariableOption {
+	return func(m *RequiredVariableMutation) {
+		m.oldValue = func(context.Context) (*RequiredVariable, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RequiredVariableMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RequiredVariableMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RequiredVariableMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RequiredVariableMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RequiredVariable.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RequiredVariableMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RequiredVariableMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RequiredVariable entity.
+// If the RequiredVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RequiredVariableMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *RequiredVariableMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[requiredvariable.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *RequiredVariableMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[requiredvariable.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RequiredVariableMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, requiredvariable.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RequiredVariableMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RequiredVariableMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RequiredVariable entity.
+// If the RequiredVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RequiredVariableMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *RequiredVariableMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[requiredvariable.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *RequiredVariableMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[requiredvariable.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RequiredVariableMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, requiredvariable.FieldUpdatedAt)
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (m *RequiredVariableMutation) SetEnvironmentID(i int) {
+	m.environment = &i
+}
+
+// EnvironmentID returns the value of the "environment_id" field in the mutation.
+func (m *RequiredVariableMutation) EnvironmentID() (r int, exists bool) {
+	v := m.environment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvironmentID returns the old "environment_id" field's value of the RequiredVariable entity.
+// If the RequiredVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RequiredVariableMutation) OldEnvironmentID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvironmentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvironmentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvironmentID: %w", err)
+	}
+	return oldValue.EnvironmentID, nil
+}
+
+// ResetEnvironmentID resets all changes to the "environment_id" field.
+func (m *RequiredVariableMutation) ResetEnvironmentID() {
+	m.environment = nil
+}
+
+// SetName sets the "name" field.
+func (m *RequiredVariableMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RequiredVariableMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the RequiredVariable entity.
+// If the RequiredVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RequiredVariableMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RequiredVariableMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *RequiredVariableMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *RequiredVariableMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the RequiredVariable entity.
+// If the RequiredVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RequiredVariableMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *RequiredVariableMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[requiredvariable.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *RequiredVariableMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[requiredvariable.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *RequiredVariableMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, requiredvariable.FieldDescription)
+}
+
+// ClearEnvironment clears the "environment" edge to the Environment entity.
+func (m *RequiredVariableMutation) ClearEnvironment() {
+	m.clearedenvironment = true
+	m.clearedFields[requiredvariable.FieldEnvironmentID] = struct{}{}
+}
+
+// EnvironmentCleared reports if the "environment" edge to the Environment entity was cleared.
+func (m *RequiredVariableMutation) EnvironmentCleared() bool {
+	return m.clearedenvironment
+}
+
+// EnvironmentIDs returns the "environment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvironmentID instead. It exists only for internal usage by the builders.
+func (m *RequiredVariableMutation) EnvironmentIDs() (ids []int) {
+	if id := m.environment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEnvironment resets all changes to the "environment" edge.
+func (m *RequiredVariableMutation) ResetEnvironment() {
+	m.environment = nil
+	m.clearedenvironment = false
+}
+
+// Where appends a list predicates to the RequiredVariableMutation builder.
+func (m *RequiredVariableMutation) Where(ps ...predicate.RequiredVariable) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RequiredVariableMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RequiredVariableMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RequiredVariable, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RequiredVariableMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RequiredVariableMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RequiredVariable).
+func (m *RequiredVariableMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RequiredVariableMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, requiredvariable.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, requiredvariable.FieldUpdatedAt)
+	}
+	if m.environment != nil {
+		fields = append(fields, requiredvariable.FieldEnvironmentID)
+	}
+	if m.name != nil {
+		fields = append(fields, requiredvariable.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, requiredvariable.FieldDescription)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RequiredVariableMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case requiredvariable.FieldCreatedAt:
+		return m.CreatedAt()
+	case requiredvariable.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case requiredvariable.FieldEnvironmentID:
+		return m.EnvironmentID()
+	case requiredvariable.FieldName:
+		return m.Name()
+	case requiredvariable.FieldDescription:
+		return m.Description()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RequiredVariableMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case requiredvariable.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case requiredvariable.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case requiredvariable.FieldEnvironmentID:
+		return m.OldEnvironmentID(ctx)
+	case requiredvariable.FieldName:
+		return m.OldName(ctx)
+	case requiredvariable.FieldDescription:
+		return m.OldDescription(ctx)
+	}
+	return nil, fmt.Errorf("unknown RequiredVariable field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RequiredVariableMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case requiredvariable.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case requiredvariable.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case requiredvariable.FieldEnvironmentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvironmentID(v)
+		return nil
+	case requiredvariable.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case requiredvariable.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RequiredVariable field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RequiredVariableMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RequiredVariableMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RequiredVariableMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RequiredVariable numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RequiredVariableMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(requiredvariable.FieldCreatedAt) {
+		fields = append(fields, requiredvariable.FieldCreatedAt)
+	}
+	if m.FieldCleared(requiredvariable.FieldUpdatedAt) {
+		fields = append(fields, requiredvariable.FieldUpdatedAt)
+	}
+	if m.FieldCleared(requiredvariable.FieldDescription) {
+		fields = append(fields, requiredvariable.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RequiredVariableMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RequiredVariableMutation) ClearField(name string) error {
+	switch name {
+	case requiredvariable.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case requiredvariable.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case requiredvariable.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown RequiredVariable nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RequiredVariableMutation) ResetField(name string) error {
+	switch name {
+	case requiredvariable.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case requiredvariable.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case requiredvariable.FieldEnvironmentID:
+		m.ResetEnvironmentID()
+		return nil
+	case requiredvariable.FieldName:
+		m.ResetName()
+		return nil
+	case requiredvariable.FieldDescription:
+		m.ResetDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown RequiredVariable field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RequiredVariableMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.environment != nil {
+		edges = append(edges, requiredvariable.EdgeEnvironment)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RequiredVariableMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case requiredvariable.EdgeEnvironment:
+		if id := m.environment; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RequiredVariableMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RequiredVariableMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RequiredVariableMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedenvironment {
+		edges = append(edges, requiredvariable.EdgeEnvironment)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RequiredVariableMutation) EdgeCleared(name string) bool {
+	switch name {
+	case requiredvariable.EdgeEnvironment:
+		return m.clearedenvironment
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RequiredVariableMutation) ClearEdge(name string) error {
+	switch name {
+	case requiredvariable.EdgeEnvironment:
+		m.ClearEnvironment()
+		return nil
+	}
+	return fmt.Errorf("unknown RequiredVariable unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RequiredVariableMutation) ResetEdge(name string) error {
+	switch name {
+	case requiredvariable.EdgeEnvironment:
+		m.ResetEnvironment()
+		return nil
+	}
+	return fmt.Errorf("unknown RequiredVariable edge %s", name)
+}
+
+// SchemaKeyMutation represents an operation that mutates the SchemaKey nodes in the graph.
+type SchemaKeyMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	created_at        *time.Time
+	updated_at        *time.Time
+	name              *string
+	description       *string
+	default_value     *string
+	secret            *bool
+	optional          *bool
+	_type             *string
+	pattern           *string
+	min               *string
+	max               *string
+	enum_values       *[]string
+	appendenum_values []string
+	clearedFields     map[string]struct{}
+	schema            *int
+	clearedschema     bool
+	done              bool
+	oldValue          func(context.Context) (*SchemaKey, error)
+	predicates        []predicate.SchemaKey
+}
+
+var _ ent.Mutation = (*SchemaKeyMutation)(nil)
+
+// schemakeyOption allows management of the mutation configuration using functional options.
+type schemakeyOption func(*SchemaKeyMutation)
+
+// newSchemaKeyMutation creates new mutation for the SchemaKey entity.
+func newSchemaKeyMutation(c config, op Op, opts ...schemakeyOption) *SchemaKeyMutation {
+	m := &SchemaKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSchemaKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSchemaKeyID sets the ID field of the mutation.
+func withSchemaKeyID(id int) schemakeyOption {
+	return func(m *SchemaKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SchemaKey
+		)
+		m.oldValue = func(ctx context.Context) (*SchemaKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SchemaKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSchemaKey sets the old SchemaKey of the mutation.
+func withSchemaKey(node *SchemaKey) schemakeyOption {
+	return func(m *SchemaKeyMutation) {
+		m.oldValue = func(context.Context) (*SchemaKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SchemaKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SchemaKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SchemaKeyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SchemaKeyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SchemaKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SchemaKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SchemaKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SchemaKey entity.
+// If the SchemaKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *SchemaKeyMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[schemakey.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *SchemaKeyMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[schemakey.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SchemaKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, schemakey.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SchemaKeyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SchemaKeyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SchemaKey entity.
+// If the SchemaKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaKeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *SchemaKeyMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[schemakey.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *SchemaKeyMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[schemakey.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SchemaKeyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, schemakey.FieldUpdatedAt)
+}
+
+// SetSchemaID sets the "schema_id" field.
+func (m *SchemaKeyMutation) SetSchemaID(i int) {
+	m.schema = &i
+}
+
+// SchemaID returns the value of the "schema_id" field in the mutation.
+func (m *SchemaKeyMutation) SchemaID() (r int, exists bool) {
+	v := m.schema
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSchemaID returns the old "schema_id" field's value of the SchemaKey entity.
+// If the SchemaKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaKeyMutation) OldSchemaID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchemaID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchemaID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchemaID: %w", err)
+	}
+	return oldValue.SchemaID, nil
+}
+
+// ResetSchemaID resets all changes to the "schema_id" field.
+func (m *SchemaKeyMutation) ResetSchemaID() {
+	m.schema = nil
+}
+
+// SetName sets the "name" field.
+func (m *SchemaKeyMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SchemaKeyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SchemaKey entity.
+// If the SchemaKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaKeyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SchemaKeyMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *SchemaKeyMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *SchemaKeyMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the SchemaKey entity.
+// If the SchemaKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaKeyMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *SchemaKeyMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[schemakey.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *SchemaKeyMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[schemakey.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *SchemaKeyMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, schemakey.FieldDescription)
+}
+
+// SetDefaultValue sets the "default_value" field.
+func (m *SchemaKeyMutation) SetDefaultValue(s string) {
+	m.default_value = &s
+}
+
+// DefaultValue returns the value of the "default_value" field in the mutation.
+func (m *SchemaKeyMutation) DefaultValue() (r string, exists bool) {
+	v := m.default_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultValue returns the old "default_value" field's value of the SchemaKey entity.
+// If the SchemaKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaKeyMutation) OldDefaultValue(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultValue: %w", err)
+	}
+	return oldValue.DefaultValue, nil
+}
+
+// ClearDefaultValue clears the value of the "default_value" field.
+func (m *SchemaKeyMutation) ClearDefaultValue() {
+	m.default_value = nil
+	m.clearedFields[schemakey.FieldDefaultValue] = struct{}{}
+}
+
+// DefaultValueCleared returns if the "default_value" field was cleared in this mutation.
+func (m *SchemaKeyMutation) DefaultValueCleared() bool {
+	_, ok := m.clearedFields[schemakey.FieldDefaultValue]
+	return ok
+}
+
+// ResetDefaultValue resets all changes to the "default_value" field.
+func (m *SchemaKeyMutation) ResetDefaultValue() {
+	m.default_value = nil
+	delete(m.clearedFields, schemakey.FieldDefaultValue)
+}
+
+// SetSecret sets the "secret" field.
+func (m *SchemaKeyMutation) SetSecret(b bool) {
+	m.secret = &b
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *SchemaKeyMutation) Secret() (r bool, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the SchemaKey entity.
+// If the SchemaKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaKeyMutation) OldSecret(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (m *SchemaKeyMutation) ClearSecret() {
+	m.secret = nil
+	m.clearedFields[schemakey.FieldSecret] = struct{}{}
+}
+
+// SecretCleared returns if the "secret" field was cleared in this mutation.
+func (m *SchemaKeyMutation) SecretCleared() bool {
+	_, ok := m.clearedFields[schemakey.FieldSecret]
+	return ok
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *SchemaKeyMutation) ResetSecret() {
+	m.secret = nil
+	delete(m.clearedFields, schemakey.FieldSecret)
+}
+
+// SetOptional sets the "optional" field.
+func (m *SchemaKeyMutation) SetOptional(b bool) {
+	m.optional = &b
+}
+
+// Optional returns the value of the "optional" field in the mutation.
+func (m *SchemaKeyMutation) Optional() (r bool, exists bool) {
+	v := m.optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptional returns the old "optional" field's value of the SchemaKey entity.
+// If the SchemaKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaKeyMutation) OldOptional(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptional is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptional: %w", err)
+	}
+	return oldValue.Optional, nil
+}
+
+// ClearOptional clears the value of the "optional" field.
+func (m *SchemaKeyMutation) ClearOptional() {
+	m.optional = nil
+	m.clearedFields[schemakey.FieldOptional] = struct{}{}
+}
+
+// OptionalCleared returns if the "optional" field was cleared in this mutation.
+func (m *SchemaKeyMutation) OptionalCleared() bool {
+	_, ok := m.clearedFields[schemakey.FieldOptional]
+	return ok
+}
+
+// ResetOptional resets all changes to the "optional" field.
+func (m *SchemaKeyMutation) ResetOptional() {
+	m.optional = nil
+	delete(m.clearedFields, schemakey.FieldOptional)
+}
+
+// SetType sets the "type" field.
+func (m *SchemaKeyMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *SchemaKeyMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the SchemaKey entity.
+// If the SchemaKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaKeyMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *SchemaKeyMutation) ResetType() {
+	m._type = nil
+}
+
+// SetPattern sets the "pattern" field.
+func (m *SchemaKeyMutation) SetPattern(s string) {
+	m.pattern = &s
+}
+
+// Pattern returns the value of the "pattern" field in the mutation.
+func (m *SchemaKeyMutation) Pattern() (r string, exists bool) {
+	v := m.pattern
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPattern returns the old "pattern" field's value of the SchemaKey entity.
+// If the SchemaKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaKeyMutation) OldPattern(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPattern is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPattern requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPattern: %w", err)
+	}
+	return oldValue.Pattern, nil
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (m *SchemaKeyMutation) ClearPattern() {
+	m.pattern = nil
+	m.clearedFields[schemakey.FieldPattern] = struct{}{}
+}
+
+// PatternCleared returns if the "pattern" field was cleared in this mutation.
+func (m *SchemaKeyMutation) PatternCleared() bool {
+	_, ok := m.clearedFields[schemakey.FieldPattern]
+	return ok
+}
+
+// ResetPattern resets all changes to the "pattern" field.
+func (m *SchemaKeyMutation) ResetPattern() {
+	m.pattern = nil
+	delete(m.clearedFields, schemakey.FieldPattern)
+}
+
+// SetMin sets the "min" field.
+func (m *SchemaKeyMutation) SetMin(s string) {
+	m.min = &s
+}
+
+// Min returns the value of the "min" field in the mutation.
+func (m *SchemaKeyMutation) Min() (r string, exists bool) {
+	v := m.min
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMin returns the old "min" field's value of the SchemaKey entity.
+// If the SchemaKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaKeyMutation) OldMin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMin: %w", err)
+	}
+	return oldValue.Min, nil
+}
+
+// ClearMin clears the value of the "min" field.
+func (m *SchemaKeyMutation) ClearMin() {
+	m.min = nil
+	m.clearedFields[schemakey.FieldMin] = struct{}{}
+}
+
+// MinCleared returns if the "min" field was cleared in this mutation.
+func (m *SchemaKeyMutation) MinCleared() bool {
+	_, ok := m.clearedFields[schemakey.FieldMin]
+	return ok
+}
+
+// ResetMin resets all changes to the "min" field.
+func (m *SchemaKeyMutation) ResetMin() {
+	m.min = nil
+	delete(m.clearedFields, schemakey.FieldMin)
+}
+
+// SetMax sets the "max" field.
+func (m *SchemaKeyMutation) SetMax(s string) {
+	m.max = &s
+}
+
+// Max returns the value of the "max" field in the mutation.
+func (m *SchemaKeyMutation) Max() (r string, exists bool) {
+	v := m.max
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMax returns the old "max" field's value of the SchemaKey entity.
+// If the SchemaKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaKeyMutation) OldMax(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMax is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMax requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMax: %w", err)
+	}
+	return oldValue.Max, nil
+}
+
+// ClearMax clears the value of the "max" field.
+func (m *SchemaKeyMutation) ClearMax() {
+	m.max = nil
+	m.clearedFields[schemakey.FieldMax] = struct{}{}
+}
+
+// MaxCleared returns if the "max" field was cleared in this mutation.
+func (m *SchemaKeyMutation) MaxCleared() bool {
+	_, ok := m.clearedFields[schemakey.FieldMax]
+	return ok
+}
+
+// ResetMax resets all changes to the "max" field.
+func (m *SchemaKeyMutation) ResetMax() {
+	m.max = nil
+	delete(m.clearedFields, schemakey.FieldMax)
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (m *SchemaKeyMutation) SetEnumValues(s []string) {
+	m.enum_values = &s
+	m.appendenum_values = nil
+}
+
+// EnumValues returns the value of the "enum_values" field in the mutation.
+func (m *SchemaKeyMutation) EnumValues() (r []string, exists bool) {
+	v := m.enum_values
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnumValues returns the old "enum_values" field's value of the SchemaKey entity.
+// If the SchemaKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaKeyMutation) OldEnumValues(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnumValues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnumValues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnumValues: %w", err)
+	}
+	return oldValue.EnumValues, nil
+}
+
+// AppendEnumValues adds s to the "enum_values" field.
+func (m *SchemaKeyMutation) AppendEnumValues(s []string) {
+	m.appendenum_values = append(m.appendenum_values, s...)
+}
+
+// AppendedEnumValues returns the list of values that were appended to the "enum_values" field in this mutation.
+func (m *SchemaKeyMutation) AppendedEnumValues() ([]string, bool) {
+	if len(m.appendenum_values) == 0 {
+		return nil, false
+	}
+	return m.appendenum_values, true
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (m *SchemaKeyMutation) ClearEnumValues() {
+	m.enum_values = nil
+	m.appendenum_values = nil
+	m.clearedFields[schemakey.FieldEnumValues] = struct{}{}
+}
+
+// EnumValuesCleared returns if the "enum_values" field was cleared in this mutation.
+func (m *SchemaKeyMutation) EnumValuesCleared() bool {
+	_, ok := m.clearedFields[schemakey.FieldEnumValues]
+	return ok
+}
+
+// ResetEnumValues resets all changes to the "enum_values" field.
+func (m *SchemaKeyMutation) ResetEnumValues() {
+	m.enum_values = nil
+	m.appendenum_values = nil
+	delete(m.clearedFields, schemakey.FieldEnumValues)
+}
+
+// ClearSchema clears the "schema" edge to the EnvironmentSchema entity.
+func (m *SchemaKeyMutation) ClearSchema() {
+	m.clearedschema = true
+	m.clearedFields[schemakey.FieldSchemaID] = struct{}{}
+}
+
+// SchemaCleared reports if the "schema" edge to the EnvironmentSchema entity was cleared.
+func (m *SchemaKeyMutation) SchemaCleared() bool {
+	return m.clearedschema
+}
+
+// SchemaIDs returns the "schema" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SchemaID instead. It exists only for internal usage by the builders.
+func (m *SchemaKeyMutation) SchemaIDs() (ids []int) {
+	if id := m.schema; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSchema resets all changes to the "schema" edge.
+func (m *SchemaKeyMutation) ResetSchema() {
+	m.schema = nil
+	m.clearedschema = false
+}
+
+// Where appends a list predicates to the SchemaKeyMutation builder.
+func (m *SchemaKeyMutation) Where(ps ...predicate.SchemaKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SchemaKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SchemaKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SchemaKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SchemaKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SchemaKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SchemaKey).
+func (m *SchemaKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SchemaKeyMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.created_at != nil {
+		fields = append(fields, schemakey.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, schemakey.FieldUpdatedAt)
+	}
+	if m.schema != nil {
+		fields = append(fields, schemakey.FieldSchemaID)
+	}
+	if m.name != nil {
+		fields = append(fields, schemakey.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, schemakey.FieldDescription)
+	}
+	if m.default_value != nil {
+		fields = append(fields, schemakey.FieldDefaultValue)
+	}
+	if m.secret != nil {
+		fields = append(fields, schemakey.FieldSecret)
+	}
+	if m.optional != nil {
+		fields = append(fields, schemakey.FieldOptional)
+	}
+	if m._type != nil {
+		fields = append(fields, schemakey.FieldType)
+	}
+	if m.pattern != nil {
+		fields = append(fields, schemakey.FieldPattern)
+	}
+	if m.min != nil {
+		fields = append(fields, schemakey.FieldMin)
+	}
+	if m.max != nil {
+		fields = append(fields, schemakey.FieldMax)
+	}
+	if m.enum_values != nil {
+		fields = append(fields, schemakey.FieldEnumValues)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SchemaKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case schemakey.FieldCreatedAt:
+		return m.CreatedAt()
+	case schemakey.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case schemakey.FieldSchemaID:
+		return m.SchemaID()
+	case schemakey.FieldName:
+		return m.Name()
+	case schemakey.FieldDescription:
+		return m.Description()
+	case schemakey.FieldDefaultValue:
+		return m.DefaultValue()
+	case schemakey.FieldSecret:
+		return m.Secret()
+	case schemakey.FieldOptional:
+		return m.Optional()
+	case schemakey.FieldType:
+		return m.GetType()
+	case schemakey.FieldPattern:
+		return m.Pattern()
+	case schemakey.FieldMin:
+		return m.Min()
+	case schemakey.FieldMax:
+		return m.Max()
+	case schemakey.FieldEnumValues:
+		return m.EnumValues()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SchemaKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case schemakey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case schemakey.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case schemakey.FieldSchemaID:
+		return m.OldSchemaID(ctx)
+	case schemakey.FieldName:
+		return m.OldName(ctx)
+	case schemakey.FieldDescription:
+		return m.OldDescription(ctx)
+	case schemakey.FieldDefaultValue:
+		return m.OldDefaultValue(ctx)
+	case schemakey.FieldSecret:
+		return m.OldSecret(ctx)
+	case schemakey.FieldOptional:
+		return m.OldOptional(ctx)
+	case schemakey.FieldType:
+		return m.OldType(ctx)
+	case schemakey.FieldPattern:
+		return m.OldPattern(ctx)
+	case schemakey.FieldMin:
+		return m.OldMin(ctx)
+	case schemakey.FieldMax:
+		return m.OldMax(ctx)
+	case schemakey.FieldEnumValues:
+		return m.OldEnumValues(ctx)
+	}
+	return nil, fmt.Errorf("unknown SchemaKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SchemaKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case schemakey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case schemakey.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case schemakey.FieldSchemaID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchemaID(v)
+		return nil
+	case schemakey.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case schemakey.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case schemakey.FieldDefaultValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultValue(v)
+		return nil
+	case schemakey.FieldSecret:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case schemakey.FieldOptional:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptional(v)
+		return nil
+	case schemakey.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case schemakey.FieldPattern:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPattern(v)
+		return nil
+	case schemakey.FieldMin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMin(v)
+		return nil
+	case schemakey.FieldMax:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMax(v)
+		return nil
+	case schemakey.FieldEnumValues:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnumValues(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SchemaKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SchemaKeyMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SchemaKeyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SchemaKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SchemaKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SchemaKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(schemakey.FieldCreatedAt) {
+		fields = append(fields, schemakey.FieldCreatedAt)
+	}
+	if m.FieldCleared(schemakey.FieldUpdatedAt) {
+		fields = append(fields, schemakey.FieldUpdatedAt)
+	}
+	if m.FieldCleared(schemakey.FieldDescription) {
+		fields = append(fields, schemakey.FieldDescription)
+	}
+	if m.FieldCleared(schemakey.FieldDefaultValue) {
+		fields = append(fields, schemakey.FieldDefaultValue)
+	}
+	if m.FieldCleared(schemakey.FieldSecret) {
+		fields = append(fields, schemakey.FieldSecret)
+	}
+	if m.FieldCleared(schemakey.FieldOptional) {
+		fields = append(fields, schemakey.FieldOptional)
+	}
+	if m.FieldCleared(schemakey.FieldPattern) {
+		fields = append(fields, schemakey.FieldPattern)
+	}
+	if m.FieldCleared(schemakey.FieldMin) {
+		fields = append(fields, schemakey.FieldMin)
+	}
+	if m.FieldCleared(schemakey.FieldMax) {
+		fields = append(fields, schemakey.FieldMax)
+	}
+	if m.FieldCleared(schemakey.FieldEnumValues) {
+		fields = append(fields, schemakey.FieldEnumValues)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SchemaKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SchemaKeyMutation) ClearField(name string) error {
+	switch name {
+	case schemakey.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case schemakey.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case schemakey.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case schemakey.FieldDefaultValue:
+		m.ClearDefaultValue()
+		return nil
+	case schemakey.FieldSecret:
+		m.ClearSecret()
+		return nil
+	case schemakey.FieldOptional:
+		m.ClearOptional()
+		return nil
+	case schemakey.FieldPattern:
+		m.ClearPattern()
+		return nil
+	case schemakey.FieldMin:
+		m.ClearMin()
+		return nil
+	case schemakey.FieldMax:
+		m.ClearMax()
+		return nil
+	case schemakey.FieldEnumValues:
+		m.ClearEnumValues()
+		return nil
+	}
+	return fmt.Errorf("unknown SchemaKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SchemaKeyMutation) ResetField(name string) error {
+	switch name {
+	case schemakey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case schemakey.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case schemakey.FieldSchemaID:
+		m.ResetSchemaID()
+		return nil
+	case schemakey.FieldName:
+		m.ResetName()
+		return nil
+	case schemakey.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case schemakey.FieldDefaultValue:
+		m.ResetDefaultValue()
+		return nil
+	case schemakey.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case schemakey.FieldOptional:
+		m.ResetOptional()
+		return nil
+	case schemakey.FieldType:
+		m.ResetType()
+		return nil
+	case schemakey.FieldPattern:
+		m.ResetPattern()
+		return nil
+	case schemakey.FieldMin:
+		m.ResetMin()
+		return nil
+	case schemakey.FieldMax:
+		m.ResetMax()
+		return nil
+	case schemakey.FieldEnumValues:
+		m.ResetEnumValues()
+		return nil
+	}
+	return fmt.Errorf("unknown SchemaKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SchemaKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.schema != nil {
+		edges = append(edges, schemakey.EdgeSchema)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SchemaKeyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case schemakey.EdgeSchema:
+		if id := m.schema; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SchemaKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SchemaKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SchemaKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedschema {
+		edges = append(edges, schemakey.EdgeSchema)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SchemaKeyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case schemakey.EdgeSchema:
+		return m.clearedschema
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SchemaKeyMutation) ClearEdge(name string) error {
+	switch name {
+	case schemakey.EdgeSchema:
+		m.ClearSchema()
+		return nil
+	}
+	return fmt.Errorf("unknown SchemaKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SchemaKeyMutation) ResetEdge(name string) error {
+	switch name {
+	case schemakey.EdgeSchema:
+		m.ResetSchema()
+		return nil
+	}
+	return fmt.Errorf("unknown SchemaKey edge %s", name)
+}
+
+// SyncStateMutation represents an operation that mutates the SyncState nodes in the graph.
+type SyncStateMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	_path              *string
+	variables          *map[string]string
+	synced_at          *time.Time
+	clearedFields      map[string]struct{}
+	environment        *int
+	clearedenvironment bool
+	done               bool
+	oldValue           func(context.Context) (*SyncState, error)
+	predicates         []predicate.SyncState
+}
+
+var _ ent.Mutation = (*SyncStateMutation)(nil)
+
+// syncstateOption allows management of the mutation configuration using functional options.
+type syncstateOption func(*SyncStateMutation)
+
+// newSyncStateMutation creates new mutation for the SyncState entity.
+func newSyncStateMutation(c config, op Op, opts ...syncstateOption) *SyncStateMutation {
+	m := &SyncStateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSyncState,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSyncStateID sets the ID field of the mutation.
+func withSyncStateID(id int) syncstateOption {
+	return func(m *SyncStateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SyncState
+		)
+		m.oldValue = func(ctx context.Context) (*SyncState, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SyncState.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSyncState sets the old SyncState of the mutation.
+func withSyncState(node *SyncState) syncstateOption {
+	return func(m *SyncStateMutation) {
+		m.oldValue = func(context.Context) (*SyncState, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SyncStateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SyncStateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SyncStateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SyncStateMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SyncState.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (m *SyncStateMutation) SetEnvironmentID(i int) {
+	m.environment = &i
+}
+
+// EnvironmentID returns the value of the "environment_id" field in the mutation.
+func (m *SyncStateMutation) EnvironmentID() (r int, exists bool) {
+	v := m.environment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvironmentID returns the old "environment_id" field's value of the SyncState entity.
+// If the SyncState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncStateMutation) OldEnvironmentID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvironmentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvironmentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvironmentID: %w", err)
+	}
+	return oldValue.EnvironmentID, nil
+}
+
+// ResetEnvironmentID resets all changes to the "environment_id" field.
+func (m *SyncStateMutation) ResetEnvironmentID() {
+	m.environment = nil
+}
+
+// SetPath sets the "path" field.
+func (m *SyncStateMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *SyncStateMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the SyncState entity.
+// If the SyncState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncStateMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *SyncStateMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetVariables sets the "variables" field.
+func (m *SyncStateMutation) SetVariables(value map[string]string) {
+	m.variables = &value
+}
+
+// Variables returns the value of the "variables" field in the mutation.
+func (m *SyncStateMutation) Variables() (r map[string]string, exists bool) {
+	v := m.variables
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVariables returns the old "variables" field's value of the SyncState entity.
+// If the SyncState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncStateMutation) OldVariables(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVariables is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVariables requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVariables: %w", err)
+	}
+	return oldValue.Variables, nil
+}
+
+// ResetVariables resets all changes to the "variables" field.
+func (m *SyncStateMutation) ResetVariables() {
+	m.variables = nil
+}
+
+// SetSyncedAt sets the "synced_at" field.
+func (m *SyncStateMutation) SetSyncedAt(t time.Time) {
+	m.synced_at = &t
+}
+
+// SyncedAt returns the value of the "synced_at" field in the mutation.
+func (m *SyncStateMutation) SyncedAt() (r time.Time, exists bool) {
+	v := m.synced_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSyncedAt returns the old "synced_at" field's value of the SyncState entity.
+// If the SyncState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncStateMutation) OldSyncedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSyncedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSyncedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSyncedAt: %w", err)
+	}
+	return oldValue.SyncedAt, nil
+}
+
+// ResetSyncedAt resets all changes to the "synced_at" field.
+func (m *SyncStateMutation) ResetSyncedAt() {
+	m.synced_at = nil
+}
+
+// ClearEnvironment clears the "environment" edge to the Environment entity.
+func (m *SyncStateMutation) ClearEnvironment() {
+	m.clearedenvironment = true
+	m.clearedFields[syncstate.FieldEnvironmentID] = struct{}{}
+}
+
+// EnvironmentCleared reports if the "environment" edge to the Environment entity was cleared.
+func (m *SyncStateMutation) EnvironmentCleared() bool {
+	return m.clearedenvironment
+}
+
+// EnvironmentIDs returns the "environment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvironmentID instead. It exists only for internal usage by the builders.
+func (m *SyncStateMutation) EnvironmentIDs() (ids []int) {
+	if id := m.environment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEnvironment resets all changes to the "environment" edge.
+func (m *SyncStateMutation) ResetEnvironment() {
+	m.environment = nil
+	m.clearedenvironment = false
+}
+
+// Where appends a list predicates to the SyncStateMutation builder.
+func (m *SyncStateMutation) Where(ps ...predicate.SyncState) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SyncStateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SyncStateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SyncState, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SyncStateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SyncStateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SyncState).
+func (m *SyncStateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SyncStateMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.environment != nil {
+		fields = append(fields, syncstate.FieldEnvironmentID)
+	}
+	if m._path != nil {
+		fields = append(fields, syncstate.FieldPath)
+	}
+	if m.variables != nil {
+		fields = append(fields, syncstate.FieldVariables)
+	}
+	if m.synced_at != nil {
+		fields = append(fields, syncstate.FieldSyncedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SyncStateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case syncstate.FieldEnvironmentID:
+		return m.EnvironmentID()
+	case syncstate.FieldPath:
+		return m.Path()
+	case syncstate.FieldVariables:
+		return m.Variables()
+	case syncstate.FieldSyncedAt:
+		return m.SyncedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SyncStateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case syncstate.FieldEnvironmentID:
+		return m.OldEnvironmentID(ctx)
+	case syncstate.FieldPath:
+		return m.OldPath(ctx)
+	case syncstate.FieldVariables:
+		return m.OldVariables(ctx)
+	case syncstate.FieldSyncedAt:
+		return m.OldSyncedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SyncState field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SyncStateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case syncstate.FieldEnvironmentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvironmentID(v)
+		return nil
+	case syncstate.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case syncstate.FieldVariables:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVariables(v)
+		return nil
+	case syncstate.FieldSyncedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSyncedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SyncState field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SyncStateMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SyncStateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SyncStateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SyncState numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SyncStateMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SyncStateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SyncStateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SyncState nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SyncStateMutation) ResetField(name string) error {
+	switch name {
+	case syncstate.FieldEnvironmentID:
+		m.ResetEnvironmentID()
+		return nil
+	case syncstate.FieldPath:
+		m.ResetPath()
+		return nil
+	case syncstate.FieldVariables:
+		m.ResetVariables()
+		return nil
+	case syncstate.FieldSyncedAt:
+		m.ResetSyncedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SyncState field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SyncStateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.environment != nil {
+		edges = append(edges, syncstate.EdgeEnvironment)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SyncStateMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case syncstate.EdgeEnvironment:
+		if id := m.environment; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SyncStateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SyncStateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SyncStateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedenvironment {
+		edges = append(edges, syncstate.EdgeEnvironment)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SyncStateMutation) EdgeCleared(name string) bool {
+	switch name {
+	case syncstate.EdgeEnvironment:
+		return m.clearedenvironment
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SyncStateMutation) ClearEdge(name string) error {
+	switch name {
+	case syncstate.EdgeEnvironment:
+		m.ClearEnvironment()
+		return nil
+	}
+	return fmt.Errorf("unknown SyncState unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SyncStateMutation) ResetEdge(name string) error {
+	switch name {
+	case syncstate.EdgeEnvironment:
+		m.ResetEnvironment()
+		return nil
+	}
+	return fmt.Errorf("unknown SyncState edge %s", name)
+}
+
+// VariableMutation represents an operation that mutates the Variable nodes in the graph.
+type VariableMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	created_at         *time.Time
+	updated_at         *time.Time
+	name               *string
+	value              *string
+	comment            *string
+	expand             *bool
+	secret             *bool
+	_type              *string
+	pattern            *string
+	min                *string
+	max                *string
+	enum_values        *[]string
+	appendenum_values  []string
+	clearedFields      map[string]struct{}
+	environment        *int
+	clearedenvironment bool
+	done               bool
+	oldValue           func(context.Context) (*Variable, error)
+	predicates         []predicate.Variable
+}
+
+var _ ent.Mutation = (*VariableMutation)(nil)
+
+// variableOption allows management of the mutation configuration using functional options.
+type variableOption func(*VariableMutation)
+
+// newVariableMutation creates new mutation for the Variable entity.
+func newVariableMutation(c config, op Op, opts ...variableOption) *VariableMutation {
+	m := &VariableMutation{
 		config:        c,
 		op:            op,
 		typ:           TypeVariable,
@@ -606,37 +5347,135 @@ func (m VariableMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VariableMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VariableMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Variable.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *VariableMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *VariableMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Variable entity.
+// If the Variable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VariableMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *VariableMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[variable.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *VariableMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[variable.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *VariableMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, variable.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *VariableMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *VariableMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Variable entity.
+// If the Variable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VariableMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *VariableMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *VariableMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[variable.FieldUpdatedAt] = struct{}{}
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *VariableMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Variable.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *VariableMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[variable.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *VariableMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, variable.FieldUpdatedAt)
 }
 
 // SetEnvironmentID sets the "environment_id" field.
@@ -845,6 +5684,303 @@ func (m *VariableMutation) ResetExpand() {
 	delete(m.clearedFields, variable.FieldExpand)
 }
 
+// SetSecret sets the "secret" field.
+func (m *VariableMutation) SetSecret(b bool) {
+	m.secret = &b
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *VariableMutation) Secret() (r bool, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the Variable entity.
+// If the Variable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VariableMutation) OldSecret(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (m *VariableMutation) ClearSecret() {
+	m.secret = nil
+	m.clearedFields[variable.FieldSecret] = struct{}{}
+}
+
+// SecretCleared returns if the "secret" field was cleared in this mutation.
+func (m *VariableMutation) SecretCleared() bool {
+	_, ok := m.clearedFields[variable.FieldSecret]
+	return ok
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *VariableMutation) ResetSecret() {
+	m.secret = nil
+	delete(m.clearedFields, variable.FieldSecret)
+}
+
+// SetType sets the "type" field.
+func (m *VariableMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *VariableMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Variable entity.
+// If the Variable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VariableMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *VariableMutation) ResetType() {
+	m._type = nil
+}
+
+// SetPattern sets the "pattern" field.
+func (m *VariableMutation) SetPattern(s string) {
+	m.pattern = &s
+}
+
+// Pattern returns the value of the "pattern" field in the mutation.
+func (m *VariableMutation) Pattern() (r string, exists bool) {
+	v := m.pattern
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPattern returns the old "pattern" field's value of the Variable entity.
+// If the Variable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VariableMutation) OldPattern(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPattern is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPattern requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPattern: %w", err)
+	}
+	return oldValue.Pattern, nil
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (m *VariableMutation) ClearPattern() {
+	m.pattern = nil
+	m.clearedFields[variable.FieldPattern] = struct{}{}
+}
+
+// PatternCleared returns if the "pattern" field was cleared in this mutation.
+func (m *VariableMutation) PatternCleared() bool {
+	_, ok := m.clearedFields[variable.FieldPattern]
+	return ok
+}
+
+// ResetPattern resets all changes to the "pattern" field.
+func (m *VariableMutation) ResetPattern() {
+	m.pattern = nil
+	delete(m.clearedFields, variable.FieldPattern)
+}
+
+// SetMin sets the "min" field.
+func (m *VariableMutation) SetMin(s string) {
+	m.min = &s
+}
+
+// Min returns the value of the "min" field in the mutation.
+func (m *VariableMutation) Min() (r string, exists bool) {
+	v := m.min
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMin returns the old "min" field's value of the Variable entity.
+// If the Variable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VariableMutation) OldMin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMin: %w", err)
+	}
+	return oldValue.Min, nil
+}
+
+// ClearMin clears the value of the "min" field.
+func (m *VariableMutation) ClearMin() {
+	m.min = nil
+	m.clearedFields[variable.FieldMin] = struct{}{}
+}
+
+// MinCleared returns if the "min" field was cleared in this mutation.
+func (m *VariableMutation) MinCleared() bool {
+	_, ok := m.clearedFields[variable.FieldMin]
+	return ok
+}
+
+// ResetMin resets all changes to the "min" field.
+func (m *VariableMutation) ResetMin() {
+	m.min = nil
+	delete(m.clearedFields, variable.FieldMin)
+}
+
+// SetMax sets the "max" field.
+func (m *VariableMutation) SetMax(s string) {
+	m.max = &s
+}
+
+// Max returns the value of the "max" field in the mutation.
+func (m *VariableMutation) Max() (r string, exists bool) {
+	v := m.max
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMax returns the old "max" field's value of the Variable entity.
+// If the Variable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VariableMutation) OldMax(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMax is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMax requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMax: %w", err)
+	}
+	return oldValue.Max, nil
+}
+
+// ClearMax clears the value of the "max" field.
+func (m *VariableMutation) ClearMax() {
+	m.max = nil
+	m.clearedFields[variable.FieldMax] = struct{}{}
+}
+
+// MaxCleared returns if the "max" field was cleared in this mutation.
+func (m *VariableMutation) MaxCleared() bool {
+	_, ok := m.clearedFields[variable.FieldMax]
+	return ok
+}
+
+// ResetMax resets all changes to the "max" field.
+func (m *VariableMutation) ResetMax() {
+	m.max = nil
+	delete(m.clearedFields, variable.FieldMax)
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (m *VariableMutation) SetEnumValues(s []string) {
+	m.enum_values = &s
+	m.appendenum_values = nil
+}
+
+// EnumValues returns the value of the "enum_values" field in the mutation.
+func (m *VariableMutation) EnumValues() (r []string, exists bool) {
+	v := m.enum_values
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnumValues returns the old "enum_values" field's value of the Variable entity.
+// If the Variable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VariableMutation) OldEnumValues(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnumValues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnumValues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnumValues: %w", err)
+	}
+	return oldValue.EnumValues, nil
+}
+
+// AppendEnumValues adds s to the "enum_values" field.
+func (m *VariableMutation) AppendEnumValues(s []string) {
+	m.appendenum_values = append(m.appendenum_values, s...)
+}
+
+// AppendedEnumValues returns the list of values that were appended to the "enum_values" field in this mutation.
+func (m *VariableMutation) AppendedEnumValues() ([]string, bool) {
+	if len(m.appendenum_values) == 0 {
+		return nil, false
+	}
+	return m.appendenum_values, true
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (m *VariableMutation) ClearEnumValues() {
+	m.enum_values = nil
+	m.appendenum_values = nil
+	m.clearedFields[variable.FieldEnumValues] = struct{}{}
+}
+
+// EnumValuesCleared returns if the "enum_values" field was cleared in this mutation.
+func (m *VariableMutation) EnumValuesCleared() bool {
+	_, ok := m.clearedFields[variable.FieldEnumValues]
+	return ok
+}
+
+// ResetEnumValues resets all changes to the "enum_values" field.
+func (m *VariableMutation) ResetEnumValues() {
+	m.enum_values = nil
+	m.appendenum_values = nil
+	delete(m.clearedFields, variable.FieldEnumValues)
+}
+
 // ClearEnvironment clears the "environment" edge to the Environment entity.
 func (m *VariableMutation) ClearEnvironment() {
 	m.clearedenvironment = true
@@ -906,7 +6042,13 @@ func (m *VariableMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *VariableMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 13)
+	if m.created_at != nil {
+		fields = append(fields, variable.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, variable.FieldUpdatedAt)
+	}
 	if m.environment != nil {
 		fields = append(fields, variable.FieldEnvironmentID)
 	}
@@ -922,6 +6064,24 @@ func (m *VariableMutation) Fields() []string {
 	if m.expand != nil {
 		fields = append(fields, variable.FieldExpand)
 	}
+	if m.secret != nil {
+		fields = append(fields, variable.FieldSecret)
+	}
+	if m._type != nil {
+		fields = append(fields, variable.FieldType)
+	}
+	if m.pattern != nil {
+		fields = append(fields, variable.FieldPattern)
+	}
+	if m.min != nil {
+		fields = append(fields, variable.FieldMin)
+	}
+	if m.max != nil {
+		fields = append(fields, variable.FieldMax)
+	}
+	if m.enum_values != nil {
+		fields = append(fields, variable.FieldEnumValues)
+	}
 	return fields
 }
 
@@ -930,6 +6090,10 @@ func (m *VariableMutation) Fields() []string {
 // schema.
 func (m *VariableMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case variable.FieldCreatedAt:
+		return m.CreatedAt()
+	case variable.FieldUpdatedAt:
+		return m.UpdatedAt()
 	case variable.FieldEnvironmentID:
 		return m.EnvironmentID()
 	case variable.FieldName:
@@ -940,6 +6104,18 @@ func (m *VariableMutation) Field(name string) (ent.Value, bool) {
 		return m.Comment()
 	case variable.FieldExpand:
 		return m.Expand()
+	case variable.FieldSecret:
+		return m.Secret()
+	case variable.FieldType:
+		return m.GetType()
+	case variable.FieldPattern:
+		return m.Pattern()
+	case variable.FieldMin:
+		return m.Min()
+	case variable.FieldMax:
+		return m.Max()
+	case variable.FieldEnumValues:
+		return m.EnumValues()
 	}
 	return nil, false
 }
@@ -949,6 +6125,10 @@ func (m *VariableMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *VariableMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case variable.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case variable.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	case variable.FieldEnvironmentID:
 		return m.OldEnvironmentID(ctx)
 	case variable.FieldName:
@@ -959,6 +6139,18 @@ func (m *VariableMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldComment(ctx)
 	case variable.FieldExpand:
 		return m.OldExpand(ctx)
+	case variable.FieldSecret:
+		return m.OldSecret(ctx)
+	case variable.FieldType:
+		return m.OldType(ctx)
+	case variable.FieldPattern:
+		return m.OldPattern(ctx)
+	case variable.FieldMin:
+		return m.OldMin(ctx)
+	case variable.FieldMax:
+		return m.OldMax(ctx)
+	case variable.FieldEnumValues:
+		return m.OldEnumValues(ctx)
 	}
 	return nil, fmt.Errorf("unknown Variable field %s", name)
 }
@@ -968,6 +6160,20 @@ func (m *VariableMutation) OldField(ctx context.Context, name string) (ent.Value
 // type.
 func (m *VariableMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case variable.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case variable.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	case variable.FieldEnvironmentID:
 		v, ok := value.(int)
 		if !ok {
@@ -1003,6 +6209,48 @@ func (m *VariableMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetExpand(v)
 		return nil
+	case variable.FieldSecret:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case variable.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case variable.FieldPattern:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPattern(v)
+		return nil
+	case variable.FieldMin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMin(v)
+		return nil
+	case variable.FieldMax:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMax(v)
+		return nil
+	case variable.FieldEnumValues:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnumValues(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Variable field %s", name)
 }
@@ -1036,12 +6284,33 @@ func (m *VariableMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *VariableMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(variable.FieldCreatedAt) {
+		fields = append(fields, variable.FieldCreatedAt)
+	}
+	if m.FieldCleared(variable.FieldUpdatedAt) {
+		fields = append(fields, variable.FieldUpdatedAt)
+	}
 	if m.FieldCleared(variable.FieldComment) {
 		fields = append(fields, variable.FieldComment)
 	}
 	if m.FieldCleared(variable.FieldExpand) {
 		fields = append(fields, variable.FieldExpand)
 	}
+	if m.FieldCleared(variable.FieldSecret) {
+		fields = append(fields, variable.FieldSecret)
+	}
+	if m.FieldCleared(variable.FieldPattern) {
+		fields = append(fields, variable.FieldPattern)
+	}
+	if m.FieldCleared(variable.FieldMin) {
+		fields = append(fields, variable.FieldMin)
+	}
+	if m.FieldCleared(variable.FieldMax) {
+		fields = append(fields, variable.FieldMax)
+	}
+	if m.FieldCleared(variable.FieldEnumValues) {
+		fields = append(fields, variable.FieldEnumValues)
+	}
 	return fields
 }
 
@@ -1056,12 +6325,33 @@ func (m *VariableMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *VariableMutation) ClearField(name string) error {
 	switch name {
+	case variable.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case variable.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
 	case variable.FieldComment:
 		m.ClearComment()
 		return nil
 	case variable.FieldExpand:
 		m.ClearExpand()
 		return nil
+	case variable.FieldSecret:
+		m.ClearSecret()
+		return nil
+	case variable.FieldPattern:
+		m.ClearPattern()
+		return nil
+	case variable.FieldMin:
+		m.ClearMin()
+		return nil
+	case variable.FieldMax:
+		m.ClearMax()
+		return nil
+	case variable.FieldEnumValues:
+		m.ClearEnumValues()
+		return nil
 	}
 	return fmt.Errorf("unknown Variable nullable field %s", name)
 }
@@ -1070,6 +6360,12 @@ func (m *VariableMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *VariableMutation) ResetField(name string) error {
 	switch name {
+	case variable.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case variable.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	case variable.FieldEnvironmentID:
 		m.ResetEnvironmentID()
 		return nil
@@ -1085,6 +6381,24 @@ func (m *VariableMutation) ResetField(name string) error {
 	case variable.FieldExpand:
 		m.ResetExpand()
 		return nil
+	case variable.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case variable.FieldType:
+		m.ResetType()
+		return nil
+	case variable.FieldPattern:
+		m.ResetPattern()
+		return nil
+	case variable.FieldMin:
+		m.ResetMin()
+		return nil
+	case variable.FieldMax:
+		m.ResetMax()
+		return nil
+	case variable.FieldEnumValues:
+		m.ResetEnumValues()
+		return nil
 	}
 	return fmt.Errorf("unknown Variable field %s", name)
 }
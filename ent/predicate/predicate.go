@@ -6,8 +6,26 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AllowedProject is the predicate function for allowedproject builders.
+type AllowedProject func(*sql.Selector)
+
 // Environment is the predicate function for environment builders.
 type Environment func(*sql.Selector)
 
+// EnvironmentSchema is the predicate function for environmentschema builders.
+type EnvironmentSchema func(*sql.Selector)
+
+// MergeState is the predicate function for mergestate builders.
+type MergeState func(*sql.Selector)
+
+// RequiredVariable is the predicate function for requiredvariable builders.
+type RequiredVariable func(*sql.Selector)
+
+// SchemaKey is the predicate function for schemakey builders.
+type SchemaKey func(*sql.Selector)
+
+// SyncState is the predicate function for syncstate builders.
+type SyncState func(*sql.Selector)
+
 // Variable is the predicate function for variable builders.
 type Variable func(*sql.Selector)
@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/requiredvariable"
+)
+
+// RequiredVariable is the model entity for the RequiredVariable schema.
+type RequiredVariable struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID int `json:"environment_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RequiredVariableQuery when eager-loading is set.
+	Edges        RequiredVariableEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RequiredVariableEdges holds the relations/edges for other nodes in the graph.
+type RequiredVariableEdges struct {
+	// Environment holds the value of the environment edge.
+	Environment *Environment `json:"environment,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// EnvironmentOrErr returns the Environment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RequiredVariableEdges) EnvironmentOrErr() (*Environment, error) {
+	if e.Environment != nil {
+		return e.Environment, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: environment.Label}
+	}
+	return nil, &NotLoadedError{edge: "environment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RequiredVariable) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case requiredvariable.FieldID, requiredvariable.FieldEnvironmentID:
+			values[i] = new(sql.NullInt64)
+		case requiredvariable.FieldName, requiredvariable.FieldDescription:
+			values[i] = new(sql.NullString)
+		case requiredvariable.FieldCreatedAt, requiredvariable.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RequiredVariable fields.
+func (rv *RequiredVariable) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case requiredvariable.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rv.ID = int(value.Int64)
+		case requiredvariable.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rv.CreatedAt = value.Time
+			}
+		case requiredvariable.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rv.UpdatedAt = value.Time
+			}
+		case requiredvariable.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				rv.EnvironmentID = int(value.Int64)
+			}
+		case requiredvariable.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				rv.Name = value.String
+			}
+		case requiredvariable.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				rv.Description = value.String
+			}
+		default:
+			rv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RequiredVariable.
+// This includes values selected through modifiers, order, etc.
+func (rv *RequiredVariable) Value(name string) (ent.Value, error) {
+	return rv.selectValues.Get(name)
+}
+
+// QueryEnvironment queries the "environment" edge of the RequiredVariable entity.
+func (rv *RequiredVariable) QueryEnvironment() *EnvironmentQuery {
+	return NewRequiredVariableClient(rv.config).QueryEnvironment(rv)
+}
+
+// Update returns a builder for updating this RequiredVariable.
+// Note that you need to call RequiredVariable.Unwrap() before calling this method if this RequiredVariable
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rv *RequiredVariable) Update() *RequiredVariableUpdateOne {
+	return NewRequiredVariableClient(rv.config).UpdateOne(rv)
+}
+
+// Unwrap unwraps the RequiredVariable entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rv *RequiredVariable) Unwrap() *RequiredVariable {
+	_tx, ok := rv.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RequiredVariable is not a transactional entity")
+	}
+	rv.config.driver = _tx.drv
+	return rv
+}
+
+// String implements the fmt.Stringer.
+func (rv *RequiredVariable) String() string {
+	var builder strings.Builder
+	builder.WriteString("RequiredVariable(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rv.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(rv.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rv.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(fmt.Sprintf("%v", rv.EnvironmentID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(rv.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(rv.Description)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RequiredVariables is a parsable slice of RequiredVariable.
+type RequiredVariables []*RequiredVariable
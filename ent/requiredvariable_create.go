@@ -0,0 +1,735 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/requiredvariable"
+)
+
+// RequiredVariableCreate is the builder for creating a RequiredVariable entity.
+type RequiredVariableCreate struct {
+	config
+	mutation *RequiredVariableMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rvc *RequiredVariableCreate) SetCreatedAt(t time.Time) *RequiredVariableCreate {
+	rvc.mutation.SetCreatedAt(t)
+	return rvc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rvc *RequiredVariableCreate) SetNillableCreatedAt(t *time.Time) *RequiredVariableCreate {
+	if t != nil {
+		rvc.SetCreatedAt(*t)
+	}
+	return rvc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rvc *RequiredVariableCreate) SetUpdatedAt(t time.Time) *RequiredVariableCreate {
+	rvc.mutation.SetUpdatedAt(t)
+	return rvc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rvc *RequiredVariableCreate) SetNillableUpdatedAt(t *time.Time) *RequiredVariableCreate {
+	if t != nil {
+		rvc.SetUpdatedAt(*t)
+	}
+	return rvc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (rvc *RequiredVariableCreate) SetEnvironmentID(i int) *RequiredVariableCreate {
+	rvc.mutation.SetEnvironmentID(i)
+	return rvc
+}
+
+// SetName sets the "name" field.
+func (rvc *RequiredVariableCreate) SetName(s string) *RequiredVariableCreate {
+	rvc.mutation.SetName(s)
+	return rvc
+}
+
+// SetDescription sets the "description" field.
+func (rvc *RequiredVariableCreate) SetDescription(s string) *RequiredVariableCreate {
+	rvc.mutation.SetDescription(s)
+	return rvc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (rvc *RequiredVariableCreate) SetNillableDescription(s *string) *RequiredVariableCreate {
+	if s != nil {
+		rvc.SetDescription(*s)
+	}
+	return rvc
+}
+
+// SetEnvironment sets the "environment" edge to the Environment entity.
+func (rvc *RequiredVariableCreate) SetEnvironment(e *Environment) *RequiredVariableCreate {
+	return rvc.SetEnvironmentID(e.ID)
+}
+
+// Mutation returns the RequiredVariableMutation object of the builder.
+func (rvc *RequiredVariableCreate) Mutation() *RequiredVariableMutation {
+	return rvc.mutation
+}
+
+// Save creates the RequiredVariable in the database.
+func (rvc *RequiredVariableCreate) Save(ctx context.Context) (*RequiredVariable, error) {
+	rvc.defaults()
+	return withHooks(ctx, rvc.sqlSave, rvc.mutation, rvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rvc *RequiredVariableCreate) SaveX(ctx context.Context) *RequiredVariable {
+	v, err := rvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rvc *RequiredVariableCreate) Exec(ctx context.Context) error {
+	_, err := rvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rvc *RequiredVariableCreate) ExecX(ctx context.Context) {
+	if err := rvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rvc *RequiredVariableCreate) defaults() {
+	if _, ok := rvc.mutation.CreatedAt(); !ok {
+		v := requiredvariable.DefaultCreatedAt()
+		rvc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rvc.mutation.UpdatedAt(); !ok {
+		v := requiredvariable.DefaultUpdatedAt()
+		rvc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rvc *RequiredVariableCreate) check() error {
+	if _, ok := rvc.mutation.EnvironmentID(); !ok {
+		return &ValidationError{Name: "environment_id", err: errors.New(`ent: missing required field "RequiredVariable.environment_id"`)}
+	}
+	if _, ok := rvc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "RequiredVariable.name"`)}
+	}
+	if v, ok := rvc.mutation.Name(); ok {
+		if err := requiredvariable.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RequiredVariable.name": %w`, err)}
+		}
+	}
+	if len(rvc.mutation.EnvironmentIDs()) == 0 {
+		return &ValidationError{Name: "environment", err: errors.New(`ent: missing required edge "RequiredVariable.environment"`)}
+	}
+	return nil
+}
+
+func (rvc *RequiredVariableCreate) sqlSave(ctx context.Context) (*RequiredVariable, error) {
+	if err := rvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rvc.mutation.id = &_node.ID
+	rvc.mutation.done = true
+	return _node, nil
+}
+
+func (rvc *RequiredVariableCreate) createSpec() (*RequiredVariable, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RequiredVariable{config: rvc.config}
+		_spec = sqlgraph.NewCreateSpec(requiredvariable.Table, sqlgraph.NewFieldSpec(requiredvariable.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = rvc.conflict
+	if value, ok := rvc.mutation.CreatedAt(); ok {
+		_spec.SetField(requiredvariable.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rvc.mutation.UpdatedAt(); ok {
+		_spec.SetField(requiredvariable.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rvc.mutation.Name(); ok {
+		_spec.SetField(requiredvariable.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := rvc.mutation.Description(); ok {
+		_spec.SetField(requiredvariable.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if nodes := rvc.mutation.EnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   requiredvariable.EnvironmentTable,
+			Columns: []string{requiredvariable.EnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EnvironmentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RequiredVariable.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RequiredVariableUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rvc *RequiredVariableCreate) OnConflict(opts ...sql.ConflictOption) *RequiredVariableUpsertOne {
+	rvc.conflict = opts
+	return &RequiredVariableUpsertOne{
+		create: rvc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RequiredVariable.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rvc *RequiredVariableCreate) OnConflictColumns(columns ...string) *RequiredVariableUpsertOne {
+	rvc.conflict = append(rvc.conflict, sql.ConflictColumns(columns...))
+	return &RequiredVariableUpsertOne{
+		create: rvc,
+	}
+}
+
+type (
+	// RequiredVariableUpsertOne is the builder for "upsert"-ing
+	//  one RequiredVariable node.
+	RequiredVariableUpsertOne struct {
+		create *RequiredVariableCreate
+	}
+
+	// RequiredVariableUpsert is the "OnConflict" setter.
+	RequiredVariableUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RequiredVariableUpsert) SetUpdatedAt(v time.Time) *RequiredVariableUpsert {
+	u.Set(requiredvariable.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RequiredVariableUpsert) UpdateUpdatedAt() *RequiredVariableUpsert {
+	u.SetExcluded(requiredvariable.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *RequiredVariableUpsert) ClearUpdatedAt() *RequiredVariableUpsert {
+	u.SetNull(requiredvariable.FieldUpdatedAt)
+	return u
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (u *RequiredVariableUpsert) SetEnvironmentID(v int) *RequiredVariableUpsert {
+	u.Set(requiredvariable.FieldEnvironmentID, v)
+	return u
+}
+
+// UpdateEnvironmentID sets the "environment_id" field to the value that was provided on create.
+func (u *RequiredVariableUpsert) UpdateEnvironmentID() *RequiredVariableUpsert {
+	u.SetExcluded(requiredvariable.FieldEnvironmentID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *RequiredVariableUpsert) SetName(v string) *RequiredVariableUpsert {
+	u.Set(requiredvariable.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RequiredVariableUpsert) UpdateName() *RequiredVariableUpsert {
+	u.SetExcluded(requiredvariable.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *RequiredVariableUpsert) SetDescription(v string) *RequiredVariableUpsert {
+	u.Set(requiredvariable.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RequiredVariableUpsert) UpdateDescription() *RequiredVariableUpsert {
+	u.SetExcluded(requiredvariable.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RequiredVariableUpsert) ClearDescription() *RequiredVariableUpsert {
+	u.SetNull(requiredvariable.FieldDescription)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.RequiredVariable.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RequiredVariableUpsertOne) UpdateNewValues() *RequiredVariableUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(requiredvariable.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RequiredVariable.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RequiredVariableUpsertOne) Ignore() *RequiredVariableUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RequiredVariableUpsertOne) DoNothing() *RequiredVariableUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RequiredVariableCreate.OnConflict
+// documentation for more info.
+func (u *RequiredVariableUpsertOne) Update(set func(*RequiredVariableUpsert)) *RequiredVariableUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RequiredVariableUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RequiredVariableUpsertOne) SetUpdatedAt(v time.Time) *RequiredVariableUpsertOne {
+	return u.Update(func(s *RequiredVariableUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RequiredVariableUpsertOne) UpdateUpdatedAt() *RequiredVariableUpsertOne {
+	return u.Update(func(s *RequiredVariableUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *RequiredVariableUpsertOne) ClearUpdatedAt() *RequiredVariableUpsertOne {
+	return u.Update(func(s *RequiredVariableUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (u *RequiredVariableUpsertOne) SetEnvironmentID(v int) *RequiredVariableUpsertOne {
+	return u.Update(func(s *RequiredVariableUpsert) {
+		s.SetEnvironmentID(v)
+	})
+}
+
+// UpdateEnvironmentID sets the "environment_id" field to the value that was provided on create.
+func (u *RequiredVariableUpsertOne) UpdateEnvironmentID() *RequiredVariableUpsertOne {
+	return u.Update(func(s *RequiredVariableUpsert) {
+		s.UpdateEnvironmentID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *RequiredVariableUpsertOne) SetName(v string) *RequiredVariableUpsertOne {
+	return u.Update(func(s *RequiredVariableUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RequiredVariableUpsertOne) UpdateName() *RequiredVariableUpsertOne {
+	return u.Update(func(s *RequiredVariableUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *RequiredVariableUpsertOne) SetDescription(v string) *RequiredVariableUpsertOne {
+	return u.Update(func(s *RequiredVariableUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RequiredVariableUpsertOne) UpdateDescription() *RequiredVariableUpsertOne {
+	return u.Update(func(s *RequiredVariableUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RequiredVariableUpsertOne) ClearDescription() *RequiredVariableUpsertOne {
+	return u.Update(func(s *RequiredVariableUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *RequiredVariableUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RequiredVariableCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RequiredVariableUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RequiredVariableUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RequiredVariableUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RequiredVariableCreateBulk is the builder for creating many RequiredVariable entities in bulk.
+type RequiredVariableCreateBulk struct {
+	config
+	err      error
+	builders []*RequiredVariableCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RequiredVariable entities in the database.
+func (rvcb *RequiredVariableCreateBulk) Save(ctx context.Context) ([]*RequiredVariable, error) {
+	if rvcb.err != nil {
+		return nil, rvcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rvcb.builders))
+	nodes := make([]*RequiredVariable, len(rvcb.builders))
+	mutators := make([]Mutator, len(rvcb.builders))
+	for i := range rvcb.builders {
+		func(i int, root context.Context) {
+			builder := rvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RequiredVariableMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rvcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rvcb *RequiredVariableCreateBulk) SaveX(ctx context.Context) []*RequiredVariable {
+	v, err := rvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rvcb *RequiredVariableCreateBulk) Exec(ctx context.Context) error {
+	_, err := rvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rvcb *RequiredVariableCreateBulk) ExecX(ctx context.Context) {
+	if err := rvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RequiredVariable.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RequiredVariableUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rvcb *RequiredVariableCreateBulk) OnConflict(opts ...sql.ConflictOption) *RequiredVariableUpsertBulk {
+	rvcb.conflict = opts
+	return &RequiredVariableUpsertBulk{
+		create: rvcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RequiredVariable.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rvcb *RequiredVariableCreateBulk) OnConflictColumns(columns ...string) *RequiredVariableUpsertBulk {
+	rvcb.conflict = append(rvcb.conflict, sql.ConflictColumns(columns...))
+	return &RequiredVariableUpsertBulk{
+		create: rvcb,
+	}
+}
+
+// RequiredVariableUpsertBulk is the builder for "upsert"-ing
+// a bulk of RequiredVariable nodes.
+type RequiredVariableUpsertBulk struct {
+	create *RequiredVariableCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RequiredVariable.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RequiredVariableUpsertBulk) UpdateNewValues() *RequiredVariableUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(requiredvariable.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RequiredVariable.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RequiredVariableUpsertBulk) Ignore() *RequiredVariableUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RequiredVariableUpsertBulk) DoNothing() *RequiredVariableUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RequiredVariableCreateBulk.OnConflict
+// documentation for more info.
+func (u *RequiredVariableUpsertBulk) Update(set func(*RequiredVariableUpsert)) *RequiredVariableUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RequiredVariableUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RequiredVariableUpsertBulk) SetUpdatedAt(v time.Time) *RequiredVariableUpsertBulk {
+	return u.Update(func(s *RequiredVariableUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RequiredVariableUpsertBulk) UpdateUpdatedAt() *RequiredVariableUpsertBulk {
+	return u.Update(func(s *RequiredVariableUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *RequiredVariableUpsertBulk) ClearUpdatedAt() *RequiredVariableUpsertBulk {
+	return u.Update(func(s *RequiredVariableUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (u *RequiredVariableUpsertBulk) SetEnvironmentID(v int) *RequiredVariableUpsertBulk {
+	return u.Update(func(s *RequiredVariableUpsert) {
+		s.SetEnvironmentID(v)
+	})
+}
+
+// UpdateEnvironmentID sets the "environment_id" field to the value that was provided on create.
+func (u *RequiredVariableUpsertBulk) UpdateEnvironmentID() *RequiredVariableUpsertBulk {
+	return u.Update(func(s *RequiredVariableUpsert) {
+		s.UpdateEnvironmentID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *RequiredVariableUpsertBulk) SetName(v string) *RequiredVariableUpsertBulk {
+	return u.Update(func(s *RequiredVariableUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RequiredVariableUpsertBulk) UpdateName() *RequiredVariableUpsertBulk {
+	return u.Update(func(s *RequiredVariableUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *RequiredVariableUpsertBulk) SetDescription(v string) *RequiredVariableUpsertBulk {
+	return u.Update(func(s *RequiredVariableUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RequiredVariableUpsertBulk) UpdateDescription() *RequiredVariableUpsertBulk {
+	return u.Update(func(s *RequiredVariableUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RequiredVariableUpsertBulk) ClearDescription() *RequiredVariableUpsertBulk {
+	return u.Update(func(s *RequiredVariableUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *RequiredVariableUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RequiredVariableCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RequiredVariableCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RequiredVariableUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/envoke/ent/predicate"
+	"github.com/kechako/envoke/ent/requiredvariable"
+)
+
+// RequiredVariableDelete is the builder for deleting a RequiredVariable entity.
+type RequiredVariableDelete struct {
+	config
+	hooks    []Hook
+	mutation *RequiredVariableMutation
+}
+
+// Where appends a list predicates to the RequiredVariableDelete builder.
+func (rvd *RequiredVariableDelete) Where(ps ...predicate.RequiredVariable) *RequiredVariableDelete {
+	rvd.mutation.Where(ps...)
+	return rvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rvd *RequiredVariableDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rvd.sqlExec, rvd.mutation, rvd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rvd *RequiredVariableDelete) ExecX(ctx context.Context) int {
+	n, err := rvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rvd *RequiredVariableDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(requiredvariable.Table, sqlgraph.NewFieldSpec(requiredvariable.FieldID, field.TypeInt))
+	if ps := rvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rvd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rvd.mutation.done = true
+	return affected, err
+}
+
+// RequiredVariableDeleteOne is the builder for deleting a single RequiredVariable entity.
+type RequiredVariableDeleteOne struct {
+	rvd *RequiredVariableDelete
+}
+
+// Where appends a list predicates to the RequiredVariableDelete builder.
+func (rvdo *RequiredVariableDeleteOne) Where(ps ...predicate.RequiredVariable) *RequiredVariableDeleteOne {
+	rvdo.rvd.mutation.Where(ps...)
+	return rvdo
+}
+
+// Exec executes the deletion query.
+func (rvdo *RequiredVariableDeleteOne) Exec(ctx context.Context) error {
+	n, err := rvdo.rvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{requiredvariable.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rvdo *RequiredVariableDeleteOne) ExecX(ctx context.Context) {
+	if err := rvdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
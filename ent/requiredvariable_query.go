@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/predicate"
+	"github.com/kechako/envoke/ent/requiredvariable"
+)
+
+// RequiredVariableQuery is the builder for querying RequiredVariable entities.
+type RequiredVariableQuery struct {
+	config
+	ctx             *QueryContext
+	order           []requiredvariable.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.RequiredVariable
+	withEnvironment *EnvironmentQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RequiredVariableQuery builder.
+func (rvq *RequiredVariableQuery) Where(ps ...predicate.RequiredVariable) *RequiredVariableQuery {
+	rvq.predicates = append(rvq.predicates, ps...)
+	return rvq
+}
+
+// Limit the number of records to be returned by this query.
+func (rvq *RequiredVariableQuery) Limit(limit int) *RequiredVariableQuery {
+	rvq.ctx.Limit = &limit
+	return rvq
+}
+
+// Offset to start from.
+func (rvq *RequiredVariableQuery) Offset(offset int) *RequiredVariableQuery {
+	rvq.ctx.Offset = &offset
+	return rvq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rvq *RequiredVariableQuery) Unique(unique bool) *RequiredVariableQuery {
+	rvq.ctx.Unique = &unique
+	return rvq
+}
+
+// Order specifies how the records should be ordered.
+func (rvq *RequiredVariableQuery) Order(o ...requiredvariable.OrderOption) *RequiredVariableQuery {
+	rvq.order = append(rvq.order, o...)
+	return rvq
+}
+
+// QueryEnvironment chains the current query on the "environment" edge.
+func (rvq *RequiredVariableQuery) QueryEnvironment() *EnvironmentQuery {
+	query := (&EnvironmentClient{config: rvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(requiredvariable.Table, requiredvariable.FieldID, selector),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, requiredvariable.EnvironmentTable, requiredvariable.EnvironmentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RequiredVariable entity from the query.
+// Returns a *NotFoundError when no RequiredVariable was found.
+func (rvq *RequiredVariableQuery) First(ctx context.Context) (*RequiredVariable, error) {
+	nodes, err := rvq.Limit(1).All(setContextOp(ctx, rvq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{requiredvariable.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rvq *RequiredVariableQuery) FirstX(ctx context.Context) *RequiredVariable {
+	node, err := rvq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RequiredVariable ID from the query.
+// Returns a *NotFoundError when no RequiredVariable ID was found.
+func (rvq *RequiredVariableQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rvq.Limit(1).IDs(setContextOp(ctx, rvq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{requiredvariable.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rvq *RequiredVariableQuery) FirstIDX(ctx context.Context) int {
+	id, err := rvq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RequiredVariable entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RequiredVariable entity is found.
+// Returns a *NotFoundError when no RequiredVariable entities are found.
+func (rvq *RequiredVariableQuery) Only(ctx context.Context) (*RequiredVariable, error) {
+	nodes, err := rvq.Limit(2).All(setContextOp(ctx, rvq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{requiredvariable.Label}
+	default:
+		return nil, &NotSingularError{requiredvariable.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rvq *RequiredVariableQuery) OnlyX(ctx context.Context) *RequiredVariable {
+	node, err := rvq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RequiredVariable ID in the query.
+// Returns a *NotSingularError when more than one RequiredVariable ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rvq *RequiredVariableQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rvq.Limit(2).IDs(setContextOp(ctx, rvq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{requiredvariable.Label}
+	default:
+		err = &NotSingularError{requiredvariable.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rvq *RequiredVariableQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rvq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RequiredVariables.
+func (rvq *RequiredVariableQuery) All(ctx context.Context) ([]*RequiredVariable, error) {
+	ctx = setContextOp(ctx, rvq.ctx, ent.OpQueryAll)
+	if err := rvq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RequiredVariable, *RequiredVariableQuery]()
+	return withInterceptors[[]*RequiredVariable](ctx, rvq, qr, rvq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rvq *RequiredVariableQuery) AllX(ctx context.Context) []*RequiredVariable {
+	nodes, err := rvq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RequiredVariable IDs.
+func (rvq *RequiredVariableQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if rvq.ctx.Unique == nil && rvq.path != nil {
+		rvq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rvq.ctx, ent.OpQueryIDs)
+	if err = rvq.Select(requiredvariable.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rvq *RequiredVariableQuery) IDsX(ctx context.Context) []int {
+	ids, err := rvq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rvq *RequiredVariableQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rvq.ctx, ent.OpQueryCount)
+	if err := rvq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rvq, querierCount[*RequiredVariableQuery](), rvq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rvq *RequiredVariableQuery) CountX(ctx context.Context) int {
+	count, err := rvq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rvq *RequiredVariableQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rvq.ctx, ent.OpQueryExist)
+	switch _, err := rvq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rvq *RequiredVariableQuery) ExistX(ctx context.Context) bool {
+	exist, err := rvq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RequiredVariableQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rvq *RequiredVariableQuery) Clone() *RequiredVariableQuery {
+	if rvq == nil {
+		return nil
+	}
+	return &RequiredVariableQuery{
+		config:          rvq.config,
+		ctx:             rvq.ctx.Clone(),
+		order:           append([]requiredvariable.OrderOption{}, rvq.order...),
+		inters:          append([]Interceptor{}, rvq.inters...),
+		predicates:      append([]predicate.RequiredVariable{}, rvq.predicates...),
+		withEnvironment: rvq.withEnvironment.Clone(),
+		// clone intermediate query.
+		sql:  rvq.sql.Clone(),
+		path: rvq.path,
+	}
+}
+
+// WithEnvironment tells the query-builder to eager-load the nodes that are connected to
+// the "environment" edge. The optional arguments are used to configure the query builder of the edge.
+func (rvq *RequiredVariableQuery) WithEnvironment(opts ...func(*EnvironmentQuery)) *RequiredVariableQuery {
+	query := (&EnvironmentClient{config: rvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rvq.withEnvironment = query
+	return rvq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RequiredVariable.Query().
+//		GroupBy(requiredvariable.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rvq *RequiredVariableQuery) GroupBy(field string, fields ...string) *RequiredVariableGroupBy {
+	rvq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RequiredVariableGroupBy{build: rvq}
+	grbuild.flds = &rvq.ctx.Fields
+	grbuild.label = requiredvariable.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.RequiredVariable.Query().
+//		Select(requiredvariable.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (rvq *RequiredVariableQuery) Select(fields ...string) *RequiredVariableSelect {
+	rvq.ctx.Fields = append(rvq.ctx.Fields, fields...)
+	sbuild := &RequiredVariableSelect{RequiredVariableQuery: rvq}
+	sbuild.label = requiredvariable.Label
+	sbuild.flds, sbuild.scan = &rvq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RequiredVariableSelect configured with the given aggregations.
+func (rvq *RequiredVariableQuery) Aggregate(fns ...AggregateFunc) *RequiredVariableSelect {
+	return rvq.Select().Aggregate(fns...)
+}
+
+func (rvq *RequiredVariableQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rvq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rvq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rvq.ctx.Fields {
+		if !requiredvariable.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rvq.path != nil {
+		prev, err := rvq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rvq.sql = prev
+	}
+	return nil
+}
+
+func (rvq *RequiredVariableQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RequiredVariable, error) {
+	var (
+		nodes       = []*RequiredVariable{}
+		_spec       = rvq.querySpec()
+		loadedTypes = [1]bool{
+			rvq.withEnvironment != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RequiredVariable).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RequiredVariable{config: rvq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rvq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rvq.withEnvironment; query != nil {
+		if err := rvq.loadEnvironment(ctx, query, nodes, nil,
+			func(n *RequiredVariable, e *Environment) { n.Edges.Environment = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rvq *RequiredVariableQuery) loadEnvironment(ctx context.Context, query *EnvironmentQuery, nodes []*RequiredVariable, init func(*RequiredVariable), assign func(*RequiredVariable, *Environment)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RequiredVariable)
+	for i := range nodes {
+		fk := nodes[i].EnvironmentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(environment.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "environment_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rvq *RequiredVariableQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rvq.querySpec()
+	_spec.Node.Columns = rvq.ctx.Fields
+	if len(rvq.ctx.Fields) > 0 {
+		_spec.Unique = rvq.ctx.Unique != nil && *rvq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rvq.driver, _spec)
+}
+
+func (rvq *RequiredVariableQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(requiredvariable.Table, requiredvariable.Columns, sqlgraph.NewFieldSpec(requiredvariable.FieldID, field.TypeInt))
+	_spec.From = rvq.sql
+	if unique := rvq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rvq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rvq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, requiredvariable.FieldID)
+		for i := range fields {
+			if fields[i] != requiredvariable.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if rvq.withEnvironment != nil {
+			_spec.Node.AddColumnOnce(requiredvariable.FieldEnvironmentID)
+		}
+	}
+	if ps := rvq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rvq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rvq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rvq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rvq *RequiredVariableQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rvq.driver.Dialect())
+	t1 := builder.Table(requiredvariable.Table)
+	columns := rvq.ctx.Fields
+	if len(columns) == 0 {
+		columns = requiredvariable.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rvq.sql != nil {
+		selector = rvq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rvq.ctx.Unique != nil && *rvq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rvq.predicates {
+		p(selector)
+	}
+	for _, p := range rvq.order {
+		p(selector)
+	}
+	if offset := rvq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rvq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RequiredVariableGroupBy is the group-by builder for RequiredVariable entities.
+type RequiredVariableGroupBy struct {
+	selector
+	build *RequiredVariableQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rvgb *RequiredVariableGroupBy) Aggregate(fns ...AggregateFunc) *RequiredVariableGroupBy {
+	rvgb.fns = append(rvgb.fns, fns...)
+	return rvgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rvgb *RequiredVariableGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rvgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rvgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RequiredVariableQuery, *RequiredVariableGroupBy](ctx, rvgb.build, rvgb, rvgb.build.inters, v)
+}
+
+func (rvgb *RequiredVariableGroupBy) sqlScan(ctx context.Context, root *RequiredVariableQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rvgb.fns))
+	for _, fn := range rvgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rvgb.flds)+len(rvgb.fns))
+		for _, f := range *rvgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rvgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rvgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RequiredVariableSelect is the builder for selecting fields of RequiredVariable entities.
+type RequiredVariableSelect struct {
+	*RequiredVariableQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rvs *RequiredVariableSelect) Aggregate(fns ...AggregateFunc) *RequiredVariableSelect {
+	rvs.fns = append(rvs.fns, fns...)
+	return rvs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rvs *RequiredVariableSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rvs.ctx, ent.OpQuerySelect)
+	if err := rvs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RequiredVariableQuery, *RequiredVariableSelect](ctx, rvs.RequiredVariableQuery, rvs, rvs.inters, v)
+}
+
+func (rvs *RequiredVariableSelect) sqlScan(ctx context.Context, root *RequiredVariableQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rvs.fns))
+	for _, fn := range rvs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rvs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rvs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
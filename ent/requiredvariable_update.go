@@ -0,0 +1,463 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/predicate"
+	"github.com/kechako/envoke/ent/requiredvariable"
+)
+
+// RequiredVariableUpdate is the builder for updating RequiredVariable entities.
+type RequiredVariableUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RequiredVariableMutation
+}
+
+// Where appends a list predicates to the RequiredVariableUpdate builder.
+func (rvu *RequiredVariableUpdate) Where(ps ...predicate.RequiredVariable) *RequiredVariableUpdate {
+	rvu.mutation.Where(ps...)
+	return rvu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rvu *RequiredVariableUpdate) SetUpdatedAt(t time.Time) *RequiredVariableUpdate {
+	rvu.mutation.SetUpdatedAt(t)
+	return rvu
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (rvu *RequiredVariableUpdate) ClearUpdatedAt() *RequiredVariableUpdate {
+	rvu.mutation.ClearUpdatedAt()
+	return rvu
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (rvu *RequiredVariableUpdate) SetEnvironmentID(i int) *RequiredVariableUpdate {
+	rvu.mutation.SetEnvironmentID(i)
+	return rvu
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (rvu *RequiredVariableUpdate) SetNillableEnvironmentID(i *int) *RequiredVariableUpdate {
+	if i != nil {
+		rvu.SetEnvironmentID(*i)
+	}
+	return rvu
+}
+
+// SetName sets the "name" field.
+func (rvu *RequiredVariableUpdate) SetName(s string) *RequiredVariableUpdate {
+	rvu.mutation.SetName(s)
+	return rvu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (rvu *RequiredVariableUpdate) SetNillableName(s *string) *RequiredVariableUpdate {
+	if s != nil {
+		rvu.SetName(*s)
+	}
+	return rvu
+}
+
+// SetDescription sets the "description" field.
+func (rvu *RequiredVariableUpdate) SetDescription(s string) *RequiredVariableUpdate {
+	rvu.mutation.SetDescription(s)
+	return rvu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (rvu *RequiredVariableUpdate) SetNillableDescription(s *string) *RequiredVariableUpdate {
+	if s != nil {
+		rvu.SetDescription(*s)
+	}
+	return rvu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (rvu *RequiredVariableUpdate) ClearDescription() *RequiredVariableUpdate {
+	rvu.mutation.ClearDescription()
+	return rvu
+}
+
+// SetEnvironment sets the "environment" edge to the Environment entity.
+func (rvu *RequiredVariableUpdate) SetEnvironment(e *Environment) *RequiredVariableUpdate {
+	return rvu.SetEnvironmentID(e.ID)
+}
+
+// Mutation returns the RequiredVariableMutation object of the builder.
+func (rvu *RequiredVariableUpdate) Mutation() *RequiredVariableMutation {
+	return rvu.mutation
+}
+
+// ClearEnvironment clears the "environment" edge to the Environment entity.
+func (rvu *RequiredVariableUpdate) ClearEnvironment() *RequiredVariableUpdate {
+	rvu.mutation.ClearEnvironment()
+	return rvu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rvu *RequiredVariableUpdate) Save(ctx context.Context) (int, error) {
+	rvu.defaults()
+	return withHooks(ctx, rvu.sqlSave, rvu.mutation, rvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rvu *RequiredVariableUpdate) SaveX(ctx context.Context) int {
+	affected, err := rvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rvu *RequiredVariableUpdate) Exec(ctx context.Context) error {
+	_, err := rvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rvu *RequiredVariableUpdate) ExecX(ctx context.Context) {
+	if err := rvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rvu *RequiredVariableUpdate) defaults() {
+	if _, ok := rvu.mutation.UpdatedAt(); !ok && !rvu.mutation.UpdatedAtCleared() {
+		v := requiredvariable.UpdateDefaultUpdatedAt()
+		rvu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rvu *RequiredVariableUpdate) check() error {
+	if v, ok := rvu.mutation.Name(); ok {
+		if err := requiredvariable.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RequiredVariable.name": %w`, err)}
+		}
+	}
+	if rvu.mutation.EnvironmentCleared() && len(rvu.mutation.EnvironmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RequiredVariable.environment"`)
+	}
+	return nil
+}
+
+func (rvu *RequiredVariableUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rvu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(requiredvariable.Table, requiredvariable.Columns, sqlgraph.NewFieldSpec(requiredvariable.FieldID, field.TypeInt))
+	if ps := rvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if rvu.mutation.CreatedAtCleared() {
+		_spec.ClearField(requiredvariable.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := rvu.mutation.UpdatedAt(); ok {
+		_spec.SetField(requiredvariable.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if rvu.mutation.UpdatedAtCleared() {
+		_spec.ClearField(requiredvariable.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := rvu.mutation.Name(); ok {
+		_spec.SetField(requiredvariable.FieldName, field.TypeString, value)
+	}
+	if value, ok := rvu.mutation.Description(); ok {
+		_spec.SetField(requiredvariable.FieldDescription, field.TypeString, value)
+	}
+	if rvu.mutation.DescriptionCleared() {
+		_spec.ClearField(requiredvariable.FieldDescription, field.TypeString)
+	}
+	if rvu.mutation.EnvironmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   requiredvariable.EnvironmentTable,
+			Columns: []string{requiredvariable.EnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rvu.mutation.EnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   requiredvariable.EnvironmentTable,
+			Columns: []string{requiredvariable.EnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{requiredvariable.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rvu.mutation.done = true
+	return n, nil
+}
+
+// RequiredVariableUpdateOne is the builder for updating a single RequiredVariable entity.
+type RequiredVariableUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RequiredVariableMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rvuo *RequiredVariableUpdateOne) SetUpdatedAt(t time.Time) *RequiredVariableUpdateOne {
+	rvuo.mutation.SetUpdatedAt(t)
+	return rvuo
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (rvuo *RequiredVariableUpdateOne) ClearUpdatedAt() *RequiredVariableUpdateOne {
+	rvuo.mutation.ClearUpdatedAt()
+	return rvuo
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (rvuo *RequiredVariableUpdateOne) SetEnvironmentID(i int) *RequiredVariableUpdateOne {
+	rvuo.mutation.SetEnvironmentID(i)
+	return rvuo
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (rvuo *RequiredVariableUpdateOne) SetNillableEnvironmentID(i *int) *RequiredVariableUpdateOne {
+	if i != nil {
+		rvuo.SetEnvironmentID(*i)
+	}
+	return rvuo
+}
+
+// SetName sets the "name" field.
+func (rvuo *RequiredVariableUpdateOne) SetName(s string) *RequiredVariableUpdateOne {
+	rvuo.mutation.SetName(s)
+	return rvuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (rvuo *RequiredVariableUpdateOne) SetNillableName(s *string) *RequiredVariableUpdateOne {
+	if s != nil {
+		rvuo.SetName(*s)
+	}
+	return rvuo
+}
+
+// SetDescription sets the "description" field.
+func (rvuo *RequiredVariableUpdateOne) SetDescription(s string) *RequiredVariableUpdateOne {
+	rvuo.mutation.SetDescription(s)
+	return rvuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (rvuo *RequiredVariableUpdateOne) SetNillableDescription(s *string) *RequiredVariableUpdateOne {
+	if s != nil {
+		rvuo.SetDescription(*s)
+	}
+	return rvuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (rvuo *RequiredVariableUpdateOne) ClearDescription() *RequiredVariableUpdateOne {
+	rvuo.mutation.ClearDescription()
+	return rvuo
+}
+
+// SetEnvironment sets the "environment" edge to the Environment entity.
+func (rvuo *RequiredVariableUpdateOne) SetEnvironment(e *Environment) *RequiredVariableUpdateOne {
+	return rvuo.SetEnvironmentID(e.ID)
+}
+
+// Mutation returns the RequiredVariableMutation object of the builder.
+func (rvuo *RequiredVariableUpdateOne) Mutation() *RequiredVariableMutation {
+	return rvuo.mutation
+}
+
+// ClearEnvironment clears the "environment" edge to the Environment entity.
+func (rvuo *RequiredVariableUpdateOne) ClearEnvironment() *RequiredVariableUpdateOne {
+	rvuo.mutation.ClearEnvironment()
+	return rvuo
+}
+
+// Where appends a list predicates to the RequiredVariableUpdate builder.
+func (rvuo *RequiredVariableUpdateOne) Where(ps ...predicate.RequiredVariable) *RequiredVariableUpdateOne {
+	rvuo.mutation.Where(ps...)
+	return rvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rvuo *RequiredVariableUpdateOne) Select(field string, fields ...string) *RequiredVariableUpdateOne {
+	rvuo.fields = append([]string{field}, fields...)
+	return rvuo
+}
+
+// Save executes the query and returns the updated RequiredVariable entity.
+func (rvuo *RequiredVariableUpdateOne) Save(ctx context.Context) (*RequiredVariable, error) {
+	rvuo.defaults()
+	return withHooks(ctx, rvuo.sqlSave, rvuo.mutation, rvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rvuo *RequiredVariableUpdateOne) SaveX(ctx context.Context) *RequiredVariable {
+	node, err := rvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rvuo *RequiredVariableUpdateOne) Exec(ctx context.Context) error {
+	_, err := rvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rvuo *RequiredVariableUpdateOne) ExecX(ctx context.Context) {
+	if err := rvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rvuo *RequiredVariableUpdateOne) defaults() {
+	if _, ok := rvuo.mutation.UpdatedAt(); !ok && !rvuo.mutation.UpdatedAtCleared() {
+		v := requiredvariable.UpdateDefaultUpdatedAt()
+		rvuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rvuo *RequiredVariableUpdateOne) check() error {
+	if v, ok := rvuo.mutation.Name(); ok {
+		if err := requiredvariable.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RequiredVariable.name": %w`, err)}
+		}
+	}
+	if rvuo.mutation.EnvironmentCleared() && len(rvuo.mutation.EnvironmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RequiredVariable.environment"`)
+	}
+	return nil
+}
+
+func (rvuo *RequiredVariableUpdateOne) sqlSave(ctx context.Context) (_node *RequiredVariable, err error) {
+	if err := rvuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(requiredvariable.Table, requiredvariable.Columns, sqlgraph.NewFieldSpec(requiredvariable.FieldID, field.TypeInt))
+	id, ok := rvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RequiredVariable.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, requiredvariable.FieldID)
+		for _, f := range fields {
+			if !requiredvariable.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != requiredvariable.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if rvuo.mutation.CreatedAtCleared() {
+		_spec.ClearField(requiredvariable.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := rvuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(requiredvariable.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if rvuo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(requiredvariable.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := rvuo.mutation.Name(); ok {
+		_spec.SetField(requiredvariable.FieldName, field.TypeString, value)
+	}
+	if value, ok := rvuo.mutation.Description(); ok {
+		_spec.SetField(requiredvariable.FieldDescription, field.TypeString, value)
+	}
+	if rvuo.mutation.DescriptionCleared() {
+		_spec.ClearField(requiredvariable.FieldDescription, field.TypeString)
+	}
+	if rvuo.mutation.EnvironmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   requiredvariable.EnvironmentTable,
+			Columns: []string{requiredvariable.EnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rvuo.mutation.EnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   requiredvariable.EnvironmentTable,
+			Columns: []string{requiredvariable.EnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RequiredVariable{config: rvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{requiredvariable.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rvuo.mutation.done = true
+	return _node, nil
+}
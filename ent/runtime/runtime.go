@@ -2,7 +2,218 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/kechako/envoke/ent/runtime.go
+import (
+	"time"
+
+	"github.com/kechako/envoke/ent/allowedproject"
+	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/environmentschema"
+	"github.com/kechako/envoke/ent/mergestate"
+	"github.com/kechako/envoke/ent/requiredvariable"
+	"github.com/kechako/envoke/ent/schema"
+	"github.com/kechako/envoke/ent/schemakey"
+	"github.com/kechako/envoke/ent/syncstate"
+	"github.com/kechako/envoke/ent/variable"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	allowedprojectFields := schema.AllowedProject{}.Fields()
+	_ = allowedprojectFields
+	// allowedprojectDescPath is the schema descriptor for path field.
+	allowedprojectDescPath := allowedprojectFields[0].Descriptor()
+	// allowedproject.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	allowedproject.PathValidator = allowedprojectDescPath.Validators[0].(func(string) error)
+	// allowedprojectDescHash is the schema descriptor for hash field.
+	allowedprojectDescHash := allowedprojectFields[1].Descriptor()
+	// allowedproject.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	allowedproject.HashValidator = allowedprojectDescHash.Validators[0].(func(string) error)
+	// allowedprojectDescAllowedAt is the schema descriptor for allowed_at field.
+	allowedprojectDescAllowedAt := allowedprojectFields[2].Descriptor()
+	// allowedproject.DefaultAllowedAt holds the default value on creation for the allowed_at field.
+	allowedproject.DefaultAllowedAt = allowedprojectDescAllowedAt.Default.(func() time.Time)
+	// allowedproject.UpdateDefaultAllowedAt holds the default value on update for the allowed_at field.
+	allowedproject.UpdateDefaultAllowedAt = allowedprojectDescAllowedAt.UpdateDefault.(func() time.Time)
+	environmentMixin := schema.Environment{}.Mixin()
+	environmentMixinFields0 := environmentMixin[0].Fields()
+	_ = environmentMixinFields0
+	environmentFields := schema.Environment{}.Fields()
+	_ = environmentFields
+	// environmentDescCreatedAt is the schema descriptor for created_at field.
+	environmentDescCreatedAt := environmentMixinFields0[0].Descriptor()
+	// environment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	environment.DefaultCreatedAt = environmentDescCreatedAt.Default.(func() time.Time)
+	// environmentDescUpdatedAt is the schema descriptor for updated_at field.
+	environmentDescUpdatedAt := environmentMixinFields0[1].Descriptor()
+	// environment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	environment.DefaultUpdatedAt = environmentDescUpdatedAt.Default.(func() time.Time)
+	// environment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	environment.UpdateDefaultUpdatedAt = environmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// environmentDescName is the schema descriptor for name field.
+	environmentDescName := environmentFields[0].Descriptor()
+	// environment.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	environment.NameValidator = environmentDescName.Validators[0].(func(string) error)
+	environmentschemaMixin := schema.EnvironmentSchema{}.Mixin()
+	environmentschemaMixinFields0 := environmentschemaMixin[0].Fields()
+	_ = environmentschemaMixinFields0
+	environmentschemaFields := schema.EnvironmentSchema{}.Fields()
+	_ = environmentschemaFields
+	// environmentschemaDescCreatedAt is the schema descriptor for created_at field.
+	environmentschemaDescCreatedAt := environmentschemaMixinFields0[0].Descriptor()
+	// environmentschema.DefaultCreatedAt holds the default value on creation for the created_at field.
+	environmentschema.DefaultCreatedAt = environmentschemaDescCreatedAt.Default.(func() time.Time)
+	// environmentschemaDescUpdatedAt is the schema descriptor for updated_at field.
+	environmentschemaDescUpdatedAt := environmentschemaMixinFields0[1].Descriptor()
+	// environmentschema.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	environmentschema.DefaultUpdatedAt = environmentschemaDescUpdatedAt.Default.(func() time.Time)
+	// environmentschema.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	environmentschema.UpdateDefaultUpdatedAt = environmentschemaDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// environmentschemaDescName is the schema descriptor for name field.
+	environmentschemaDescName := environmentschemaFields[0].Descriptor()
+	// environmentschema.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	environmentschema.NameValidator = environmentschemaDescName.Validators[0].(func(string) error)
+	mergestateFields := schema.MergeState{}.Fields()
+	_ = mergestateFields
+	// mergestateDescPath is the schema descriptor for path field.
+	mergestateDescPath := mergestateFields[1].Descriptor()
+	// mergestate.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	mergestate.PathValidator = mergestateDescPath.Validators[0].(func(string) error)
+	// mergestateDescMergedAt is the schema descriptor for merged_at field.
+	mergestateDescMergedAt := mergestateFields[4].Descriptor()
+	// mergestate.DefaultMergedAt holds the default value on creation for the merged_at field.
+	mergestate.DefaultMergedAt = mergestateDescMergedAt.Default.(func() time.Time)
+	// mergestate.UpdateDefaultMergedAt holds the default value on update for the merged_at field.
+	mergestate.UpdateDefaultMergedAt = mergestateDescMergedAt.UpdateDefault.(func() time.Time)
+	requiredvariableMixin := schema.RequiredVariable{}.Mixin()
+	requiredvariableMixinFields0 := requiredvariableMixin[0].Fields()
+	_ = requiredvariableMixinFields0
+	requiredvariableFields := schema.RequiredVariable{}.Fields()
+	_ = requiredvariableFields
+	// requiredvariableDescCreatedAt is the schema descriptor for created_at field.
+	requiredvariableDescCreatedAt := requiredvariableMixinFields0[0].Descriptor()
+	// requiredvariable.DefaultCreatedAt holds the default value on creation for the created_at field.
+	requiredvariable.DefaultCreatedAt = requiredvariableDescCreatedAt.Default.(func() time.Time)
+	// requiredvariableDescUpdatedAt is the schema descriptor for updated_at field.
+	requiredvariableDescUpdatedAt := requiredvariableMixinFields0[1].Descriptor()
+	// requiredvariable.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	requiredvariable.DefaultUpdatedAt = requiredvariableDescUpdatedAt.Default.(func() time.Time)
+	// requiredvariable.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	requiredvariable.UpdateDefaultUpdatedAt = requiredvariableDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// requiredvariableDescName is the schema descriptor for name field.
+	requiredvariableDescName := requiredvariableFields[1].Descriptor()
+	// requiredvariable.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	requiredvariable.NameValidator = func() func(string) error {
+		validators := requiredvariableDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	schemakeyMixin := schema.SchemaKey{}.Mixin()
+	schemakeyHooks := schema.SchemaKey{}.Hooks()
+	schemakey.Hooks[0] = schemakeyHooks[0]
+	schemakeyMixinFields0 := schemakeyMixin[0].Fields()
+	_ = schemakeyMixinFields0
+	schemakeyFields := schema.SchemaKey{}.Fields()
+	_ = schemakeyFields
+	// schemakeyDescCreatedAt is the schema descriptor for created_at field.
+	schemakeyDescCreatedAt := schemakeyMixinFields0[0].Descriptor()
+	// schemakey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	schemakey.DefaultCreatedAt = schemakeyDescCreatedAt.Default.(func() time.Time)
+	// schemakeyDescUpdatedAt is the schema descriptor for updated_at field.
+	schemakeyDescUpdatedAt := schemakeyMixinFields0[1].Descriptor()
+	// schemakey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	schemakey.DefaultUpdatedAt = schemakeyDescUpdatedAt.Default.(func() time.Time)
+	// schemakey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	schemakey.UpdateDefaultUpdatedAt = schemakeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// schemakeyDescName is the schema descriptor for name field.
+	schemakeyDescName := schemakeyFields[1].Descriptor()
+	// schemakey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	schemakey.NameValidator = func() func(string) error {
+		validators := schemakeyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// schemakeyDescType is the schema descriptor for type field.
+	schemakeyDescType := schemakeyFields[6].Descriptor()
+	// schemakey.DefaultType holds the default value on creation for the type field.
+	schemakey.DefaultType = schemakeyDescType.Default.(string)
+	// schemakey.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	schemakey.TypeValidator = schemakeyDescType.Validators[0].(func(string) error)
+	syncstateFields := schema.SyncState{}.Fields()
+	_ = syncstateFields
+	// syncstateDescPath is the schema descriptor for path field.
+	syncstateDescPath := syncstateFields[1].Descriptor()
+	// syncstate.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	syncstate.PathValidator = syncstateDescPath.Validators[0].(func(string) error)
+	// syncstateDescSyncedAt is the schema descriptor for synced_at field.
+	syncstateDescSyncedAt := syncstateFields[3].Descriptor()
+	// syncstate.DefaultSyncedAt holds the default value on creation for the synced_at field.
+	syncstate.DefaultSyncedAt = syncstateDescSyncedAt.Default.(func() time.Time)
+	// syncstate.UpdateDefaultSyncedAt holds the default value on update for the synced_at field.
+	syncstate.UpdateDefaultSyncedAt = syncstateDescSyncedAt.UpdateDefault.(func() time.Time)
+	variableMixin := schema.Variable{}.Mixin()
+	variableHooks := schema.Variable{}.Hooks()
+	variable.Hooks[0] = variableHooks[0]
+	variableMixinFields0 := variableMixin[0].Fields()
+	_ = variableMixinFields0
+	variableFields := schema.Variable{}.Fields()
+	_ = variableFields
+	// variableDescCreatedAt is the schema descriptor for created_at field.
+	variableDescCreatedAt := variableMixinFields0[0].Descriptor()
+	// variable.DefaultCreatedAt holds the default value on creation for the created_at field.
+	variable.DefaultCreatedAt = variableDescCreatedAt.Default.(func() time.Time)
+	// variableDescUpdatedAt is the schema descriptor for updated_at field.
+	variableDescUpdatedAt := variableMixinFields0[1].Descriptor()
+	// variable.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	variable.DefaultUpdatedAt = variableDescUpdatedAt.Default.(func() time.Time)
+	// variable.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	variable.UpdateDefaultUpdatedAt = variableDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// variableDescName is the schema descriptor for name field.
+	variableDescName := variableFields[1].Descriptor()
+	// variable.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	variable.NameValidator = func() func(string) error {
+		validators := variableDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// variableDescType is the schema descriptor for type field.
+	variableDescType := variableFields[6].Descriptor()
+	// variable.DefaultType holds the default value on creation for the type field.
+	variable.DefaultType = variableDescType.Default.(string)
+	// variable.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	variable.TypeValidator = variableDescType.Validators[0].(func(string) error)
+}
 
 const (
 	Version = "v0.14.4"                                         // Version of ent codegen.
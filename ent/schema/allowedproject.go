@@ -0,0 +1,29 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// AllowedProject holds the schema definition for the AllowedProject entity.
+// It records a project file trusted by the shell hook, and the hash of its
+// content when it was allowed.
+type AllowedProject struct {
+	ent.Schema
+}
+
+// Fields of the AllowedProject.
+func (AllowedProject) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("path").
+			NotEmpty().
+			Unique(),
+		field.String("hash").
+			NotEmpty(),
+		field.Time("allowed_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
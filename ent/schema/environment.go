@@ -12,6 +12,13 @@ type Environment struct {
 	ent.Schema
 }
 
+// Mixin of the Environment.
+func (Environment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
 // Fields of the Environment.
 func (Environment) Fields() []ent.Field {
 	return []ent.Field{
@@ -20,6 +27,9 @@ func (Environment) Fields() []ent.Field {
 			NotEmpty(),
 		field.String("description").
 			Optional(),
+		field.Int("schema_id").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -31,5 +41,24 @@ func (Environment) Edges() []ent.Edge {
 				OnDelete: entsql.Cascade,
 			},
 		),
+		edge.To("sync_states", SyncState.Type).Annotations(
+			entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			},
+		),
+		edge.To("required_variables", RequiredVariable.Type).Annotations(
+			entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			},
+		),
+		edge.To("merge_states", MergeState.Type).Annotations(
+			entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			},
+		),
+		edge.From("schema", EnvironmentSchema.Type).
+			Ref("environments").
+			Unique().
+			Field("schema_id"),
 	}
 }
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// EnvironmentSchema holds the schema definition for the EnvironmentSchema
+// entity. It lists the keys expected in a group of environments, such as the
+// development, staging and production environments of an application.
+type EnvironmentSchema struct {
+	ent.Schema
+}
+
+// Mixin of the EnvironmentSchema.
+func (EnvironmentSchema) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the EnvironmentSchema.
+func (EnvironmentSchema) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Unique().
+			NotEmpty(),
+		field.String("description").
+			Optional(),
+	}
+}
+
+// Edges of the EnvironmentSchema.
+func (EnvironmentSchema) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("keys", SchemaKey.Type).Annotations(
+			entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			},
+		),
+		edge.To("environments", Environment.Type).Annotations(
+			entsql.Annotation{
+				OnDelete: entsql.SetNull,
+			},
+		),
+	}
+}
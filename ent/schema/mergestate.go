@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MergeState holds the schema definition for the MergeState entity.
+// It records the modification times of the variables of an environment in
+// the current database and in a merged database after the last merge, so
+// that the next merge can tell which side changed. The times of the
+// environment itself are recorded with the empty name.
+type MergeState struct {
+	ent.Schema
+}
+
+// Fields of the MergeState.
+func (MergeState) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("environment_id"),
+		field.String("path").
+			NotEmpty(),
+		field.JSON("local", map[string]time.Time{}),
+		field.JSON("remote", map[string]time.Time{}),
+		field.Time("merged_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the MergeState.
+func (MergeState) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("environment", Environment.Type).
+			Ref("merge_states").
+			Unique().
+			Required().
+			Field("environment_id"),
+	}
+}
+
+func (MergeState) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("environment_id", "path").
+			Unique(),
+	}
+}
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// TimeMixin holds the creation and last modification times of an entity.
+// The fields are optional so that rows created before they were introduced
+// can be migrated.
+type TimeMixin struct {
+	mixin.Schema
+}
+
+// Fields of the TimeMixin.
+func (TimeMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").
+			Optional().
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Optional().
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
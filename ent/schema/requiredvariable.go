@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/kechako/envoke/varname"
+)
+
+// RequiredVariable holds the schema definition for the RequiredVariable entity.
+// It declares a variable that must be defined to run a command in an environment.
+type RequiredVariable struct {
+	ent.Schema
+}
+
+// Mixin of the RequiredVariable.
+func (RequiredVariable) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the RequiredVariable.
+func (RequiredVariable) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("environment_id"),
+		field.String("name").
+			NotEmpty().
+			Validate(varname.Validate),
+		field.String("description").
+			Optional(),
+	}
+}
+
+// Edges of the RequiredVariable.
+func (RequiredVariable) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("environment", Environment.Type).
+			Ref("required_variables").
+			Unique().
+			Required().
+			Field("environment_id"),
+	}
+}
+
+func (RequiredVariable) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("environment_id", "name").
+			Unique(),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"github.com/kechako/envoke/vartype"
+)
+
+// mutationFields reads the fields of a mutation, falling back to the old
+// values of the entity being updated. The first error reading an old value is
+// kept in err.
+type mutationFields struct {
+	ctx context.Context
+	m   ent.Mutation
+	err error
+}
+
+// get returns the value of the field, or nil if it is not set.
+func (f *mutationFields) get(name string) ent.Value {
+	if v, ok := f.m.Field(name); ok {
+		return v
+	}
+	if f.m.FieldCleared(name) || !f.m.Op().Is(ent.OpUpdateOne) || f.err != nil {
+		return nil
+	}
+
+	v, err := f.m.OldField(f.ctx, name)
+	if err != nil {
+		f.err = err
+		return nil
+	}
+	if p, ok := v.(*string); ok {
+		if p == nil {
+			return nil
+		}
+		return *p
+	}
+	return v
+}
+
+// rule returns the validation rule made of the type and the constraint fields.
+func (f *mutationFields) rule() *vartype.Rule {
+	rule := &vartype.Rule{}
+	if t, _ := f.get("type").(string); t != "" {
+		rule.Type = vartype.Type(t)
+	}
+	rule.Pattern, _ = f.get("pattern").(string)
+	rule.Min, _ = f.get("min").(string)
+	rule.Max, _ = f.get("max").(string)
+	rule.Values, _ = f.get("enum_values").([]string)
+	return rule
+}
@@ -0,0 +1,110 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/kechako/envoke/varname"
+	"github.com/kechako/envoke/vartype"
+)
+
+// SchemaKey holds the schema definition for the SchemaKey entity.
+// It declares a key expected in the environments of a schema.
+type SchemaKey struct {
+	ent.Schema
+}
+
+// Mixin of the SchemaKey.
+func (SchemaKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the SchemaKey.
+func (SchemaKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("schema_id"),
+		field.String("name").
+			NotEmpty().
+			Validate(varname.Validate),
+		field.String("description").
+			Optional(),
+		field.String("default_value").
+			Optional().
+			Nillable(),
+		field.Bool("secret").
+			Optional(),
+		field.Bool("optional").
+			Optional(),
+		field.String("type").
+			Default(string(vartype.String)).
+			Validate(vartype.ValidateType),
+		field.String("pattern").
+			Optional(),
+		field.String("min").
+			Optional(),
+		field.String("max").
+			Optional(),
+		field.Strings("enum_values").
+			Optional(),
+	}
+}
+
+// Edges of the SchemaKey.
+func (SchemaKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("schema", EnvironmentSchema.Type).
+			Ref("keys").
+			Unique().
+			Required().
+			Field("schema_id"),
+	}
+}
+
+// Hooks of the SchemaKey.
+func (SchemaKey) Hooks() []ent.Hook {
+	return []ent.Hook{
+		validateSchemaKey,
+	}
+}
+
+// validateSchemaKey validates the rule of a key and its default value when it
+// is created or updated.
+func validateSchemaKey(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		if !m.Op().Is(ent.OpCreate | ent.OpUpdateOne) {
+			return next.Mutate(ctx, m)
+		}
+
+		f := &mutationFields{ctx: ctx, m: m}
+		name, _ := f.get("name").(string)
+		value, hasDefault := f.get("default_value").(string)
+		rule := f.rule()
+		if f.err != nil {
+			return nil, f.err
+		}
+
+		if err := rule.Check(); err != nil {
+			return nil, fmt.Errorf("invalid rule of key '%s': %w", name, err)
+		}
+		if hasDefault {
+			if err := rule.Validate(value); err != nil {
+				return nil, fmt.Errorf("invalid default value of key '%s': %w", name, err)
+			}
+		}
+
+		return next.Mutate(ctx, m)
+	})
+}
+
+func (SchemaKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("schema_id", "name").
+			Unique(),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SyncState holds the schema definition for the SyncState entity.
+// It records the last synchronized variables of an environment and a file.
+type SyncState struct {
+	ent.Schema
+}
+
+// Fields of the SyncState.
+func (SyncState) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("environment_id"),
+		field.String("path").
+			NotEmpty(),
+		field.JSON("variables", map[string]string{}),
+		field.Time("synced_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the SyncState.
+func (SyncState) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("environment", Environment.Type).
+			Ref("sync_states").
+			Unique().
+			Required().
+			Field("environment_id"),
+	}
+}
+
+func (SyncState) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("environment_id", "path").
+			Unique(),
+	}
+}
@@ -1,10 +1,15 @@
 package schema
 
 import (
+	"context"
+	"fmt"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/kechako/envoke/varname"
+	"github.com/kechako/envoke/vartype"
 )
 
 // Variable holds the schema definition for the Variable entity.
@@ -12,17 +17,38 @@ type Variable struct {
 	ent.Schema
 }
 
+// Mixin of the Variable.
+func (Variable) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
 // Fields of the Variable.
 func (Variable) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("environment_id"),
 		field.String("name").
-			NotEmpty(),
+			NotEmpty().
+			Validate(varname.Validate),
 		field.String("value"),
 		field.String("comment").
 			Optional(),
 		field.Bool("expand").
 			Optional(),
+		field.Bool("secret").
+			Optional(),
+		field.String("type").
+			Default(string(vartype.String)).
+			Validate(vartype.ValidateType),
+		field.String("pattern").
+			Optional(),
+		field.String("min").
+			Optional(),
+		field.String("max").
+			Optional(),
+		field.Strings("enum_values").
+			Optional(),
 	}
 }
 
@@ -37,6 +63,44 @@ func (Variable) Edges() []ent.Edge {
 	}
 }
 
+// Hooks of the Variable.
+func (Variable) Hooks() []ent.Hook {
+	return []ent.Hook{
+		validateVariable,
+	}
+}
+
+// validateVariable validates the rule of a variable and its value when it is
+// created or updated. Values to be expanded are validated after expansion
+// instead.
+func validateVariable(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		if !m.Op().Is(ent.OpCreate | ent.OpUpdateOne) {
+			return next.Mutate(ctx, m)
+		}
+
+		f := &mutationFields{ctx: ctx, m: m}
+		name, _ := f.get("name").(string)
+		value, _ := f.get("value").(string)
+		expand, _ := f.get("expand").(bool)
+		rule := f.rule()
+		if f.err != nil {
+			return nil, f.err
+		}
+
+		if err := rule.Check(); err != nil {
+			return nil, fmt.Errorf("invalid rule of variable '%s': %w", name, err)
+		}
+		if !expand {
+			if err := rule.Validate(value); err != nil {
+				return nil, fmt.Errorf("invalid value of variable '%s': %w", name, err)
+			}
+		}
+
+		return next.Mutate(ctx, m)
+	})
+}
+
 func (Variable) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("environment_id", "name").
@@ -0,0 +1,277 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/envoke/ent/environmentschema"
+	"github.com/kechako/envoke/ent/schemakey"
+)
+
+// SchemaKey is the model entity for the SchemaKey schema.
+type SchemaKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// SchemaID holds the value of the "schema_id" field.
+	SchemaID int `json:"schema_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// DefaultValue holds the value of the "default_value" field.
+	DefaultValue *string `json:"default_value,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret bool `json:"secret,omitempty"`
+	// Optional holds the value of the "optional" field.
+	Optional bool `json:"optional,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// Pattern holds the value of the "pattern" field.
+	Pattern string `json:"pattern,omitempty"`
+	// Min holds the value of the "min" field.
+	Min string `json:"min,omitempty"`
+	// Max holds the value of the "max" field.
+	Max string `json:"max,omitempty"`
+	// EnumValues holds the value of the "enum_values" field.
+	EnumValues []string `json:"enum_values,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SchemaKeyQuery when eager-loading is set.
+	Edges        SchemaKeyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SchemaKeyEdges holds the relations/edges for other nodes in the graph.
+type SchemaKeyEdges struct {
+	// Schema holds the value of the schema edge.
+	Schema *EnvironmentSchema `json:"schema,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SchemaOrErr returns the Schema value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SchemaKeyEdges) SchemaOrErr() (*EnvironmentSchema, error) {
+	if e.Schema != nil {
+		return e.Schema, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: environmentschema.Label}
+	}
+	return nil, &NotLoadedError{edge: "schema"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SchemaKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case schemakey.FieldEnumValues:
+			values[i] = new([]byte)
+		case schemakey.FieldSecret, schemakey.FieldOptional:
+			values[i] = new(sql.NullBool)
+		case schemakey.FieldID, schemakey.FieldSchemaID:
+			values[i] = new(sql.NullInt64)
+		case schemakey.FieldName, schemakey.FieldDescription, schemakey.FieldDefaultValue, schemakey.FieldType, schemakey.FieldPattern, schemakey.FieldMin, schemakey.FieldMax:
+			values[i] = new(sql.NullString)
+		case schemakey.FieldCreatedAt, schemakey.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SchemaKey fields.
+func (sk *SchemaKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case schemakey.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sk.ID = int(value.Int64)
+		case schemakey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sk.CreatedAt = value.Time
+			}
+		case schemakey.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sk.UpdatedAt = value.Time
+			}
+		case schemakey.FieldSchemaID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field schema_id", values[i])
+			} else if value.Valid {
+				sk.SchemaID = int(value.Int64)
+			}
+		case schemakey.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sk.Name = value.String
+			}
+		case schemakey.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				sk.Description = value.String
+			}
+		case schemakey.FieldDefaultValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field default_value", values[i])
+			} else if value.Valid {
+				sk.DefaultValue = new(string)
+				*sk.DefaultValue = value.String
+			}
+		case schemakey.FieldSecret:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				sk.Secret = value.Bool
+			}
+		case schemakey.FieldOptional:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field optional", values[i])
+			} else if value.Valid {
+				sk.Optional = value.Bool
+			}
+		case schemakey.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				sk.Type = value.String
+			}
+		case schemakey.FieldPattern:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pattern", values[i])
+			} else if value.Valid {
+				sk.Pattern = value.String
+			}
+		case schemakey.FieldMin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field min", values[i])
+			} else if value.Valid {
+				sk.Min = value.String
+			}
+		case schemakey.FieldMax:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field max", values[i])
+			} else if value.Valid {
+				sk.Max = value.String
+			}
+		case schemakey.FieldEnumValues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field enum_values", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sk.EnumValues); err != nil {
+					return fmt.Errorf("unmarshal field enum_values: %w", err)
+				}
+			}
+		default:
+			sk.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SchemaKey.
+// This includes values selected through modifiers, order, etc.
+func (sk *SchemaKey) Value(name string) (ent.Value, error) {
+	return sk.selectValues.Get(name)
+}
+
+// QuerySchema queries the "schema" edge of the SchemaKey entity.
+func (sk *SchemaKey) QuerySchema() *EnvironmentSchemaQuery {
+	return NewSchemaKeyClient(sk.config).QuerySchema(sk)
+}
+
+// Update returns a builder for updating this SchemaKey.
+// Note that you need to call SchemaKey.Unwrap() before calling this method if this SchemaKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sk *SchemaKey) Update() *SchemaKeyUpdateOne {
+	return NewSchemaKeyClient(sk.config).UpdateOne(sk)
+}
+
+// Unwrap unwraps the SchemaKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sk *SchemaKey) Unwrap() *SchemaKey {
+	_tx, ok := sk.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SchemaKey is not a transactional entity")
+	}
+	sk.config.driver = _tx.drv
+	return sk
+}
+
+// String implements the fmt.Stringer.
+func (sk *SchemaKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("SchemaKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sk.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(sk.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sk.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("schema_id=")
+	builder.WriteString(fmt.Sprintf("%v", sk.SchemaID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(sk.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(sk.Description)
+	builder.WriteString(", ")
+	if v := sk.DefaultValue; v != nil {
+		builder.WriteString("default_value=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("secret=")
+	builder.WriteString(fmt.Sprintf("%v", sk.Secret))
+	builder.WriteString(", ")
+	builder.WriteString("optional=")
+	builder.WriteString(fmt.Sprintf("%v", sk.Optional))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(sk.Type)
+	builder.WriteString(", ")
+	builder.WriteString("pattern=")
+	builder.WriteString(sk.Pattern)
+	builder.WriteString(", ")
+	builder.WriteString("min=")
+	builder.WriteString(sk.Min)
+	builder.WriteString(", ")
+	builder.WriteString("max=")
+	builder.WriteString(sk.Max)
+	builder.WriteString(", ")
+	builder.WriteString("enum_values=")
+	builder.WriteString(fmt.Sprintf("%v", sk.EnumValues))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SchemaKeys is a parsable slice of SchemaKey.
+type SchemaKeys []*SchemaKey
@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package schemakey
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the schemakey type in the database.
+	Label = "schema_key"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldSchemaID holds the string denoting the schema_id field in the database.
+	FieldSchemaID = "schema_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldDefaultValue holds the string denoting the default_value field in the database.
+	FieldDefaultValue = "default_value"
+	// FieldSecret holds the string denoting the secret field in the database.
+	FieldSecret = "secret"
+	// FieldOptional holds the string denoting the optional field in the database.
+	FieldOptional = "optional"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldPattern holds the string denoting the pattern field in the database.
+	FieldPattern = "pattern"
+	// FieldMin holds the string denoting the min field in the database.
+	FieldMin = "min"
+	// FieldMax holds the string denoting the max field in the database.
+	FieldMax = "max"
+	// FieldEnumValues holds the string denoting the enum_values field in the database.
+	FieldEnumValues = "enum_values"
+	// EdgeSchema holds the string denoting the schema edge name in mutations.
+	EdgeSchema = "schema"
+	// Table holds the table name of the schemakey in the database.
+	Table = "schema_keys"
+	// SchemaTable is the table that holds the schema relation/edge.
+	SchemaTable = "schema_keys"
+	// SchemaInverseTable is the table name for the EnvironmentSchema entity.
+	// It exists in this package in order to avoid circular dependency with the "environmentschema" package.
+	SchemaInverseTable = "environment_schemas"
+	// SchemaColumn is the table column denoting the schema relation/edge.
+	SchemaColumn = "schema_id"
+)
+
+// Columns holds all SQL columns for schemakey fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldSchemaID,
+	FieldName,
+	FieldDescription,
+	FieldDefaultValue,
+	FieldSecret,
+	FieldOptional,
+	FieldType,
+	FieldPattern,
+	FieldMin,
+	FieldMax,
+	FieldEnumValues,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/kechako/envoke/ent/runtime"
+var (
+	Hooks [1]ent.Hook
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultType holds the default value on creation for the "type" field.
+	DefaultType string
+	// TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	TypeValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the SchemaKey queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// BySchemaID orders the results by the schema_id field.
+func BySchemaID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSchemaID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByDefaultValue orders the results by the default_value field.
+func ByDefaultValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultValue, opts...).ToFunc()
+}
+
+// BySecret orders the results by the secret field.
+func BySecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSecret, opts...).ToFunc()
+}
+
+// ByOptional orders the results by the optional field.
+func ByOptional(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOptional, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByPattern orders the results by the pattern field.
+func ByPattern(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPattern, opts...).ToFunc()
+}
+
+// ByMin orders the results by the min field.
+func ByMin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMin, opts...).ToFunc()
+}
+
+// ByMax orders the results by the max field.
+func ByMax(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMax, opts...).ToFunc()
+}
+
+// BySchemaField orders the results by schema field.
+func BySchemaField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSchemaStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSchemaStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SchemaInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SchemaTable, SchemaColumn),
+	)
+}
@@ -0,0 +1,829 @@
+// Code generated by ent, DO NOT EDIT.
+
+package schemakey
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/kechako/envoke/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// SchemaID applies equality check predicate on the "schema_id" field. It's identical to SchemaIDEQ.
+func SchemaID(v int) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldSchemaID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldDescription, v))
+}
+
+// DefaultValue applies equality check predicate on the "default_value" field. It's identical to DefaultValueEQ.
+func DefaultValue(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldDefaultValue, v))
+}
+
+// Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
+func Secret(v bool) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldSecret, v))
+}
+
+// Optional applies equality check predicate on the "optional" field. It's identical to OptionalEQ.
+func Optional(v bool) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldOptional, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldType, v))
+}
+
+// Pattern applies equality check predicate on the "pattern" field. It's identical to PatternEQ.
+func Pattern(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldPattern, v))
+}
+
+// Min applies equality check predicate on the "min" field. It's identical to MinEQ.
+func Min(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldMin, v))
+}
+
+// Max applies equality check predicate on the "max" field. It's identical to MaxEQ.
+func Max(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldMax, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// SchemaIDEQ applies the EQ predicate on the "schema_id" field.
+func SchemaIDEQ(v int) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldSchemaID, v))
+}
+
+// SchemaIDNEQ applies the NEQ predicate on the "schema_id" field.
+func SchemaIDNEQ(v int) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNEQ(FieldSchemaID, v))
+}
+
+// SchemaIDIn applies the In predicate on the "schema_id" field.
+func SchemaIDIn(vs ...int) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIn(FieldSchemaID, vs...))
+}
+
+// SchemaIDNotIn applies the NotIn predicate on the "schema_id" field.
+func SchemaIDNotIn(vs ...int) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotIn(FieldSchemaID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// DefaultValueEQ applies the EQ predicate on the "default_value" field.
+func DefaultValueEQ(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldDefaultValue, v))
+}
+
+// DefaultValueNEQ applies the NEQ predicate on the "default_value" field.
+func DefaultValueNEQ(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNEQ(FieldDefaultValue, v))
+}
+
+// DefaultValueIn applies the In predicate on the "default_value" field.
+func DefaultValueIn(vs ...string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIn(FieldDefaultValue, vs...))
+}
+
+// DefaultValueNotIn applies the NotIn predicate on the "default_value" field.
+func DefaultValueNotIn(vs ...string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotIn(FieldDefaultValue, vs...))
+}
+
+// DefaultValueGT applies the GT predicate on the "default_value" field.
+func DefaultValueGT(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldGT(FieldDefaultValue, v))
+}
+
+// DefaultValueGTE applies the GTE predicate on the "default_value" field.
+func DefaultValueGTE(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldGTE(FieldDefaultValue, v))
+}
+
+// DefaultValueLT applies the LT predicate on the "default_value" field.
+func DefaultValueLT(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldLT(FieldDefaultValue, v))
+}
+
+// DefaultValueLTE applies the LTE predicate on the "default_value" field.
+func DefaultValueLTE(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldLTE(FieldDefaultValue, v))
+}
+
+// DefaultValueContains applies the Contains predicate on the "default_value" field.
+func DefaultValueContains(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldContains(FieldDefaultValue, v))
+}
+
+// DefaultValueHasPrefix applies the HasPrefix predicate on the "default_value" field.
+func DefaultValueHasPrefix(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldHasPrefix(FieldDefaultValue, v))
+}
+
+// DefaultValueHasSuffix applies the HasSuffix predicate on the "default_value" field.
+func DefaultValueHasSuffix(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldHasSuffix(FieldDefaultValue, v))
+}
+
+// DefaultValueIsNil applies the IsNil predicate on the "default_value" field.
+func DefaultValueIsNil() predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIsNull(FieldDefaultValue))
+}
+
+// DefaultValueNotNil applies the NotNil predicate on the "default_value" field.
+func DefaultValueNotNil() predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotNull(FieldDefaultValue))
+}
+
+// DefaultValueEqualFold applies the EqualFold predicate on the "default_value" field.
+func DefaultValueEqualFold(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEqualFold(FieldDefaultValue, v))
+}
+
+// DefaultValueContainsFold applies the ContainsFold predicate on the "default_value" field.
+func DefaultValueContainsFold(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldContainsFold(FieldDefaultValue, v))
+}
+
+// SecretEQ applies the EQ predicate on the "secret" field.
+func SecretEQ(v bool) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldSecret, v))
+}
+
+// SecretNEQ applies the NEQ predicate on the "secret" field.
+func SecretNEQ(v bool) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNEQ(FieldSecret, v))
+}
+
+// SecretIsNil applies the IsNil predicate on the "secret" field.
+func SecretIsNil() predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIsNull(FieldSecret))
+}
+
+// SecretNotNil applies the NotNil predicate on the "secret" field.
+func SecretNotNil() predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotNull(FieldSecret))
+}
+
+// OptionalEQ applies the EQ predicate on the "optional" field.
+func OptionalEQ(v bool) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldOptional, v))
+}
+
+// OptionalNEQ applies the NEQ predicate on the "optional" field.
+func OptionalNEQ(v bool) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNEQ(FieldOptional, v))
+}
+
+// OptionalIsNil applies the IsNil predicate on the "optional" field.
+func OptionalIsNil() predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIsNull(FieldOptional))
+}
+
+// OptionalNotNil applies the NotNil predicate on the "optional" field.
+func OptionalNotNil() predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotNull(FieldOptional))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldContainsFold(FieldType, v))
+}
+
+// PatternEQ applies the EQ predicate on the "pattern" field.
+func PatternEQ(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldPattern, v))
+}
+
+// PatternNEQ applies the NEQ predicate on the "pattern" field.
+func PatternNEQ(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNEQ(FieldPattern, v))
+}
+
+// PatternIn applies the In predicate on the "pattern" field.
+func PatternIn(vs ...string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIn(FieldPattern, vs...))
+}
+
+// PatternNotIn applies the NotIn predicate on the "pattern" field.
+func PatternNotIn(vs ...string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotIn(FieldPattern, vs...))
+}
+
+// PatternGT applies the GT predicate on the "pattern" field.
+func PatternGT(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldGT(FieldPattern, v))
+}
+
+// PatternGTE applies the GTE predicate on the "pattern" field.
+func PatternGTE(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldGTE(FieldPattern, v))
+}
+
+// PatternLT applies the LT predicate on the "pattern" field.
+func PatternLT(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldLT(FieldPattern, v))
+}
+
+// PatternLTE applies the LTE predicate on the "pattern" field.
+func PatternLTE(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldLTE(FieldPattern, v))
+}
+
+// PatternContains applies the Contains predicate on the "pattern" field.
+func PatternContains(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldContains(FieldPattern, v))
+}
+
+// PatternHasPrefix applies the HasPrefix predicate on the "pattern" field.
+func PatternHasPrefix(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldHasPrefix(FieldPattern, v))
+}
+
+// PatternHasSuffix applies the HasSuffix predicate on the "pattern" field.
+func PatternHasSuffix(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldHasSuffix(FieldPattern, v))
+}
+
+// PatternIsNil applies the IsNil predicate on the "pattern" field.
+func PatternIsNil() predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIsNull(FieldPattern))
+}
+
+// PatternNotNil applies the NotNil predicate on the "pattern" field.
+func PatternNotNil() predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotNull(FieldPattern))
+}
+
+// PatternEqualFold applies the EqualFold predicate on the "pattern" field.
+func PatternEqualFold(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEqualFold(FieldPattern, v))
+}
+
+// PatternContainsFold applies the ContainsFold predicate on the "pattern" field.
+func PatternContainsFold(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldContainsFold(FieldPattern, v))
+}
+
+// MinEQ applies the EQ predicate on the "min" field.
+func MinEQ(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldMin, v))
+}
+
+// MinNEQ applies the NEQ predicate on the "min" field.
+func MinNEQ(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNEQ(FieldMin, v))
+}
+
+// MinIn applies the In predicate on the "min" field.
+func MinIn(vs ...string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIn(FieldMin, vs...))
+}
+
+// MinNotIn applies the NotIn predicate on the "min" field.
+func MinNotIn(vs ...string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotIn(FieldMin, vs...))
+}
+
+// MinGT applies the GT predicate on the "min" field.
+func MinGT(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldGT(FieldMin, v))
+}
+
+// MinGTE applies the GTE predicate on the "min" field.
+func MinGTE(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldGTE(FieldMin, v))
+}
+
+// MinLT applies the LT predicate on the "min" field.
+func MinLT(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldLT(FieldMin, v))
+}
+
+// MinLTE applies the LTE predicate on the "min" field.
+func MinLTE(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldLTE(FieldMin, v))
+}
+
+// MinContains applies the Contains predicate on the "min" field.
+func MinContains(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldContains(FieldMin, v))
+}
+
+// MinHasPrefix applies the HasPrefix predicate on the "min" field.
+func MinHasPrefix(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldHasPrefix(FieldMin, v))
+}
+
+// MinHasSuffix applies the HasSuffix predicate on the "min" field.
+func MinHasSuffix(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldHasSuffix(FieldMin, v))
+}
+
+// MinIsNil applies the IsNil predicate on the "min" field.
+func MinIsNil() predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIsNull(FieldMin))
+}
+
+// MinNotNil applies the NotNil predicate on the "min" field.
+func MinNotNil() predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotNull(FieldMin))
+}
+
+// MinEqualFold applies the EqualFold predicate on the "min" field.
+func MinEqualFold(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEqualFold(FieldMin, v))
+}
+
+// MinContainsFold applies the ContainsFold predicate on the "min" field.
+func MinContainsFold(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldContainsFold(FieldMin, v))
+}
+
+// MaxEQ applies the EQ predicate on the "max" field.
+func MaxEQ(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEQ(FieldMax, v))
+}
+
+// MaxNEQ applies the NEQ predicate on the "max" field.
+func MaxNEQ(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNEQ(FieldMax, v))
+}
+
+// MaxIn applies the In predicate on the "max" field.
+func MaxIn(vs ...string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIn(FieldMax, vs...))
+}
+
+// MaxNotIn applies the NotIn predicate on the "max" field.
+func MaxNotIn(vs ...string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotIn(FieldMax, vs...))
+}
+
+// MaxGT applies the GT predicate on the "max" field.
+func MaxGT(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldGT(FieldMax, v))
+}
+
+// MaxGTE applies the GTE predicate on the "max" field.
+func MaxGTE(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldGTE(FieldMax, v))
+}
+
+// MaxLT applies the LT predicate on the "max" field.
+func MaxLT(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldLT(FieldMax, v))
+}
+
+// MaxLTE applies the LTE predicate on the "max" field.
+func MaxLTE(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldLTE(FieldMax, v))
+}
+
+// MaxContains applies the Contains predicate on the "max" field.
+func MaxContains(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldContains(FieldMax, v))
+}
+
+// MaxHasPrefix applies the HasPrefix predicate on the "max" field.
+func MaxHasPrefix(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldHasPrefix(FieldMax, v))
+}
+
+// MaxHasSuffix applies the HasSuffix predicate on the "max" field.
+func MaxHasSuffix(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldHasSuffix(FieldMax, v))
+}
+
+// MaxIsNil applies the IsNil predicate on the "max" field.
+func MaxIsNil() predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIsNull(FieldMax))
+}
+
+// MaxNotNil applies the NotNil predicate on the "max" field.
+func MaxNotNil() predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotNull(FieldMax))
+}
+
+// MaxEqualFold applies the EqualFold predicate on the "max" field.
+func MaxEqualFold(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldEqualFold(FieldMax, v))
+}
+
+// MaxContainsFold applies the ContainsFold predicate on the "max" field.
+func MaxContainsFold(v string) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldContainsFold(FieldMax, v))
+}
+
+// EnumValuesIsNil applies the IsNil predicate on the "enum_values" field.
+func EnumValuesIsNil() predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldIsNull(FieldEnumValues))
+}
+
+// EnumValuesNotNil applies the NotNil predicate on the "enum_values" field.
+func EnumValuesNotNil() predicate.SchemaKey {
+	return predicate.SchemaKey(sql.FieldNotNull(FieldEnumValues))
+}
+
+// HasSchema applies the HasEdge predicate on the "schema" edge.
+func HasSchema() predicate.SchemaKey {
+	return predicate.SchemaKey(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SchemaTable, SchemaColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSchemaWith applies the HasEdge predicate on the "schema" edge with a given conditions (other predicates).
+func HasSchemaWith(preds ...predicate.EnvironmentSchema) predicate.SchemaKey {
+	return predicate.SchemaKey(func(s *sql.Selector) {
+		step := newSchemaStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SchemaKey) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SchemaKey) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SchemaKey) predicate.SchemaKey {
+	return predicate.SchemaKey(sql.NotPredicates(p))
+}
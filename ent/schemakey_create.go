@@ -0,0 +1,1352 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/envoke/ent/environmentschema"
+	"github.com/kechako/envoke/ent/schemakey"
+)
+
+// SchemaKeyCreate is the builder for creating a SchemaKey entity.
+type SchemaKeyCreate struct {
+	config
+	mutation *SchemaKeyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (skc *SchemaKeyCreate) SetCreatedAt(t time.Time) *SchemaKeyCreate {
+	skc.mutation.SetCreatedAt(t)
+	return skc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (skc *SchemaKeyCreate) SetNillableCreatedAt(t *time.Time) *SchemaKeyCreate {
+	if t != nil {
+		skc.SetCreatedAt(*t)
+	}
+	return skc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (skc *SchemaKeyCreate) SetUpdatedAt(t time.Time) *SchemaKeyCreate {
+	skc.mutation.SetUpdatedAt(t)
+	return skc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (skc *SchemaKeyCreate) SetNillableUpdatedAt(t *time.Time) *SchemaKeyCreate {
+	if t != nil {
+		skc.SetUpdatedAt(*t)
+	}
+	return skc
+}
+
+// SetSchemaID sets the "schema_id" field.
+func (skc *SchemaKeyCreate) SetSchemaID(i int) *SchemaKeyCreate {
+	skc.mutation.SetSchemaID(i)
+	return skc
+}
+
+// SetName sets the "name" field.
+func (skc *SchemaKeyCreate) SetName(s string) *SchemaKeyCreate {
+	skc.mutation.SetName(s)
+	return skc
+}
+
+// SetDescription sets the "description" field.
+func (skc *SchemaKeyCreate) SetDescription(s string) *SchemaKeyCreate {
+	skc.mutation.SetDescription(s)
+	return skc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (skc *SchemaKeyCreate) SetNillableDescription(s *string) *SchemaKeyCreate {
+	if s != nil {
+		skc.SetDescription(*s)
+	}
+	return skc
+}
+
+// SetDefaultValue sets the "default_value" field.
+func (skc *SchemaKeyCreate) SetDefaultValue(s string) *SchemaKeyCreate {
+	skc.mutation.SetDefaultValue(s)
+	return skc
+}
+
+// SetNillableDefaultValue sets the "default_value" field if the given value is not nil.
+func (skc *SchemaKeyCreate) SetNillableDefaultValue(s *string) *SchemaKeyCreate {
+	if s != nil {
+		skc.SetDefaultValue(*s)
+	}
+	return skc
+}
+
+// SetSecret sets the "secret" field.
+func (skc *SchemaKeyCreate) SetSecret(b bool) *SchemaKeyCreate {
+	skc.mutation.SetSecret(b)
+	return skc
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (skc *SchemaKeyCreate) SetNillableSecret(b *bool) *SchemaKeyCreate {
+	if b != nil {
+		skc.SetSecret(*b)
+	}
+	return skc
+}
+
+// SetOptional sets the "optional" field.
+func (skc *SchemaKeyCreate) SetOptional(b bool) *SchemaKeyCreate {
+	skc.mutation.SetOptional(b)
+	return skc
+}
+
+// SetNillableOptional sets the "optional" field if the given value is not nil.
+func (skc *SchemaKeyCreate) SetNillableOptional(b *bool) *SchemaKeyCreate {
+	if b != nil {
+		skc.SetOptional(*b)
+	}
+	return skc
+}
+
+// SetType sets the "type" field.
+func (skc *SchemaKeyCreate) SetType(s string) *SchemaKeyCreate {
+	skc.mutation.SetType(s)
+	return skc
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (skc *SchemaKeyCreate) SetNillableType(s *string) *SchemaKeyCreate {
+	if s != nil {
+		skc.SetType(*s)
+	}
+	return skc
+}
+
+// SetPattern sets the "pattern" field.
+func (skc *SchemaKeyCreate) SetPattern(s string) *SchemaKeyCreate {
+	skc.mutation.SetPattern(s)
+	return skc
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (skc *SchemaKeyCreate) SetNillablePattern(s *string) *SchemaKeyCreate {
+	if s != nil {
+		skc.SetPattern(*s)
+	}
+	return skc
+}
+
+// SetMin sets the "min" field.
+func (skc *SchemaKeyCreate) SetMin(s string) *SchemaKeyCreate {
+	skc.mutation.SetMin(s)
+	return skc
+}
+
+// SetNillableMin sets the "min" field if the given value is not nil.
+func (skc *SchemaKeyCreate) SetNillableMin(s *string) *SchemaKeyCreate {
+	if s != nil {
+		skc.SetMin(*s)
+	}
+	return skc
+}
+
+// SetMax sets the "max" field.
+func (skc *SchemaKeyCreate) SetMax(s string) *SchemaKeyCreate {
+	skc.mutation.SetMax(s)
+	return skc
+}
+
+// SetNillableMax sets the "max" field if the given value is not nil.
+func (skc *SchemaKeyCreate) SetNillableMax(s *string) *SchemaKeyCreate {
+	if s != nil {
+		skc.SetMax(*s)
+	}
+	return skc
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (skc *SchemaKeyCreate) SetEnumValues(s []string) *SchemaKeyCreate {
+	skc.mutation.SetEnumValues(s)
+	return skc
+}
+
+// SetSchema sets the "schema" edge to the EnvironmentSchema entity.
+func (skc *SchemaKeyCreate) SetSchema(e *EnvironmentSchema) *SchemaKeyCreate {
+	return skc.SetSchemaID(e.ID)
+}
+
+// Mutation returns the SchemaKeyMutation object of the builder.
+func (skc *SchemaKeyCreate) Mutation() *SchemaKeyMutation {
+	return skc.mutation
+}
+
+// Save creates the SchemaKey in the database.
+func (skc *SchemaKeyCreate) Save(ctx context.Context) (*SchemaKey, error) {
+	if err := skc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, skc.sqlSave, skc.mutation, skc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (skc *SchemaKeyCreate) SaveX(ctx context.Context) *SchemaKey {
+	v, err := skc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (skc *SchemaKeyCreate) Exec(ctx context.Context) error {
+	_, err := skc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skc *SchemaKeyCreate) ExecX(ctx context.Context) {
+	if err := skc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (skc *SchemaKeyCreate) defaults() error {
+	if _, ok := skc.mutation.CreatedAt(); !ok {
+		if schemakey.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized schemakey.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := schemakey.DefaultCreatedAt()
+		skc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := skc.mutation.UpdatedAt(); !ok {
+		if schemakey.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized schemakey.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := schemakey.DefaultUpdatedAt()
+		skc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := skc.mutation.GetType(); !ok {
+		v := schemakey.DefaultType
+		skc.mutation.SetType(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (skc *SchemaKeyCreate) check() error {
+	if _, ok := skc.mutation.SchemaID(); !ok {
+		return &ValidationError{Name: "schema_id", err: errors.New(`ent: missing required field "SchemaKey.schema_id"`)}
+	}
+	if _, ok := skc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SchemaKey.name"`)}
+	}
+	if v, ok := skc.mutation.Name(); ok {
+		if err := schemakey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SchemaKey.name": %w`, err)}
+		}
+	}
+	if _, ok := skc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "SchemaKey.type"`)}
+	}
+	if v, ok := skc.mutation.GetType(); ok {
+		if err := schemakey.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "SchemaKey.type": %w`, err)}
+		}
+	}
+	if len(skc.mutation.SchemaIDs()) == 0 {
+		return &ValidationError{Name: "schema", err: errors.New(`ent: missing required edge "SchemaKey.schema"`)}
+	}
+	return nil
+}
+
+func (skc *SchemaKeyCreate) sqlSave(ctx context.Context) (*SchemaKey, error) {
+	if err := skc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := skc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, skc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	skc.mutation.id = &_node.ID
+	skc.mutation.done = true
+	return _node, nil
+}
+
+func (skc *SchemaKeyCreate) createSpec() (*SchemaKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SchemaKey{config: skc.config}
+		_spec = sqlgraph.NewCreateSpec(schemakey.Table, sqlgraph.NewFieldSpec(schemakey.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = skc.conflict
+	if value, ok := skc.mutation.CreatedAt(); ok {
+		_spec.SetField(schemakey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := skc.mutation.UpdatedAt(); ok {
+		_spec.SetField(schemakey.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := skc.mutation.Name(); ok {
+		_spec.SetField(schemakey.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := skc.mutation.Description(); ok {
+		_spec.SetField(schemakey.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := skc.mutation.DefaultValue(); ok {
+		_spec.SetField(schemakey.FieldDefaultValue, field.TypeString, value)
+		_node.DefaultValue = &value
+	}
+	if value, ok := skc.mutation.Secret(); ok {
+		_spec.SetField(schemakey.FieldSecret, field.TypeBool, value)
+		_node.Secret = value
+	}
+	if value, ok := skc.mutation.Optional(); ok {
+		_spec.SetField(schemakey.FieldOptional, field.TypeBool, value)
+		_node.Optional = value
+	}
+	if value, ok := skc.mutation.GetType(); ok {
+		_spec.SetField(schemakey.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := skc.mutation.Pattern(); ok {
+		_spec.SetField(schemakey.FieldPattern, field.TypeString, value)
+		_node.Pattern = value
+	}
+	if value, ok := skc.mutation.Min(); ok {
+		_spec.SetField(schemakey.FieldMin, field.TypeString, value)
+		_node.Min = value
+	}
+	if value, ok := skc.mutation.Max(); ok {
+		_spec.SetField(schemakey.FieldMax, field.TypeString, value)
+		_node.Max = value
+	}
+	if value, ok := skc.mutation.EnumValues(); ok {
+		_spec.SetField(schemakey.FieldEnumValues, field.TypeJSON, value)
+		_node.EnumValues = value
+	}
+	if nodes := skc.mutation.SchemaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   schemakey.SchemaTable,
+			Columns: []string{schemakey.SchemaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environmentschema.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SchemaID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SchemaKey.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SchemaKeyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (skc *SchemaKeyCreate) OnConflict(opts ...sql.ConflictOption) *SchemaKeyUpsertOne {
+	skc.conflict = opts
+	return &SchemaKeyUpsertOne{
+		create: skc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SchemaKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (skc *SchemaKeyCreate) OnConflictColumns(columns ...string) *SchemaKeyUpsertOne {
+	skc.conflict = append(skc.conflict, sql.ConflictColumns(columns...))
+	return &SchemaKeyUpsertOne{
+		create: skc,
+	}
+}
+
+type (
+	// SchemaKeyUpsertOne is the builder for "upsert"-ing
+	//  one SchemaKey node.
+	SchemaKeyUpsertOne struct {
+		create *SchemaKeyCreate
+	}
+
+	// SchemaKeyUpsert is the "OnConflict" setter.
+	SchemaKeyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SchemaKeyUpsert) SetUpdatedAt(v time.Time) *SchemaKeyUpsert {
+	u.Set(schemakey.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SchemaKeyUpsert) UpdateUpdatedAt() *SchemaKeyUpsert {
+	u.SetExcluded(schemakey.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *SchemaKeyUpsert) ClearUpdatedAt() *SchemaKeyUpsert {
+	u.SetNull(schemakey.FieldUpdatedAt)
+	return u
+}
+
+// SetSchemaID sets the "schema_id" field.
+func (u *SchemaKeyUpsert) SetSchemaID(v int) *SchemaKeyUpsert {
+	u.Set(schemakey.FieldSchemaID, v)
+	return u
+}
+
+// UpdateSchemaID sets the "schema_id" field to the value that was provided on create.
+func (u *SchemaKeyUpsert) UpdateSchemaID() *SchemaKeyUpsert {
+	u.SetExcluded(schemakey.FieldSchemaID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SchemaKeyUpsert) SetName(v string) *SchemaKeyUpsert {
+	u.Set(schemakey.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SchemaKeyUpsert) UpdateName() *SchemaKeyUpsert {
+	u.SetExcluded(schemakey.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *SchemaKeyUpsert) SetDescription(v string) *SchemaKeyUpsert {
+	u.Set(schemakey.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SchemaKeyUpsert) UpdateDescription() *SchemaKeyUpsert {
+	u.SetExcluded(schemakey.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SchemaKeyUpsert) ClearDescription() *SchemaKeyUpsert {
+	u.SetNull(schemakey.FieldDescription)
+	return u
+}
+
+// SetDefaultValue sets the "default_value" field.
+func (u *SchemaKeyUpsert) SetDefaultValue(v string) *SchemaKeyUpsert {
+	u.Set(schemakey.FieldDefaultValue, v)
+	return u
+}
+
+// UpdateDefaultValue sets the "default_value" field to the value that was provided on create.
+func (u *SchemaKeyUpsert) UpdateDefaultValue() *SchemaKeyUpsert {
+	u.SetExcluded(schemakey.FieldDefaultValue)
+	return u
+}
+
+// ClearDefaultValue clears the value of the "default_value" field.
+func (u *SchemaKeyUpsert) ClearDefaultValue() *SchemaKeyUpsert {
+	u.SetNull(schemakey.FieldDefaultValue)
+	return u
+}
+
+// SetSecret sets the "secret" field.
+func (u *SchemaKeyUpsert) SetSecret(v bool) *SchemaKeyUpsert {
+	u.Set(schemakey.FieldSecret, v)
+	return u
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *SchemaKeyUpsert) UpdateSecret() *SchemaKeyUpsert {
+	u.SetExcluded(schemakey.FieldSecret)
+	return u
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (u *SchemaKeyUpsert) ClearSecret() *SchemaKeyUpsert {
+	u.SetNull(schemakey.FieldSecret)
+	return u
+}
+
+// SetOptional sets the "optional" field.
+func (u *SchemaKeyUpsert) SetOptional(v bool) *SchemaKeyUpsert {
+	u.Set(schemakey.FieldOptional, v)
+	return u
+}
+
+// UpdateOptional sets the "optional" field to the value that was provided on create.
+func (u *SchemaKeyUpsert) UpdateOptional() *SchemaKeyUpsert {
+	u.SetExcluded(schemakey.FieldOptional)
+	return u
+}
+
+// ClearOptional clears the value of the "optional" field.
+func (u *SchemaKeyUpsert) ClearOptional() *SchemaKeyUpsert {
+	u.SetNull(schemakey.FieldOptional)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *SchemaKeyUpsert) SetType(v string) *SchemaKeyUpsert {
+	u.Set(schemakey.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *SchemaKeyUpsert) UpdateType() *SchemaKeyUpsert {
+	u.SetExcluded(schemakey.FieldType)
+	return u
+}
+
+// SetPattern sets the "pattern" field.
+func (u *SchemaKeyUpsert) SetPattern(v string) *SchemaKeyUpsert {
+	u.Set(schemakey.FieldPattern, v)
+	return u
+}
+
+// UpdatePattern sets the "pattern" field to the value that was provided on create.
+func (u *SchemaKeyUpsert) UpdatePattern() *SchemaKeyUpsert {
+	u.SetExcluded(schemakey.FieldPattern)
+	return u
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (u *SchemaKeyUpsert) ClearPattern() *SchemaKeyUpsert {
+	u.SetNull(schemakey.FieldPattern)
+	return u
+}
+
+// SetMin sets the "min" field.
+func (u *SchemaKeyUpsert) SetMin(v string) *SchemaKeyUpsert {
+	u.Set(schemakey.FieldMin, v)
+	return u
+}
+
+// UpdateMin sets the "min" field to the value that was provided on create.
+func (u *SchemaKeyUpsert) UpdateMin() *SchemaKeyUpsert {
+	u.SetExcluded(schemakey.FieldMin)
+	return u
+}
+
+// ClearMin clears the value of the "min" field.
+func (u *SchemaKeyUpsert) ClearMin() *SchemaKeyUpsert {
+	u.SetNull(schemakey.FieldMin)
+	return u
+}
+
+// SetMax sets the "max" field.
+func (u *SchemaKeyUpsert) SetMax(v string) *SchemaKeyUpsert {
+	u.Set(schemakey.FieldMax, v)
+	return u
+}
+
+// UpdateMax sets the "max" field to the value that was provided on create.
+func (u *SchemaKeyUpsert) UpdateMax() *SchemaKeyUpsert {
+	u.SetExcluded(schemakey.FieldMax)
+	return u
+}
+
+// ClearMax clears the value of the "max" field.
+func (u *SchemaKeyUpsert) ClearMax() *SchemaKeyUpsert {
+	u.SetNull(schemakey.FieldMax)
+	return u
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (u *SchemaKeyUpsert) SetEnumValues(v []string) *SchemaKeyUpsert {
+	u.Set(schemakey.FieldEnumValues, v)
+	return u
+}
+
+// UpdateEnumValues sets the "enum_values" field to the value that was provided on create.
+func (u *SchemaKeyUpsert) UpdateEnumValues() *SchemaKeyUpsert {
+	u.SetExcluded(schemakey.FieldEnumValues)
+	return u
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (u *SchemaKeyUpsert) ClearEnumValues() *SchemaKeyUpsert {
+	u.SetNull(schemakey.FieldEnumValues)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.SchemaKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SchemaKeyUpsertOne) UpdateNewValues() *SchemaKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(schemakey.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SchemaKey.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SchemaKeyUpsertOne) Ignore() *SchemaKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SchemaKeyUpsertOne) DoNothing() *SchemaKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SchemaKeyCreate.OnConflict
+// documentation for more info.
+func (u *SchemaKeyUpsertOne) Update(set func(*SchemaKeyUpsert)) *SchemaKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SchemaKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SchemaKeyUpsertOne) SetUpdatedAt(v time.Time) *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SchemaKeyUpsertOne) UpdateUpdatedAt() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *SchemaKeyUpsertOne) ClearUpdatedAt() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetSchemaID sets the "schema_id" field.
+func (u *SchemaKeyUpsertOne) SetSchemaID(v int) *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetSchemaID(v)
+	})
+}
+
+// UpdateSchemaID sets the "schema_id" field to the value that was provided on create.
+func (u *SchemaKeyUpsertOne) UpdateSchemaID() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateSchemaID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SchemaKeyUpsertOne) SetName(v string) *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SchemaKeyUpsertOne) UpdateName() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *SchemaKeyUpsertOne) SetDescription(v string) *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SchemaKeyUpsertOne) UpdateDescription() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SchemaKeyUpsertOne) ClearDescription() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetDefaultValue sets the "default_value" field.
+func (u *SchemaKeyUpsertOne) SetDefaultValue(v string) *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetDefaultValue(v)
+	})
+}
+
+// UpdateDefaultValue sets the "default_value" field to the value that was provided on create.
+func (u *SchemaKeyUpsertOne) UpdateDefaultValue() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateDefaultValue()
+	})
+}
+
+// ClearDefaultValue clears the value of the "default_value" field.
+func (u *SchemaKeyUpsertOne) ClearDefaultValue() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.ClearDefaultValue()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *SchemaKeyUpsertOne) SetSecret(v bool) *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *SchemaKeyUpsertOne) UpdateSecret() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (u *SchemaKeyUpsertOne) ClearSecret() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.ClearSecret()
+	})
+}
+
+// SetOptional sets the "optional" field.
+func (u *SchemaKeyUpsertOne) SetOptional(v bool) *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetOptional(v)
+	})
+}
+
+// UpdateOptional sets the "optional" field to the value that was provided on create.
+func (u *SchemaKeyUpsertOne) UpdateOptional() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateOptional()
+	})
+}
+
+// ClearOptional clears the value of the "optional" field.
+func (u *SchemaKeyUpsertOne) ClearOptional() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.ClearOptional()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *SchemaKeyUpsertOne) SetType(v string) *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *SchemaKeyUpsertOne) UpdateType() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetPattern sets the "pattern" field.
+func (u *SchemaKeyUpsertOne) SetPattern(v string) *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetPattern(v)
+	})
+}
+
+// UpdatePattern sets the "pattern" field to the value that was provided on create.
+func (u *SchemaKeyUpsertOne) UpdatePattern() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdatePattern()
+	})
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (u *SchemaKeyUpsertOne) ClearPattern() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.ClearPattern()
+	})
+}
+
+// SetMin sets the "min" field.
+func (u *SchemaKeyUpsertOne) SetMin(v string) *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetMin(v)
+	})
+}
+
+// UpdateMin sets the "min" field to the value that was provided on create.
+func (u *SchemaKeyUpsertOne) UpdateMin() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateMin()
+	})
+}
+
+// ClearMin clears the value of the "min" field.
+func (u *SchemaKeyUpsertOne) ClearMin() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.ClearMin()
+	})
+}
+
+// SetMax sets the "max" field.
+func (u *SchemaKeyUpsertOne) SetMax(v string) *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetMax(v)
+	})
+}
+
+// UpdateMax sets the "max" field to the value that was provided on create.
+func (u *SchemaKeyUpsertOne) UpdateMax() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateMax()
+	})
+}
+
+// ClearMax clears the value of the "max" field.
+func (u *SchemaKeyUpsertOne) ClearMax() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.ClearMax()
+	})
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (u *SchemaKeyUpsertOne) SetEnumValues(v []string) *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetEnumValues(v)
+	})
+}
+
+// UpdateEnumValues sets the "enum_values" field to the value that was provided on create.
+func (u *SchemaKeyUpsertOne) UpdateEnumValues() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateEnumValues()
+	})
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (u *SchemaKeyUpsertOne) ClearEnumValues() *SchemaKeyUpsertOne {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.ClearEnumValues()
+	})
+}
+
+// Exec executes the query.
+func (u *SchemaKeyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SchemaKeyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SchemaKeyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SchemaKeyUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SchemaKeyUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SchemaKeyCreateBulk is the builder for creating many SchemaKey entities in bulk.
+type SchemaKeyCreateBulk struct {
+	config
+	err      error
+	builders []*SchemaKeyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SchemaKey entities in the database.
+func (skcb *SchemaKeyCreateBulk) Save(ctx context.Context) ([]*SchemaKey, error) {
+	if skcb.err != nil {
+		return nil, skcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(skcb.builders))
+	nodes := make([]*SchemaKey, len(skcb.builders))
+	mutators := make([]Mutator, len(skcb.builders))
+	for i := range skcb.builders {
+		func(i int, root context.Context) {
+			builder := skcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SchemaKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, skcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = skcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, skcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, skcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skcb *SchemaKeyCreateBulk) SaveX(ctx context.Context) []*SchemaKey {
+	v, err := skcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (skcb *SchemaKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := skcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skcb *SchemaKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := skcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SchemaKey.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SchemaKeyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (skcb *SchemaKeyCreateBulk) OnConflict(opts ...sql.ConflictOption) *SchemaKeyUpsertBulk {
+	skcb.conflict = opts
+	return &SchemaKeyUpsertBulk{
+		create: skcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SchemaKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (skcb *SchemaKeyCreateBulk) OnConflictColumns(columns ...string) *SchemaKeyUpsertBulk {
+	skcb.conflict = append(skcb.conflict, sql.ConflictColumns(columns...))
+	return &SchemaKeyUpsertBulk{
+		create: skcb,
+	}
+}
+
+// SchemaKeyUpsertBulk is the builder for "upsert"-ing
+// a bulk of SchemaKey nodes.
+type SchemaKeyUpsertBulk struct {
+	create *SchemaKeyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SchemaKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SchemaKeyUpsertBulk) UpdateNewValues() *SchemaKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(schemakey.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SchemaKey.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SchemaKeyUpsertBulk) Ignore() *SchemaKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SchemaKeyUpsertBulk) DoNothing() *SchemaKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SchemaKeyCreateBulk.OnConflict
+// documentation for more info.
+func (u *SchemaKeyUpsertBulk) Update(set func(*SchemaKeyUpsert)) *SchemaKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SchemaKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SchemaKeyUpsertBulk) SetUpdatedAt(v time.Time) *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SchemaKeyUpsertBulk) UpdateUpdatedAt() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *SchemaKeyUpsertBulk) ClearUpdatedAt() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetSchemaID sets the "schema_id" field.
+func (u *SchemaKeyUpsertBulk) SetSchemaID(v int) *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetSchemaID(v)
+	})
+}
+
+// UpdateSchemaID sets the "schema_id" field to the value that was provided on create.
+func (u *SchemaKeyUpsertBulk) UpdateSchemaID() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateSchemaID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SchemaKeyUpsertBulk) SetName(v string) *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SchemaKeyUpsertBulk) UpdateName() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *SchemaKeyUpsertBulk) SetDescription(v string) *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SchemaKeyUpsertBulk) UpdateDescription() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SchemaKeyUpsertBulk) ClearDescription() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetDefaultValue sets the "default_value" field.
+func (u *SchemaKeyUpsertBulk) SetDefaultValue(v string) *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetDefaultValue(v)
+	})
+}
+
+// UpdateDefaultValue sets the "default_value" field to the value that was provided on create.
+func (u *SchemaKeyUpsertBulk) UpdateDefaultValue() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateDefaultValue()
+	})
+}
+
+// ClearDefaultValue clears the value of the "default_value" field.
+func (u *SchemaKeyUpsertBulk) ClearDefaultValue() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.ClearDefaultValue()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *SchemaKeyUpsertBulk) SetSecret(v bool) *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *SchemaKeyUpsertBulk) UpdateSecret() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (u *SchemaKeyUpsertBulk) ClearSecret() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.ClearSecret()
+	})
+}
+
+// SetOptional sets the "optional" field.
+func (u *SchemaKeyUpsertBulk) SetOptional(v bool) *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetOptional(v)
+	})
+}
+
+// UpdateOptional sets the "optional" field to the value that was provided on create.
+func (u *SchemaKeyUpsertBulk) UpdateOptional() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateOptional()
+	})
+}
+
+// ClearOptional clears the value of the "optional" field.
+func (u *SchemaKeyUpsertBulk) ClearOptional() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.ClearOptional()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *SchemaKeyUpsertBulk) SetType(v string) *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *SchemaKeyUpsertBulk) UpdateType() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetPattern sets the "pattern" field.
+func (u *SchemaKeyUpsertBulk) SetPattern(v string) *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetPattern(v)
+	})
+}
+
+// UpdatePattern sets the "pattern" field to the value that was provided on create.
+func (u *SchemaKeyUpsertBulk) UpdatePattern() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdatePattern()
+	})
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (u *SchemaKeyUpsertBulk) ClearPattern() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.ClearPattern()
+	})
+}
+
+// SetMin sets the "min" field.
+func (u *SchemaKeyUpsertBulk) SetMin(v string) *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetMin(v)
+	})
+}
+
+// UpdateMin sets the "min" field to the value that was provided on create.
+func (u *SchemaKeyUpsertBulk) UpdateMin() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateMin()
+	})
+}
+
+// ClearMin clears the value of the "min" field.
+func (u *SchemaKeyUpsertBulk) ClearMin() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.ClearMin()
+	})
+}
+
+// SetMax sets the "max" field.
+func (u *SchemaKeyUpsertBulk) SetMax(v string) *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetMax(v)
+	})
+}
+
+// UpdateMax sets the "max" field to the value that was provided on create.
+func (u *SchemaKeyUpsertBulk) UpdateMax() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateMax()
+	})
+}
+
+// ClearMax clears the value of the "max" field.
+func (u *SchemaKeyUpsertBulk) ClearMax() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.ClearMax()
+	})
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (u *SchemaKeyUpsertBulk) SetEnumValues(v []string) *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.SetEnumValues(v)
+	})
+}
+
+// UpdateEnumValues sets the "enum_values" field to the value that was provided on create.
+func (u *SchemaKeyUpsertBulk) UpdateEnumValues() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.UpdateEnumValues()
+	})
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (u *SchemaKeyUpsertBulk) ClearEnumValues() *SchemaKeyUpsertBulk {
+	return u.Update(func(s *SchemaKeyUpsert) {
+		s.ClearEnumValues()
+	})
+}
+
+// Exec executes the query.
+func (u *SchemaKeyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SchemaKeyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SchemaKeyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SchemaKeyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/envoke/ent/environmentschema"
+	"github.com/kechako/envoke/ent/predicate"
+	"github.com/kechako/envoke/ent/schemakey"
+)
+
+// SchemaKeyQuery is the builder for querying SchemaKey entities.
+type SchemaKeyQuery struct {
+	config
+	ctx        *QueryContext
+	order      []schemakey.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SchemaKey
+	withSchema *EnvironmentSchemaQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SchemaKeyQuery builder.
+func (skq *SchemaKeyQuery) Where(ps ...predicate.SchemaKey) *SchemaKeyQuery {
+	skq.predicates = append(skq.predicates, ps...)
+	return skq
+}
+
+// Limit the number of records to be returned by this query.
+func (skq *SchemaKeyQuery) Limit(limit int) *SchemaKeyQuery {
+	skq.ctx.Limit = &limit
+	return skq
+}
+
+// Offset to start from.
+func (skq *SchemaKeyQuery) Offset(offset int) *SchemaKeyQuery {
+	skq.ctx.Offset = &offset
+	return skq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (skq *SchemaKeyQuery) Unique(unique bool) *SchemaKeyQuery {
+	skq.ctx.Unique = &unique
+	return skq
+}
+
+// Order specifies how the records should be ordered.
+func (skq *SchemaKeyQuery) Order(o ...schemakey.OrderOption) *SchemaKeyQuery {
+	skq.order = append(skq.order, o...)
+	return skq
+}
+
+// QuerySchema chains the current query on the "schema" edge.
+func (skq *SchemaKeyQuery) QuerySchema() *EnvironmentSchemaQuery {
+	query := (&EnvironmentSchemaClient{config: skq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := skq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := skq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(schemakey.Table, schemakey.FieldID, selector),
+			sqlgraph.To(environmentschema.Table, environmentschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, schemakey.SchemaTable, schemakey.SchemaColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(skq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SchemaKey entity from the query.
+// Returns a *NotFoundError when no SchemaKey was found.
+func (skq *SchemaKeyQuery) First(ctx context.Context) (*SchemaKey, error) {
+	nodes, err := skq.Limit(1).All(setContextOp(ctx, skq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{schemakey.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (skq *SchemaKeyQuery) FirstX(ctx context.Context) *SchemaKey {
+	node, err := skq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SchemaKey ID from the query.
+// Returns a *NotFoundError when no SchemaKey ID was found.
+func (skq *SchemaKeyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = skq.Limit(1).IDs(setContextOp(ctx, skq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{schemakey.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (skq *SchemaKeyQuery) FirstIDX(ctx context.Context) int {
+	id, err := skq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SchemaKey entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SchemaKey entity is found.
+// Returns a *NotFoundError when no SchemaKey entities are found.
+func (skq *SchemaKeyQuery) Only(ctx context.Context) (*SchemaKey, error) {
+	nodes, err := skq.Limit(2).All(setContextOp(ctx, skq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{schemakey.Label}
+	default:
+		return nil, &NotSingularError{schemakey.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (skq *SchemaKeyQuery) OnlyX(ctx context.Context) *SchemaKey {
+	node, err := skq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SchemaKey ID in the query.
+// Returns a *NotSingularError when more than one SchemaKey ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (skq *SchemaKeyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = skq.Limit(2).IDs(setContextOp(ctx, skq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{schemakey.Label}
+	default:
+		err = &NotSingularError{schemakey.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (skq *SchemaKeyQuery) OnlyIDX(ctx context.Context) int {
+	id, err := skq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SchemaKeys.
+func (skq *SchemaKeyQuery) All(ctx context.Context) ([]*SchemaKey, error) {
+	ctx = setContextOp(ctx, skq.ctx, ent.OpQueryAll)
+	if err := skq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SchemaKey, *SchemaKeyQuery]()
+	return withInterceptors[[]*SchemaKey](ctx, skq, qr, skq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (skq *SchemaKeyQuery) AllX(ctx context.Context) []*SchemaKey {
+	nodes, err := skq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SchemaKey IDs.
+func (skq *SchemaKeyQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if skq.ctx.Unique == nil && skq.path != nil {
+		skq.Unique(true)
+	}
+	ctx = setContextOp(ctx, skq.ctx, ent.OpQueryIDs)
+	if err = skq.Select(schemakey.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (skq *SchemaKeyQuery) IDsX(ctx context.Context) []int {
+	ids, err := skq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (skq *SchemaKeyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, skq.ctx, ent.OpQueryCount)
+	if err := skq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, skq, querierCount[*SchemaKeyQuery](), skq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (skq *SchemaKeyQuery) CountX(ctx context.Context) int {
+	count, err := skq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (skq *SchemaKeyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, skq.ctx, ent.OpQueryExist)
+	switch _, err := skq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (skq *SchemaKeyQuery) ExistX(ctx context.Context) bool {
+	exist, err := skq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SchemaKeyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (skq *SchemaKeyQuery) Clone() *SchemaKeyQuery {
+	if skq == nil {
+		return nil
+	}
+	return &SchemaKeyQuery{
+		config:     skq.config,
+		ctx:        skq.ctx.Clone(),
+		order:      append([]schemakey.OrderOption{}, skq.order...),
+		inters:     append([]Interceptor{}, skq.inters...),
+		predicates: append([]predicate.SchemaKey{}, skq.predicates...),
+		withSchema: skq.withSchema.Clone(),
+		// clone intermediate query.
+		sql:  skq.sql.Clone(),
+		path: skq.path,
+	}
+}
+
+// WithSchema tells the query-builder to eager-load the nodes that are connected to
+// the "schema" edge. The optional arguments are used to configure the query builder of the edge.
+func (skq *SchemaKeyQuery) WithSchema(opts ...func(*EnvironmentSchemaQuery)) *SchemaKeyQuery {
+	query := (&EnvironmentSchemaClient{config: skq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	skq.withSchema = query
+	return skq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SchemaKey.Query().
+//		GroupBy(schemakey.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (skq *SchemaKeyQuery) GroupBy(field string, fields ...string) *SchemaKeyGroupBy {
+	skq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SchemaKeyGroupBy{build: skq}
+	grbuild.flds = &skq.ctx.Fields
+	grbuild.label = schemakey.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.SchemaKey.Query().
+//		Select(schemakey.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (skq *SchemaKeyQuery) Select(fields ...string) *SchemaKeySelect {
+	skq.ctx.Fields = append(skq.ctx.Fields, fields...)
+	sbuild := &SchemaKeySelect{SchemaKeyQuery: skq}
+	sbuild.label = schemakey.Label
+	sbuild.flds, sbuild.scan = &skq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SchemaKeySelect configured with the given aggregations.
+func (skq *SchemaKeyQuery) Aggregate(fns ...AggregateFunc) *SchemaKeySelect {
+	return skq.Select().Aggregate(fns...)
+}
+
+func (skq *SchemaKeyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range skq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, skq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range skq.ctx.Fields {
+		if !schemakey.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if skq.path != nil {
+		prev, err := skq.path(ctx)
+		if err != nil {
+			return err
+		}
+		skq.sql = prev
+	}
+	return nil
+}
+
+func (skq *SchemaKeyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SchemaKey, error) {
+	var (
+		nodes       = []*SchemaKey{}
+		_spec       = skq.querySpec()
+		loadedTypes = [1]bool{
+			skq.withSchema != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SchemaKey).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SchemaKey{config: skq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, skq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := skq.withSchema; query != nil {
+		if err := skq.loadSchema(ctx, query, nodes, nil,
+			func(n *SchemaKey, e *EnvironmentSchema) { n.Edges.Schema = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (skq *SchemaKeyQuery) loadSchema(ctx context.Context, query *EnvironmentSchemaQuery, nodes []*SchemaKey, init func(*SchemaKey), assign func(*SchemaKey, *EnvironmentSchema)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SchemaKey)
+	for i := range nodes {
+		fk := nodes[i].SchemaID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(environmentschema.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "schema_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (skq *SchemaKeyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := skq.querySpec()
+	_spec.Node.Columns = skq.ctx.Fields
+	if len(skq.ctx.Fields) > 0 {
+		_spec.Unique = skq.ctx.Unique != nil && *skq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, skq.driver, _spec)
+}
+
+func (skq *SchemaKeyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(schemakey.Table, schemakey.Columns, sqlgraph.NewFieldSpec(schemakey.FieldID, field.TypeInt))
+	_spec.From = skq.sql
+	if unique := skq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if skq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := skq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, schemakey.FieldID)
+		for i := range fields {
+			if fields[i] != schemakey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if skq.withSchema != nil {
+			_spec.Node.AddColumnOnce(schemakey.FieldSchemaID)
+		}
+	}
+	if ps := skq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := skq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := skq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := skq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (skq *SchemaKeyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(skq.driver.Dialect())
+	t1 := builder.Table(schemakey.Table)
+	columns := skq.ctx.Fields
+	if len(columns) == 0 {
+		columns = schemakey.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if skq.sql != nil {
+		selector = skq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if skq.ctx.Unique != nil && *skq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range skq.predicates {
+		p(selector)
+	}
+	for _, p := range skq.order {
+		p(selector)
+	}
+	if offset := skq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := skq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SchemaKeyGroupBy is the group-by builder for SchemaKey entities.
+type SchemaKeyGroupBy struct {
+	selector
+	build *SchemaKeyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (skgb *SchemaKeyGroupBy) Aggregate(fns ...AggregateFunc) *SchemaKeyGroupBy {
+	skgb.fns = append(skgb.fns, fns...)
+	return skgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (skgb *SchemaKeyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, skgb.build.ctx, ent.OpQueryGroupBy)
+	if err := skgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SchemaKeyQuery, *SchemaKeyGroupBy](ctx, skgb.build, skgb, skgb.build.inters, v)
+}
+
+func (skgb *SchemaKeyGroupBy) sqlScan(ctx context.Context, root *SchemaKeyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(skgb.fns))
+	for _, fn := range skgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*skgb.flds)+len(skgb.fns))
+		for _, f := range *skgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*skgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := skgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SchemaKeySelect is the builder for selecting fields of SchemaKey entities.
+type SchemaKeySelect struct {
+	*SchemaKeyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sks *SchemaKeySelect) Aggregate(fns ...AggregateFunc) *SchemaKeySelect {
+	sks.fns = append(sks.fns, fns...)
+	return sks
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sks *SchemaKeySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sks.ctx, ent.OpQuerySelect)
+	if err := sks.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SchemaKeyQuery, *SchemaKeySelect](ctx, sks.SchemaKeyQuery, sks, sks.inters, v)
+}
+
+func (sks *SchemaKeySelect) sqlScan(ctx context.Context, root *SchemaKeyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sks.fns))
+	for _, fn := range sks.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sks.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sks.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
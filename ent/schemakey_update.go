@@ -0,0 +1,890 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/envoke/ent/environmentschema"
+	"github.com/kechako/envoke/ent/predicate"
+	"github.com/kechako/envoke/ent/schemakey"
+)
+
+// SchemaKeyUpdate is the builder for updating SchemaKey entities.
+type SchemaKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SchemaKeyMutation
+}
+
+// Where appends a list predicates to the SchemaKeyUpdate builder.
+func (sku *SchemaKeyUpdate) Where(ps ...predicate.SchemaKey) *SchemaKeyUpdate {
+	sku.mutation.Where(ps...)
+	return sku
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sku *SchemaKeyUpdate) SetUpdatedAt(t time.Time) *SchemaKeyUpdate {
+	sku.mutation.SetUpdatedAt(t)
+	return sku
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (sku *SchemaKeyUpdate) ClearUpdatedAt() *SchemaKeyUpdate {
+	sku.mutation.ClearUpdatedAt()
+	return sku
+}
+
+// SetSchemaID sets the "schema_id" field.
+func (sku *SchemaKeyUpdate) SetSchemaID(i int) *SchemaKeyUpdate {
+	sku.mutation.SetSchemaID(i)
+	return sku
+}
+
+// SetNillableSchemaID sets the "schema_id" field if the given value is not nil.
+func (sku *SchemaKeyUpdate) SetNillableSchemaID(i *int) *SchemaKeyUpdate {
+	if i != nil {
+		sku.SetSchemaID(*i)
+	}
+	return sku
+}
+
+// SetName sets the "name" field.
+func (sku *SchemaKeyUpdate) SetName(s string) *SchemaKeyUpdate {
+	sku.mutation.SetName(s)
+	return sku
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sku *SchemaKeyUpdate) SetNillableName(s *string) *SchemaKeyUpdate {
+	if s != nil {
+		sku.SetName(*s)
+	}
+	return sku
+}
+
+// SetDescription sets the "description" field.
+func (sku *SchemaKeyUpdate) SetDescription(s string) *SchemaKeyUpdate {
+	sku.mutation.SetDescription(s)
+	return sku
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (sku *SchemaKeyUpdate) SetNillableDescription(s *string) *SchemaKeyUpdate {
+	if s != nil {
+		sku.SetDescription(*s)
+	}
+	return sku
+}
+
+// ClearDescription clears the value of the "description" field.
+func (sku *SchemaKeyUpdate) ClearDescription() *SchemaKeyUpdate {
+	sku.mutation.ClearDescription()
+	return sku
+}
+
+// SetDefaultValue sets the "default_value" field.
+func (sku *SchemaKeyUpdate) SetDefaultValue(s string) *SchemaKeyUpdate {
+	sku.mutation.SetDefaultValue(s)
+	return sku
+}
+
+// SetNillableDefaultValue sets the "default_value" field if the given value is not nil.
+func (sku *SchemaKeyUpdate) SetNillableDefaultValue(s *string) *SchemaKeyUpdate {
+	if s != nil {
+		sku.SetDefaultValue(*s)
+	}
+	return sku
+}
+
+// ClearDefaultValue clears the value of the "default_value" field.
+func (sku *SchemaKeyUpdate) ClearDefaultValue() *SchemaKeyUpdate {
+	sku.mutation.ClearDefaultValue()
+	return sku
+}
+
+// SetSecret sets the "secret" field.
+func (sku *SchemaKeyUpdate) SetSecret(b bool) *SchemaKeyUpdate {
+	sku.mutation.SetSecret(b)
+	return sku
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (sku *SchemaKeyUpdate) SetNillableSecret(b *bool) *SchemaKeyUpdate {
+	if b != nil {
+		sku.SetSecret(*b)
+	}
+	return sku
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (sku *SchemaKeyUpdate) ClearSecret() *SchemaKeyUpdate {
+	sku.mutation.ClearSecret()
+	return sku
+}
+
+// SetOptional sets the "optional" field.
+func (sku *SchemaKeyUpdate) SetOptional(b bool) *SchemaKeyUpdate {
+	sku.mutation.SetOptional(b)
+	return sku
+}
+
+// SetNillableOptional sets the "optional" field if the given value is not nil.
+func (sku *SchemaKeyUpdate) SetNillableOptional(b *bool) *SchemaKeyUpdate {
+	if b != nil {
+		sku.SetOptional(*b)
+	}
+	return sku
+}
+
+// ClearOptional clears the value of the "optional" field.
+func (sku *SchemaKeyUpdate) ClearOptional() *SchemaKeyUpdate {
+	sku.mutation.ClearOptional()
+	return sku
+}
+
+// SetType sets the "type" field.
+func (sku *SchemaKeyUpdate) SetType(s string) *SchemaKeyUpdate {
+	sku.mutation.SetType(s)
+	return sku
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (sku *SchemaKeyUpdate) SetNillableType(s *string) *SchemaKeyUpdate {
+	if s != nil {
+		sku.SetType(*s)
+	}
+	return sku
+}
+
+// SetPattern sets the "pattern" field.
+func (sku *SchemaKeyUpdate) SetPattern(s string) *SchemaKeyUpdate {
+	sku.mutation.SetPattern(s)
+	return sku
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (sku *SchemaKeyUpdate) SetNillablePattern(s *string) *SchemaKeyUpdate {
+	if s != nil {
+		sku.SetPattern(*s)
+	}
+	return sku
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (sku *SchemaKeyUpdate) ClearPattern() *SchemaKeyUpdate {
+	sku.mutation.ClearPattern()
+	return sku
+}
+
+// SetMin sets the "min" field.
+func (sku *SchemaKeyUpdate) SetMin(s string) *SchemaKeyUpdate {
+	sku.mutation.SetMin(s)
+	return sku
+}
+
+// SetNillableMin sets the "min" field if the given value is not nil.
+func (sku *SchemaKeyUpdate) SetNillableMin(s *string) *SchemaKeyUpdate {
+	if s != nil {
+		sku.SetMin(*s)
+	}
+	return sku
+}
+
+// ClearMin clears the value of the "min" field.
+func (sku *SchemaKeyUpdate) ClearMin() *SchemaKeyUpdate {
+	sku.mutation.ClearMin()
+	return sku
+}
+
+// SetMax sets the "max" field.
+func (sku *SchemaKeyUpdate) SetMax(s string) *SchemaKeyUpdate {
+	sku.mutation.SetMax(s)
+	return sku
+}
+
+// SetNillableMax sets the "max" field if the given value is not nil.
+func (sku *SchemaKeyUpdate) SetNillableMax(s *string) *SchemaKeyUpdate {
+	if s != nil {
+		sku.SetMax(*s)
+	}
+	return sku
+}
+
+// ClearMax clears the value of the "max" field.
+func (sku *SchemaKeyUpdate) ClearMax() *SchemaKeyUpdate {
+	sku.mutation.ClearMax()
+	return sku
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (sku *SchemaKeyUpdate) SetEnumValues(s []string) *SchemaKeyUpdate {
+	sku.mutation.SetEnumValues(s)
+	return sku
+}
+
+// AppendEnumValues appends s to the "enum_values" field.
+func (sku *SchemaKeyUpdate) AppendEnumValues(s []string) *SchemaKeyUpdate {
+	sku.mutation.AppendEnumValues(s)
+	return sku
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (sku *SchemaKeyUpdate) ClearEnumValues() *SchemaKeyUpdate {
+	sku.mutation.ClearEnumValues()
+	return sku
+}
+
+// SetSchema sets the "schema" edge to the EnvironmentSchema entity.
+func (sku *SchemaKeyUpdate) SetSchema(e *EnvironmentSchema) *SchemaKeyUpdate {
+	return sku.SetSchemaID(e.ID)
+}
+
+// Mutation returns the SchemaKeyMutation object of the builder.
+func (sku *SchemaKeyUpdate) Mutation() *SchemaKeyMutation {
+	return sku.mutation
+}
+
+// ClearSchema clears the "schema" edge to the EnvironmentSchema entity.
+func (sku *SchemaKeyUpdate) ClearSchema() *SchemaKeyUpdate {
+	sku.mutation.ClearSchema()
+	return sku
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sku *SchemaKeyUpdate) Save(ctx context.Context) (int, error) {
+	if err := sku.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, sku.sqlSave, sku.mutation, sku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sku *SchemaKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := sku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sku *SchemaKeyUpdate) Exec(ctx context.Context) error {
+	_, err := sku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sku *SchemaKeyUpdate) ExecX(ctx context.Context) {
+	if err := sku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sku *SchemaKeyUpdate) defaults() error {
+	if _, ok := sku.mutation.UpdatedAt(); !ok && !sku.mutation.UpdatedAtCleared() {
+		if schemakey.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized schemakey.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := schemakey.UpdateDefaultUpdatedAt()
+		sku.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sku *SchemaKeyUpdate) check() error {
+	if v, ok := sku.mutation.Name(); ok {
+		if err := schemakey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SchemaKey.name": %w`, err)}
+		}
+	}
+	if v, ok := sku.mutation.GetType(); ok {
+		if err := schemakey.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "SchemaKey.type": %w`, err)}
+		}
+	}
+	if sku.mutation.SchemaCleared() && len(sku.mutation.SchemaIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SchemaKey.schema"`)
+	}
+	return nil
+}
+
+func (sku *SchemaKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sku.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(schemakey.Table, schemakey.Columns, sqlgraph.NewFieldSpec(schemakey.FieldID, field.TypeInt))
+	if ps := sku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if sku.mutation.CreatedAtCleared() {
+		_spec.ClearField(schemakey.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := sku.mutation.UpdatedAt(); ok {
+		_spec.SetField(schemakey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sku.mutation.UpdatedAtCleared() {
+		_spec.ClearField(schemakey.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := sku.mutation.Name(); ok {
+		_spec.SetField(schemakey.FieldName, field.TypeString, value)
+	}
+	if value, ok := sku.mutation.Description(); ok {
+		_spec.SetField(schemakey.FieldDescription, field.TypeString, value)
+	}
+	if sku.mutation.DescriptionCleared() {
+		_spec.ClearField(schemakey.FieldDescription, field.TypeString)
+	}
+	if value, ok := sku.mutation.DefaultValue(); ok {
+		_spec.SetField(schemakey.FieldDefaultValue, field.TypeString, value)
+	}
+	if sku.mutation.DefaultValueCleared() {
+		_spec.ClearField(schemakey.FieldDefaultValue, field.TypeString)
+	}
+	if value, ok := sku.mutation.Secret(); ok {
+		_spec.SetField(schemakey.FieldSecret, field.TypeBool, value)
+	}
+	if sku.mutation.SecretCleared() {
+		_spec.ClearField(schemakey.FieldSecret, field.TypeBool)
+	}
+	if value, ok := sku.mutation.Optional(); ok {
+		_spec.SetField(schemakey.FieldOptional, field.TypeBool, value)
+	}
+	if sku.mutation.OptionalCleared() {
+		_spec.ClearField(schemakey.FieldOptional, field.TypeBool)
+	}
+	if value, ok := sku.mutation.GetType(); ok {
+		_spec.SetField(schemakey.FieldType, field.TypeString, value)
+	}
+	if value, ok := sku.mutation.Pattern(); ok {
+		_spec.SetField(schemakey.FieldPattern, field.TypeString, value)
+	}
+	if sku.mutation.PatternCleared() {
+		_spec.ClearField(schemakey.FieldPattern, field.TypeString)
+	}
+	if value, ok := sku.mutation.Min(); ok {
+		_spec.SetField(schemakey.FieldMin, field.TypeString, value)
+	}
+	if sku.mutation.MinCleared() {
+		_spec.ClearField(schemakey.FieldMin, field.TypeString)
+	}
+	if value, ok := sku.mutation.Max(); ok {
+		_spec.SetField(schemakey.FieldMax, field.TypeString, value)
+	}
+	if sku.mutation.MaxCleared() {
+		_spec.ClearField(schemakey.FieldMax, field.TypeString)
+	}
+	if value, ok := sku.mutation.EnumValues(); ok {
+		_spec.SetField(schemakey.FieldEnumValues, field.TypeJSON, value)
+	}
+	if value, ok := sku.mutation.AppendedEnumValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, schemakey.FieldEnumValues, value)
+		})
+	}
+	if sku.mutation.EnumValuesCleared() {
+		_spec.ClearField(schemakey.FieldEnumValues, field.TypeJSON)
+	}
+	if sku.mutation.SchemaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   schemakey.SchemaTable,
+			Columns: []string{schemakey.SchemaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environmentschema.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sku.mutation.SchemaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   schemakey.SchemaTable,
+			Columns: []string{schemakey.SchemaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environmentschema.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schemakey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sku.mutation.done = true
+	return n, nil
+}
+
+// SchemaKeyUpdateOne is the builder for updating a single SchemaKey entity.
+type SchemaKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SchemaKeyMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (skuo *SchemaKeyUpdateOne) SetUpdatedAt(t time.Time) *SchemaKeyUpdateOne {
+	skuo.mutation.SetUpdatedAt(t)
+	return skuo
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (skuo *SchemaKeyUpdateOne) ClearUpdatedAt() *SchemaKeyUpdateOne {
+	skuo.mutation.ClearUpdatedAt()
+	return skuo
+}
+
+// SetSchemaID sets the "schema_id" field.
+func (skuo *SchemaKeyUpdateOne) SetSchemaID(i int) *SchemaKeyUpdateOne {
+	skuo.mutation.SetSchemaID(i)
+	return skuo
+}
+
+// SetNillableSchemaID sets the "schema_id" field if the given value is not nil.
+func (skuo *SchemaKeyUpdateOne) SetNillableSchemaID(i *int) *SchemaKeyUpdateOne {
+	if i != nil {
+		skuo.SetSchemaID(*i)
+	}
+	return skuo
+}
+
+// SetName sets the "name" field.
+func (skuo *SchemaKeyUpdateOne) SetName(s string) *SchemaKeyUpdateOne {
+	skuo.mutation.SetName(s)
+	return skuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (skuo *SchemaKeyUpdateOne) SetNillableName(s *string) *SchemaKeyUpdateOne {
+	if s != nil {
+		skuo.SetName(*s)
+	}
+	return skuo
+}
+
+// SetDescription sets the "description" field.
+func (skuo *SchemaKeyUpdateOne) SetDescription(s string) *SchemaKeyUpdateOne {
+	skuo.mutation.SetDescription(s)
+	return skuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (skuo *SchemaKeyUpdateOne) SetNillableDescription(s *string) *SchemaKeyUpdateOne {
+	if s != nil {
+		skuo.SetDescription(*s)
+	}
+	return skuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (skuo *SchemaKeyUpdateOne) ClearDescription() *SchemaKeyUpdateOne {
+	skuo.mutation.ClearDescription()
+	return skuo
+}
+
+// SetDefaultValue sets the "default_value" field.
+func (skuo *SchemaKeyUpdateOne) SetDefaultValue(s string) *SchemaKeyUpdateOne {
+	skuo.mutation.SetDefaultValue(s)
+	return skuo
+}
+
+// SetNillableDefaultValue sets the "default_value" field if the given value is not nil.
+func (skuo *SchemaKeyUpdateOne) SetNillableDefaultValue(s *string) *SchemaKeyUpdateOne {
+	if s != nil {
+		skuo.SetDefaultValue(*s)
+	}
+	return skuo
+}
+
+// ClearDefaultValue clears the value of the "default_value" field.
+func (skuo *SchemaKeyUpdateOne) ClearDefaultValue() *SchemaKeyUpdateOne {
+	skuo.mutation.ClearDefaultValue()
+	return skuo
+}
+
+// SetSecret sets the "secret" field.
+func (skuo *SchemaKeyUpdateOne) SetSecret(b bool) *SchemaKeyUpdateOne {
+	skuo.mutation.SetSecret(b)
+	return skuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (skuo *SchemaKeyUpdateOne) SetNillableSecret(b *bool) *SchemaKeyUpdateOne {
+	if b != nil {
+		skuo.SetSecret(*b)
+	}
+	return skuo
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (skuo *SchemaKeyUpdateOne) ClearSecret() *SchemaKeyUpdateOne {
+	skuo.mutation.ClearSecret()
+	return skuo
+}
+
+// SetOptional sets the "optional" field.
+func (skuo *SchemaKeyUpdateOne) SetOptional(b bool) *SchemaKeyUpdateOne {
+	skuo.mutation.SetOptional(b)
+	return skuo
+}
+
+// SetNillableOptional sets the "optional" field if the given value is not nil.
+func (skuo *SchemaKeyUpdateOne) SetNillableOptional(b *bool) *SchemaKeyUpdateOne {
+	if b != nil {
+		skuo.SetOptional(*b)
+	}
+	return skuo
+}
+
+// ClearOptional clears the value of the "optional" field.
+func (skuo *SchemaKeyUpdateOne) ClearOptional() *SchemaKeyUpdateOne {
+	skuo.mutation.ClearOptional()
+	return skuo
+}
+
+// SetType sets the "type" field.
+func (skuo *SchemaKeyUpdateOne) SetType(s string) *SchemaKeyUpdateOne {
+	skuo.mutation.SetType(s)
+	return skuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (skuo *SchemaKeyUpdateOne) SetNillableType(s *string) *SchemaKeyUpdateOne {
+	if s != nil {
+		skuo.SetType(*s)
+	}
+	return skuo
+}
+
+// SetPattern sets the "pattern" field.
+func (skuo *SchemaKeyUpdateOne) SetPattern(s string) *SchemaKeyUpdateOne {
+	skuo.mutation.SetPattern(s)
+	return skuo
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (skuo *SchemaKeyUpdateOne) SetNillablePattern(s *string) *SchemaKeyUpdateOne {
+	if s != nil {
+		skuo.SetPattern(*s)
+	}
+	return skuo
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (skuo *SchemaKeyUpdateOne) ClearPattern() *SchemaKeyUpdateOne {
+	skuo.mutation.ClearPattern()
+	return skuo
+}
+
+// SetMin sets the "min" field.
+func (skuo *SchemaKeyUpdateOne) SetMin(s string) *SchemaKeyUpdateOne {
+	skuo.mutation.SetMin(s)
+	return skuo
+}
+
+// SetNillableMin sets the "min" field if the given value is not nil.
+func (skuo *SchemaKeyUpdateOne) SetNillableMin(s *string) *SchemaKeyUpdateOne {
+	if s != nil {
+		skuo.SetMin(*s)
+	}
+	return skuo
+}
+
+// ClearMin clears the value of the "min" field.
+func (skuo *SchemaKeyUpdateOne) ClearMin() *SchemaKeyUpdateOne {
+	skuo.mutation.ClearMin()
+	return skuo
+}
+
+// SetMax sets the "max" field.
+func (skuo *SchemaKeyUpdateOne) SetMax(s string) *SchemaKeyUpdateOne {
+	skuo.mutation.SetMax(s)
+	return skuo
+}
+
+// SetNillableMax sets the "max" field if the given value is not nil.
+func (skuo *SchemaKeyUpdateOne) SetNillableMax(s *string) *SchemaKeyUpdateOne {
+	if s != nil {
+		skuo.SetMax(*s)
+	}
+	return skuo
+}
+
+// ClearMax clears the value of the "max" field.
+func (skuo *SchemaKeyUpdateOne) ClearMax() *SchemaKeyUpdateOne {
+	skuo.mutation.ClearMax()
+	return skuo
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (skuo *SchemaKeyUpdateOne) SetEnumValues(s []string) *SchemaKeyUpdateOne {
+	skuo.mutation.SetEnumValues(s)
+	return skuo
+}
+
+// AppendEnumValues appends s to the "enum_values" field.
+func (skuo *SchemaKeyUpdateOne) AppendEnumValues(s []string) *SchemaKeyUpdateOne {
+	skuo.mutation.AppendEnumValues(s)
+	return skuo
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (skuo *SchemaKeyUpdateOne) ClearEnumValues() *SchemaKeyUpdateOne {
+	skuo.mutation.ClearEnumValues()
+	return skuo
+}
+
+// SetSchema sets the "schema" edge to the EnvironmentSchema entity.
+func (skuo *SchemaKeyUpdateOne) SetSchema(e *EnvironmentSchema) *SchemaKeyUpdateOne {
+	return skuo.SetSchemaID(e.ID)
+}
+
+// Mutation returns the SchemaKeyMutation object of the builder.
+func (skuo *SchemaKeyUpdateOne) Mutation() *SchemaKeyMutation {
+	return skuo.mutation
+}
+
+// ClearSchema clears the "schema" edge to the EnvironmentSchema entity.
+func (skuo *SchemaKeyUpdateOne) ClearSchema() *SchemaKeyUpdateOne {
+	skuo.mutation.ClearSchema()
+	return skuo
+}
+
+// Where appends a list predicates to the SchemaKeyUpdate builder.
+func (skuo *SchemaKeyUpdateOne) Where(ps ...predicate.SchemaKey) *SchemaKeyUpdateOne {
+	skuo.mutation.Where(ps...)
+	return skuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (skuo *SchemaKeyUpdateOne) Select(field string, fields ...string) *SchemaKeyUpdateOne {
+	skuo.fields = append([]string{field}, fields...)
+	return skuo
+}
+
+// Save executes the query and returns the updated SchemaKey entity.
+func (skuo *SchemaKeyUpdateOne) Save(ctx context.Context) (*SchemaKey, error) {
+	if err := skuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, skuo.sqlSave, skuo.mutation, skuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skuo *SchemaKeyUpdateOne) SaveX(ctx context.Context) *SchemaKey {
+	node, err := skuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (skuo *SchemaKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := skuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skuo *SchemaKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := skuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (skuo *SchemaKeyUpdateOne) defaults() error {
+	if _, ok := skuo.mutation.UpdatedAt(); !ok && !skuo.mutation.UpdatedAtCleared() {
+		if schemakey.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized schemakey.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := schemakey.UpdateDefaultUpdatedAt()
+		skuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (skuo *SchemaKeyUpdateOne) check() error {
+	if v, ok := skuo.mutation.Name(); ok {
+		if err := schemakey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SchemaKey.name": %w`, err)}
+		}
+	}
+	if v, ok := skuo.mutation.GetType(); ok {
+		if err := schemakey.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "SchemaKey.type": %w`, err)}
+		}
+	}
+	if skuo.mutation.SchemaCleared() && len(skuo.mutation.SchemaIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SchemaKey.schema"`)
+	}
+	return nil
+}
+
+func (skuo *SchemaKeyUpdateOne) sqlSave(ctx context.Context) (_node *SchemaKey, err error) {
+	if err := skuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(schemakey.Table, schemakey.Columns, sqlgraph.NewFieldSpec(schemakey.FieldID, field.TypeInt))
+	id, ok := skuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SchemaKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := skuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, schemakey.FieldID)
+		for _, f := range fields {
+			if !schemakey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != schemakey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := skuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if skuo.mutation.CreatedAtCleared() {
+		_spec.ClearField(schemakey.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := skuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(schemakey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if skuo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(schemakey.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := skuo.mutation.Name(); ok {
+		_spec.SetField(schemakey.FieldName, field.TypeString, value)
+	}
+	if value, ok := skuo.mutation.Description(); ok {
+		_spec.SetField(schemakey.FieldDescription, field.TypeString, value)
+	}
+	if skuo.mutation.DescriptionCleared() {
+		_spec.ClearField(schemakey.FieldDescription, field.TypeString)
+	}
+	if value, ok := skuo.mutation.DefaultValue(); ok {
+		_spec.SetField(schemakey.FieldDefaultValue, field.TypeString, value)
+	}
+	if skuo.mutation.DefaultValueCleared() {
+		_spec.ClearField(schemakey.FieldDefaultValue, field.TypeString)
+	}
+	if value, ok := skuo.mutation.Secret(); ok {
+		_spec.SetField(schemakey.FieldSecret, field.TypeBool, value)
+	}
+	if skuo.mutation.SecretCleared() {
+		_spec.ClearField(schemakey.FieldSecret, field.TypeBool)
+	}
+	if value, ok := skuo.mutation.Optional(); ok {
+		_spec.SetField(schemakey.FieldOptional, field.TypeBool, value)
+	}
+	if skuo.mutation.OptionalCleared() {
+		_spec.ClearField(schemakey.FieldOptional, field.TypeBool)
+	}
+	if value, ok := skuo.mutation.GetType(); ok {
+		_spec.SetField(schemakey.FieldType, field.TypeString, value)
+	}
+	if value, ok := skuo.mutation.Pattern(); ok {
+		_spec.SetField(schemakey.FieldPattern, field.TypeString, value)
+	}
+	if skuo.mutation.PatternCleared() {
+		_spec.ClearField(schemakey.FieldPattern, field.TypeString)
+	}
+	if value, ok := skuo.mutation.Min(); ok {
+		_spec.SetField(schemakey.FieldMin, field.TypeString, value)
+	}
+	if skuo.mutation.MinCleared() {
+		_spec.ClearField(schemakey.FieldMin, field.TypeString)
+	}
+	if value, ok := skuo.mutation.Max(); ok {
+		_spec.SetField(schemakey.FieldMax, field.TypeString, value)
+	}
+	if skuo.mutation.MaxCleared() {
+		_spec.ClearField(schemakey.FieldMax, field.TypeString)
+	}
+	if value, ok := skuo.mutation.EnumValues(); ok {
+		_spec.SetField(schemakey.FieldEnumValues, field.TypeJSON, value)
+	}
+	if value, ok := skuo.mutation.AppendedEnumValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, schemakey.FieldEnumValues, value)
+		})
+	}
+	if skuo.mutation.EnumValuesCleared() {
+		_spec.ClearField(schemakey.FieldEnumValues, field.TypeJSON)
+	}
+	if skuo.mutation.SchemaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   schemakey.SchemaTable,
+			Columns: []string{schemakey.SchemaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environmentschema.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := skuo.mutation.SchemaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   schemakey.SchemaTable,
+			Columns: []string{schemakey.SchemaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environmentschema.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SchemaKey{config: skuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, skuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schemakey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	skuo.mutation.done = true
+	return _node, nil
+}
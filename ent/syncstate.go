@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/syncstate"
+)
+
+// SyncState is the model entity for the SyncState schema.
+type SyncState struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// EnvironmentID holds the value of the "environment_id" field.
+	EnvironmentID int `json:"environment_id,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// Variables holds the value of the "variables" field.
+	Variables map[string]string `json:"variables,omitempty"`
+	// SyncedAt holds the value of the "synced_at" field.
+	SyncedAt time.Time `json:"synced_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SyncStateQuery when eager-loading is set.
+	Edges        SyncStateEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SyncStateEdges holds the relations/edges for other nodes in the graph.
+type SyncStateEdges struct {
+	// Environment holds the value of the environment edge.
+	Environment *Environment `json:"environment,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// EnvironmentOrErr returns the Environment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SyncStateEdges) EnvironmentOrErr() (*Environment, error) {
+	if e.Environment != nil {
+		return e.Environment, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: environment.Label}
+	}
+	return nil, &NotLoadedError{edge: "environment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SyncState) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case syncstate.FieldVariables:
+			values[i] = new([]byte)
+		case syncstate.FieldID, syncstate.FieldEnvironmentID:
+			values[i] = new(sql.NullInt64)
+		case syncstate.FieldPath:
+			values[i] = new(sql.NullString)
+		case syncstate.FieldSyncedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SyncState fields.
+func (ss *SyncState) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case syncstate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ss.ID = int(value.Int64)
+		case syncstate.FieldEnvironmentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value.Valid {
+				ss.EnvironmentID = int(value.Int64)
+			}
+		case syncstate.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				ss.Path = value.String
+			}
+		case syncstate.FieldVariables:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field variables", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ss.Variables); err != nil {
+					return fmt.Errorf("unmarshal field variables: %w", err)
+				}
+			}
+		case syncstate.FieldSyncedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field synced_at", values[i])
+			} else if value.Valid {
+				ss.SyncedAt = value.Time
+			}
+		default:
+			ss.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SyncState.
+// This includes values selected through modifiers, order, etc.
+func (ss *SyncState) Value(name string) (ent.Value, error) {
+	return ss.selectValues.Get(name)
+}
+
+// QueryEnvironment queries the "environment" edge of the SyncState entity.
+func (ss *SyncState) QueryEnvironment() *EnvironmentQuery {
+	return NewSyncStateClient(ss.config).QueryEnvironment(ss)
+}
+
+// Update returns a builder for updating this SyncState.
+// Note that you need to call SyncState.Unwrap() before calling this method if this SyncState
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ss *SyncState) Update() *SyncStateUpdateOne {
+	return NewSyncStateClient(ss.config).UpdateOne(ss)
+}
+
+// Unwrap unwraps the SyncState entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ss *SyncState) Unwrap() *SyncState {
+	_tx, ok := ss.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SyncState is not a transactional entity")
+	}
+	ss.config.driver = _tx.drv
+	return ss
+}
+
+// String implements the fmt.Stringer.
+func (ss *SyncState) String() string {
+	var builder strings.Builder
+	builder.WriteString("SyncState(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ss.ID))
+	builder.WriteString("environment_id=")
+	builder.WriteString(fmt.Sprintf("%v", ss.EnvironmentID))
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(ss.Path)
+	builder.WriteString(", ")
+	builder.WriteString("variables=")
+	builder.WriteString(fmt.Sprintf("%v", ss.Variables))
+	builder.WriteString(", ")
+	builder.WriteString("synced_at=")
+	builder.WriteString(ss.SyncedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SyncStates is a parsable slice of SyncState.
+type SyncStates []*SyncState
@@ -0,0 +1,659 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/syncstate"
+)
+
+// SyncStateCreate is the builder for creating a SyncState entity.
+type SyncStateCreate struct {
+	config
+	mutation *SyncStateMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (ssc *SyncStateCreate) SetEnvironmentID(i int) *SyncStateCreate {
+	ssc.mutation.SetEnvironmentID(i)
+	return ssc
+}
+
+// SetPath sets the "path" field.
+func (ssc *SyncStateCreate) SetPath(s string) *SyncStateCreate {
+	ssc.mutation.SetPath(s)
+	return ssc
+}
+
+// SetVariables sets the "variables" field.
+func (ssc *SyncStateCreate) SetVariables(m map[string]string) *SyncStateCreate {
+	ssc.mutation.SetVariables(m)
+	return ssc
+}
+
+// SetSyncedAt sets the "synced_at" field.
+func (ssc *SyncStateCreate) SetSyncedAt(t time.Time) *SyncStateCreate {
+	ssc.mutation.SetSyncedAt(t)
+	return ssc
+}
+
+// SetNillableSyncedAt sets the "synced_at" field if the given value is not nil.
+func (ssc *SyncStateCreate) SetNillableSyncedAt(t *time.Time) *SyncStateCreate {
+	if t != nil {
+		ssc.SetSyncedAt(*t)
+	}
+	return ssc
+}
+
+// SetEnvironment sets the "environment" edge to the Environment entity.
+func (ssc *SyncStateCreate) SetEnvironment(e *Environment) *SyncStateCreate {
+	return ssc.SetEnvironmentID(e.ID)
+}
+
+// Mutation returns the SyncStateMutation object of the builder.
+func (ssc *SyncStateCreate) Mutation() *SyncStateMutation {
+	return ssc.mutation
+}
+
+// Save creates the SyncState in the database.
+func (ssc *SyncStateCreate) Save(ctx context.Context) (*SyncState, error) {
+	ssc.defaults()
+	return withHooks(ctx, ssc.sqlSave, ssc.mutation, ssc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ssc *SyncStateCreate) SaveX(ctx context.Context) *SyncState {
+	v, err := ssc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ssc *SyncStateCreate) Exec(ctx context.Context) error {
+	_, err := ssc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssc *SyncStateCreate) ExecX(ctx context.Context) {
+	if err := ssc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssc *SyncStateCreate) defaults() {
+	if _, ok := ssc.mutation.SyncedAt(); !ok {
+		v := syncstate.DefaultSyncedAt()
+		ssc.mutation.SetSyncedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssc *SyncStateCreate) check() error {
+	if _, ok := ssc.mutation.EnvironmentID(); !ok {
+		return &ValidationError{Name: "environment_id", err: errors.New(`ent: missing required field "SyncState.environment_id"`)}
+	}
+	if _, ok := ssc.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "SyncState.path"`)}
+	}
+	if v, ok := ssc.mutation.Path(); ok {
+		if err := syncstate.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "SyncState.path": %w`, err)}
+		}
+	}
+	if _, ok := ssc.mutation.Variables(); !ok {
+		return &ValidationError{Name: "variables", err: errors.New(`ent: missing required field "SyncState.variables"`)}
+	}
+	if _, ok := ssc.mutation.SyncedAt(); !ok {
+		return &ValidationError{Name: "synced_at", err: errors.New(`ent: missing required field "SyncState.synced_at"`)}
+	}
+	if len(ssc.mutation.EnvironmentIDs()) == 0 {
+		return &ValidationError{Name: "environment", err: errors.New(`ent: missing required edge "SyncState.environment"`)}
+	}
+	return nil
+}
+
+func (ssc *SyncStateCreate) sqlSave(ctx context.Context) (*SyncState, error) {
+	if err := ssc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ssc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ssc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ssc.mutation.id = &_node.ID
+	ssc.mutation.done = true
+	return _node, nil
+}
+
+func (ssc *SyncStateCreate) createSpec() (*SyncState, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SyncState{config: ssc.config}
+		_spec = sqlgraph.NewCreateSpec(syncstate.Table, sqlgraph.NewFieldSpec(syncstate.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = ssc.conflict
+	if value, ok := ssc.mutation.Path(); ok {
+		_spec.SetField(syncstate.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := ssc.mutation.Variables(); ok {
+		_spec.SetField(syncstate.FieldVariables, field.TypeJSON, value)
+		_node.Variables = value
+	}
+	if value, ok := ssc.mutation.SyncedAt(); ok {
+		_spec.SetField(syncstate.FieldSyncedAt, field.TypeTime, value)
+		_node.SyncedAt = value
+	}
+	if nodes := ssc.mutation.EnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   syncstate.EnvironmentTable,
+			Columns: []string{syncstate.EnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EnvironmentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SyncState.Create().
+//		SetEnvironmentID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SyncStateUpsert) {
+//			SetEnvironmentID(v+v).
+//		}).
+//		Exec(ctx)
+func (ssc *SyncStateCreate) OnConflict(opts ...sql.ConflictOption) *SyncStateUpsertOne {
+	ssc.conflict = opts
+	return &SyncStateUpsertOne{
+		create: ssc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SyncState.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ssc *SyncStateCreate) OnConflictColumns(columns ...string) *SyncStateUpsertOne {
+	ssc.conflict = append(ssc.conflict, sql.ConflictColumns(columns...))
+	return &SyncStateUpsertOne{
+		create: ssc,
+	}
+}
+
+type (
+	// SyncStateUpsertOne is the builder for "upsert"-ing
+	//  one SyncState node.
+	SyncStateUpsertOne struct {
+		create *SyncStateCreate
+	}
+
+	// SyncStateUpsert is the "OnConflict" setter.
+	SyncStateUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEnvironmentID sets the "environment_id" field.
+func (u *SyncStateUpsert) SetEnvironmentID(v int) *SyncStateUpsert {
+	u.Set(syncstate.FieldEnvironmentID, v)
+	return u
+}
+
+// UpdateEnvironmentID sets the "environment_id" field to the value that was provided on create.
+func (u *SyncStateUpsert) UpdateEnvironmentID() *SyncStateUpsert {
+	u.SetExcluded(syncstate.FieldEnvironmentID)
+	return u
+}
+
+// SetPath sets the "path" field.
+func (u *SyncStateUpsert) SetPath(v string) *SyncStateUpsert {
+	u.Set(syncstate.FieldPath, v)
+	return u
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *SyncStateUpsert) UpdatePath() *SyncStateUpsert {
+	u.SetExcluded(syncstate.FieldPath)
+	return u
+}
+
+// SetVariables sets the "variables" field.
+func (u *SyncStateUpsert) SetVariables(v map[string]string) *SyncStateUpsert {
+	u.Set(syncstate.FieldVariables, v)
+	return u
+}
+
+// UpdateVariables sets the "variables" field to the value that was provided on create.
+func (u *SyncStateUpsert) UpdateVariables() *SyncStateUpsert {
+	u.SetExcluded(syncstate.FieldVariables)
+	return u
+}
+
+// SetSyncedAt sets the "synced_at" field.
+func (u *SyncStateUpsert) SetSyncedAt(v time.Time) *SyncStateUpsert {
+	u.Set(syncstate.FieldSyncedAt, v)
+	return u
+}
+
+// UpdateSyncedAt sets the "synced_at" field to the value that was provided on create.
+func (u *SyncStateUpsert) UpdateSyncedAt() *SyncStateUpsert {
+	u.SetExcluded(syncstate.FieldSyncedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.SyncState.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SyncStateUpsertOne) UpdateNewValues() *SyncStateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SyncState.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SyncStateUpsertOne) Ignore() *SyncStateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SyncStateUpsertOne) DoNothing() *SyncStateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SyncStateCreate.OnConflict
+// documentation for more info.
+func (u *SyncStateUpsertOne) Update(set func(*SyncStateUpsert)) *SyncStateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SyncStateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (u *SyncStateUpsertOne) SetEnvironmentID(v int) *SyncStateUpsertOne {
+	return u.Update(func(s *SyncStateUpsert) {
+		s.SetEnvironmentID(v)
+	})
+}
+
+// UpdateEnvironmentID sets the "environment_id" field to the value that was provided on create.
+func (u *SyncStateUpsertOne) UpdateEnvironmentID() *SyncStateUpsertOne {
+	return u.Update(func(s *SyncStateUpsert) {
+		s.UpdateEnvironmentID()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *SyncStateUpsertOne) SetPath(v string) *SyncStateUpsertOne {
+	return u.Update(func(s *SyncStateUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *SyncStateUpsertOne) UpdatePath() *SyncStateUpsertOne {
+	return u.Update(func(s *SyncStateUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetVariables sets the "variables" field.
+func (u *SyncStateUpsertOne) SetVariables(v map[string]string) *SyncStateUpsertOne {
+	return u.Update(func(s *SyncStateUpsert) {
+		s.SetVariables(v)
+	})
+}
+
+// UpdateVariables sets the "variables" field to the value that was provided on create.
+func (u *SyncStateUpsertOne) UpdateVariables() *SyncStateUpsertOne {
+	return u.Update(func(s *SyncStateUpsert) {
+		s.UpdateVariables()
+	})
+}
+
+// SetSyncedAt sets the "synced_at" field.
+func (u *SyncStateUpsertOne) SetSyncedAt(v time.Time) *SyncStateUpsertOne {
+	return u.Update(func(s *SyncStateUpsert) {
+		s.SetSyncedAt(v)
+	})
+}
+
+// UpdateSyncedAt sets the "synced_at" field to the value that was provided on create.
+func (u *SyncStateUpsertOne) UpdateSyncedAt() *SyncStateUpsertOne {
+	return u.Update(func(s *SyncStateUpsert) {
+		s.UpdateSyncedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SyncStateUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SyncStateCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SyncStateUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SyncStateUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SyncStateUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SyncStateCreateBulk is the builder for creating many SyncState entities in bulk.
+type SyncStateCreateBulk struct {
+	config
+	err      error
+	builders []*SyncStateCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SyncState entities in the database.
+func (sscb *SyncStateCreateBulk) Save(ctx context.Context) ([]*SyncState, error) {
+	if sscb.err != nil {
+		return nil, sscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sscb.builders))
+	nodes := make([]*SyncState, len(sscb.builders))
+	mutators := make([]Mutator, len(sscb.builders))
+	for i := range sscb.builders {
+		func(i int, root context.Context) {
+			builder := sscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SyncStateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = sscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sscb *SyncStateCreateBulk) SaveX(ctx context.Context) []*SyncState {
+	v, err := sscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sscb *SyncStateCreateBulk) Exec(ctx context.Context) error {
+	_, err := sscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sscb *SyncStateCreateBulk) ExecX(ctx context.Context) {
+	if err := sscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SyncState.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SyncStateUpsert) {
+//			SetEnvironmentID(v+v).
+//		}).
+//		Exec(ctx)
+func (sscb *SyncStateCreateBulk) OnConflict(opts ...sql.ConflictOption) *SyncStateUpsertBulk {
+	sscb.conflict = opts
+	return &SyncStateUpsertBulk{
+		create: sscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SyncState.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sscb *SyncStateCreateBulk) OnConflictColumns(columns ...string) *SyncStateUpsertBulk {
+	sscb.conflict = append(sscb.conflict, sql.ConflictColumns(columns...))
+	return &SyncStateUpsertBulk{
+		create: sscb,
+	}
+}
+
+// SyncStateUpsertBulk is the builder for "upsert"-ing
+// a bulk of SyncState nodes.
+type SyncStateUpsertBulk struct {
+	create *SyncStateCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SyncState.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SyncStateUpsertBulk) UpdateNewValues() *SyncStateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SyncState.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SyncStateUpsertBulk) Ignore() *SyncStateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SyncStateUpsertBulk) DoNothing() *SyncStateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SyncStateCreateBulk.OnConflict
+// documentation for more info.
+func (u *SyncStateUpsertBulk) Update(set func(*SyncStateUpsert)) *SyncStateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SyncStateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (u *SyncStateUpsertBulk) SetEnvironmentID(v int) *SyncStateUpsertBulk {
+	return u.Update(func(s *SyncStateUpsert) {
+		s.SetEnvironmentID(v)
+	})
+}
+
+// UpdateEnvironmentID sets the "environment_id" field to the value that was provided on create.
+func (u *SyncStateUpsertBulk) UpdateEnvironmentID() *SyncStateUpsertBulk {
+	return u.Update(func(s *SyncStateUpsert) {
+		s.UpdateEnvironmentID()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *SyncStateUpsertBulk) SetPath(v string) *SyncStateUpsertBulk {
+	return u.Update(func(s *SyncStateUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *SyncStateUpsertBulk) UpdatePath() *SyncStateUpsertBulk {
+	return u.Update(func(s *SyncStateUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetVariables sets the "variables" field.
+func (u *SyncStateUpsertBulk) SetVariables(v map[string]string) *SyncStateUpsertBulk {
+	return u.Update(func(s *SyncStateUpsert) {
+		s.SetVariables(v)
+	})
+}
+
+// UpdateVariables sets the "variables" field to the value that was provided on create.
+func (u *SyncStateUpsertBulk) UpdateVariables() *SyncStateUpsertBulk {
+	return u.Update(func(s *SyncStateUpsert) {
+		s.UpdateVariables()
+	})
+}
+
+// SetSyncedAt sets the "synced_at" field.
+func (u *SyncStateUpsertBulk) SetSyncedAt(v time.Time) *SyncStateUpsertBulk {
+	return u.Update(func(s *SyncStateUpsert) {
+		s.SetSyncedAt(v)
+	})
+}
+
+// UpdateSyncedAt sets the "synced_at" field to the value that was provided on create.
+func (u *SyncStateUpsertBulk) UpdateSyncedAt() *SyncStateUpsertBulk {
+	return u.Update(func(s *SyncStateUpsert) {
+		s.UpdateSyncedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SyncStateUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SyncStateCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SyncStateCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SyncStateUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
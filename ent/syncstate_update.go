@@ -0,0 +1,405 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/envoke/ent/environment"
+	"github.com/kechako/envoke/ent/predicate"
+	"github.com/kechako/envoke/ent/syncstate"
+)
+
+// SyncStateUpdate is the builder for updating SyncState entities.
+type SyncStateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SyncStateMutation
+}
+
+// Where appends a list predicates to the SyncStateUpdate builder.
+func (ssu *SyncStateUpdate) Where(ps ...predicate.SyncState) *SyncStateUpdate {
+	ssu.mutation.Where(ps...)
+	return ssu
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (ssu *SyncStateUpdate) SetEnvironmentID(i int) *SyncStateUpdate {
+	ssu.mutation.SetEnvironmentID(i)
+	return ssu
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (ssu *SyncStateUpdate) SetNillableEnvironmentID(i *int) *SyncStateUpdate {
+	if i != nil {
+		ssu.SetEnvironmentID(*i)
+	}
+	return ssu
+}
+
+// SetPath sets the "path" field.
+func (ssu *SyncStateUpdate) SetPath(s string) *SyncStateUpdate {
+	ssu.mutation.SetPath(s)
+	return ssu
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (ssu *SyncStateUpdate) SetNillablePath(s *string) *SyncStateUpdate {
+	if s != nil {
+		ssu.SetPath(*s)
+	}
+	return ssu
+}
+
+// SetVariables sets the "variables" field.
+func (ssu *SyncStateUpdate) SetVariables(m map[string]string) *SyncStateUpdate {
+	ssu.mutation.SetVariables(m)
+	return ssu
+}
+
+// SetSyncedAt sets the "synced_at" field.
+func (ssu *SyncStateUpdate) SetSyncedAt(t time.Time) *SyncStateUpdate {
+	ssu.mutation.SetSyncedAt(t)
+	return ssu
+}
+
+// SetEnvironment sets the "environment" edge to the Environment entity.
+func (ssu *SyncStateUpdate) SetEnvironment(e *Environment) *SyncStateUpdate {
+	return ssu.SetEnvironmentID(e.ID)
+}
+
+// Mutation returns the SyncStateMutation object of the builder.
+func (ssu *SyncStateUpdate) Mutation() *SyncStateMutation {
+	return ssu.mutation
+}
+
+// ClearEnvironment clears the "environment" edge to the Environment entity.
+func (ssu *SyncStateUpdate) ClearEnvironment() *SyncStateUpdate {
+	ssu.mutation.ClearEnvironment()
+	return ssu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ssu *SyncStateUpdate) Save(ctx context.Context) (int, error) {
+	ssu.defaults()
+	return withHooks(ctx, ssu.sqlSave, ssu.mutation, ssu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssu *SyncStateUpdate) SaveX(ctx context.Context) int {
+	affected, err := ssu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ssu *SyncStateUpdate) Exec(ctx context.Context) error {
+	_, err := ssu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssu *SyncStateUpdate) ExecX(ctx context.Context) {
+	if err := ssu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssu *SyncStateUpdate) defaults() {
+	if _, ok := ssu.mutation.SyncedAt(); !ok {
+		v := syncstate.UpdateDefaultSyncedAt()
+		ssu.mutation.SetSyncedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssu *SyncStateUpdate) check() error {
+	if v, ok := ssu.mutation.Path(); ok {
+		if err := syncstate.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "SyncState.path": %w`, err)}
+		}
+	}
+	if ssu.mutation.EnvironmentCleared() && len(ssu.mutation.EnvironmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SyncState.environment"`)
+	}
+	return nil
+}
+
+func (ssu *SyncStateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ssu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(syncstate.Table, syncstate.Columns, sqlgraph.NewFieldSpec(syncstate.FieldID, field.TypeInt))
+	if ps := ssu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ssu.mutation.Path(); ok {
+		_spec.SetField(syncstate.FieldPath, field.TypeString, value)
+	}
+	if value, ok := ssu.mutation.Variables(); ok {
+		_spec.SetField(syncstate.FieldVariables, field.TypeJSON, value)
+	}
+	if value, ok := ssu.mutation.SyncedAt(); ok {
+		_spec.SetField(syncstate.FieldSyncedAt, field.TypeTime, value)
+	}
+	if ssu.mutation.EnvironmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   syncstate.EnvironmentTable,
+			Columns: []string{syncstate.EnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssu.mutation.EnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   syncstate.EnvironmentTable,
+			Columns: []string{syncstate.EnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ssu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{syncstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ssu.mutation.done = true
+	return n, nil
+}
+
+// SyncStateUpdateOne is the builder for updating a single SyncState entity.
+type SyncStateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SyncStateMutation
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (ssuo *SyncStateUpdateOne) SetEnvironmentID(i int) *SyncStateUpdateOne {
+	ssuo.mutation.SetEnvironmentID(i)
+	return ssuo
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (ssuo *SyncStateUpdateOne) SetNillableEnvironmentID(i *int) *SyncStateUpdateOne {
+	if i != nil {
+		ssuo.SetEnvironmentID(*i)
+	}
+	return ssuo
+}
+
+// SetPath sets the "path" field.
+func (ssuo *SyncStateUpdateOne) SetPath(s string) *SyncStateUpdateOne {
+	ssuo.mutation.SetPath(s)
+	return ssuo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (ssuo *SyncStateUpdateOne) SetNillablePath(s *string) *SyncStateUpdateOne {
+	if s != nil {
+		ssuo.SetPath(*s)
+	}
+	return ssuo
+}
+
+// SetVariables sets the "variables" field.
+func (ssuo *SyncStateUpdateOne) SetVariables(m map[string]string) *SyncStateUpdateOne {
+	ssuo.mutation.SetVariables(m)
+	return ssuo
+}
+
+// SetSyncedAt sets the "synced_at" field.
+func (ssuo *SyncStateUpdateOne) SetSyncedAt(t time.Time) *SyncStateUpdateOne {
+	ssuo.mutation.SetSyncedAt(t)
+	return ssuo
+}
+
+// SetEnvironment sets the "environment" edge to the Environment entity.
+func (ssuo *SyncStateUpdateOne) SetEnvironment(e *Environment) *SyncStateUpdateOne {
+	return ssuo.SetEnvironmentID(e.ID)
+}
+
+// Mutation returns the SyncStateMutation object of the builder.
+func (ssuo *SyncStateUpdateOne) Mutation() *SyncStateMutation {
+	return ssuo.mutation
+}
+
+// ClearEnvironment clears the "environment" edge to the Environment entity.
+func (ssuo *SyncStateUpdateOne) ClearEnvironment() *SyncStateUpdateOne {
+	ssuo.mutation.ClearEnvironment()
+	return ssuo
+}
+
+// Where appends a list predicates to the SyncStateUpdate builder.
+func (ssuo *SyncStateUpdateOne) Where(ps ...predicate.SyncState) *SyncStateUpdateOne {
+	ssuo.mutation.Where(ps...)
+	return ssuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ssuo *SyncStateUpdateOne) Select(field string, fields ...string) *SyncStateUpdateOne {
+	ssuo.fields = append([]string{field}, fields...)
+	return ssuo
+}
+
+// Save executes the query and returns the updated SyncState entity.
+func (ssuo *SyncStateUpdateOne) Save(ctx context.Context) (*SyncState, error) {
+	ssuo.defaults()
+	return withHooks(ctx, ssuo.sqlSave, ssuo.mutation, ssuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssuo *SyncStateUpdateOne) SaveX(ctx context.Context) *SyncState {
+	node, err := ssuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ssuo *SyncStateUpdateOne) Exec(ctx context.Context) error {
+	_, err := ssuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssuo *SyncStateUpdateOne) ExecX(ctx context.Context) {
+	if err := ssuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssuo *SyncStateUpdateOne) defaults() {
+	if _, ok := ssuo.mutation.SyncedAt(); !ok {
+		v := syncstate.UpdateDefaultSyncedAt()
+		ssuo.mutation.SetSyncedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssuo *SyncStateUpdateOne) check() error {
+	if v, ok := ssuo.mutation.Path(); ok {
+		if err := syncstate.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "SyncState.path": %w`, err)}
+		}
+	}
+	if ssuo.mutation.EnvironmentCleared() && len(ssuo.mutation.EnvironmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SyncState.environment"`)
+	}
+	return nil
+}
+
+func (ssuo *SyncStateUpdateOne) sqlSave(ctx context.Context) (_node *SyncState, err error) {
+	if err := ssuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(syncstate.Table, syncstate.Columns, sqlgraph.NewFieldSpec(syncstate.FieldID, field.TypeInt))
+	id, ok := ssuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SyncState.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ssuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, syncstate.FieldID)
+		for _, f := range fields {
+			if !syncstate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != syncstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ssuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ssuo.mutation.Path(); ok {
+		_spec.SetField(syncstate.FieldPath, field.TypeString, value)
+	}
+	if value, ok := ssuo.mutation.Variables(); ok {
+		_spec.SetField(syncstate.FieldVariables, field.TypeJSON, value)
+	}
+	if value, ok := ssuo.mutation.SyncedAt(); ok {
+		_spec.SetField(syncstate.FieldSyncedAt, field.TypeTime, value)
+	}
+	if ssuo.mutation.EnvironmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   syncstate.EnvironmentTable,
+			Columns: []string{syncstate.EnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssuo.mutation.EnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   syncstate.EnvironmentTable,
+			Columns: []string{syncstate.EnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SyncState{config: ssuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ssuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{syncstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ssuo.mutation.done = true
+	return _node, nil
+}
@@ -12,8 +12,20 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AllowedProject is the client for interacting with the AllowedProject builders.
+	AllowedProject *AllowedProjectClient
 	// Environment is the client for interacting with the Environment builders.
 	Environment *EnvironmentClient
+	// EnvironmentSchema is the client for interacting with the EnvironmentSchema builders.
+	EnvironmentSchema *EnvironmentSchemaClient
+	// MergeState is the client for interacting with the MergeState builders.
+	MergeState *MergeStateClient
+	// RequiredVariable is the client for interacting with the RequiredVariable builders.
+	RequiredVariable *RequiredVariableClient
+	// SchemaKey is the client for interacting with the SchemaKey builders.
+	SchemaKey *SchemaKeyClient
+	// SyncState is the client for interacting with the SyncState builders.
+	SyncState *SyncStateClient
 	// Variable is the client for interacting with the Variable builders.
 	Variable *VariableClient
 
@@ -147,7 +159,13 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AllowedProject = NewAllowedProjectClient(tx.config)
 	tx.Environment = NewEnvironmentClient(tx.config)
+	tx.EnvironmentSchema = NewEnvironmentSchemaClient(tx.config)
+	tx.MergeState = NewMergeStateClient(tx.config)
+	tx.RequiredVariable = NewRequiredVariableClient(tx.config)
+	tx.SchemaKey = NewSchemaKeyClient(tx.config)
+	tx.SyncState = NewSyncStateClient(tx.config)
 	tx.Variable = NewVariableClient(tx.config)
 }
 
@@ -158,7 +176,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Environment.QueryXXX(), the query will be executed
+// applies a query, for example: AllowedProject.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -3,8 +3,10 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -17,6 +19,10 @@ type Variable struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// EnvironmentID holds the value of the "environment_id" field.
 	EnvironmentID int `json:"environment_id,omitempty"`
 	// Name holds the value of the "name" field.
@@ -27,6 +33,18 @@ type Variable struct {
 	Comment string `json:"comment,omitempty"`
 	// Expand holds the value of the "expand" field.
 	Expand bool `json:"expand,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret bool `json:"secret,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// Pattern holds the value of the "pattern" field.
+	Pattern string `json:"pattern,omitempty"`
+	// Min holds the value of the "min" field.
+	Min string `json:"min,omitempty"`
+	// Max holds the value of the "max" field.
+	Max string `json:"max,omitempty"`
+	// EnumValues holds the value of the "enum_values" field.
+	EnumValues []string `json:"enum_values,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the VariableQuery when eager-loading is set.
 	Edges        VariableEdges `json:"edges"`
@@ -58,12 +76,16 @@ func (*Variable) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case variable.FieldExpand:
+		case variable.FieldEnumValues:
+			values[i] = new([]byte)
+		case variable.FieldExpand, variable.FieldSecret:
 			values[i] = new(sql.NullBool)
 		case variable.FieldID, variable.FieldEnvironmentID:
 			values[i] = new(sql.NullInt64)
-		case variable.FieldName, variable.FieldValue, variable.FieldComment:
+		case variable.FieldName, variable.FieldValue, variable.FieldComment, variable.FieldType, variable.FieldPattern, variable.FieldMin, variable.FieldMax:
 			values[i] = new(sql.NullString)
+		case variable.FieldCreatedAt, variable.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -85,6 +107,18 @@ func (v *Variable) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			v.ID = int(value.Int64)
+		case variable.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				v.CreatedAt = value.Time
+			}
+		case variable.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				v.UpdatedAt = value.Time
+			}
 		case variable.FieldEnvironmentID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
@@ -115,6 +149,44 @@ func (v *Variable) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				v.Expand = value.Bool
 			}
+		case variable.FieldSecret:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				v.Secret = value.Bool
+			}
+		case variable.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				v.Type = value.String
+			}
+		case variable.FieldPattern:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pattern", values[i])
+			} else if value.Valid {
+				v.Pattern = value.String
+			}
+		case variable.FieldMin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field min", values[i])
+			} else if value.Valid {
+				v.Min = value.String
+			}
+		case variable.FieldMax:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field max", values[i])
+			} else if value.Valid {
+				v.Max = value.String
+			}
+		case variable.FieldEnumValues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field enum_values", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &v.EnumValues); err != nil {
+					return fmt.Errorf("unmarshal field enum_values: %w", err)
+				}
+			}
 		default:
 			v.selectValues.Set(columns[i], values[i])
 		}
@@ -156,6 +228,12 @@ func (v *Variable) String() string {
 	var builder strings.Builder
 	builder.WriteString("Variable(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", v.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(v.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(v.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("environment_id=")
 	builder.WriteString(fmt.Sprintf("%v", v.EnvironmentID))
 	builder.WriteString(", ")
@@ -170,6 +248,24 @@ func (v *Variable) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("expand=")
 	builder.WriteString(fmt.Sprintf("%v", v.Expand))
+	builder.WriteString(", ")
+	builder.WriteString("secret=")
+	builder.WriteString(fmt.Sprintf("%v", v.Secret))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(v.Type)
+	builder.WriteString(", ")
+	builder.WriteString("pattern=")
+	builder.WriteString(v.Pattern)
+	builder.WriteString(", ")
+	builder.WriteString("min=")
+	builder.WriteString(v.Min)
+	builder.WriteString(", ")
+	builder.WriteString("max=")
+	builder.WriteString(v.Max)
+	builder.WriteString(", ")
+	builder.WriteString("enum_values=")
+	builder.WriteString(fmt.Sprintf("%v", v.EnumValues))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package variable
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/kechako/envoke/ent/predicate"
@@ -53,6 +55,16 @@ func IDLTE(id int) predicate.Variable {
 	return predicate.Variable(sql.FieldLTE(FieldID, id))
 }
 
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Variable {
+	return predicate.Variable(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Variable {
+	return predicate.Variable(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
 // EnvironmentID applies equality check predicate on the "environment_id" field. It's identical to EnvironmentIDEQ.
 func EnvironmentID(v int) predicate.Variable {
 	return predicate.Variable(sql.FieldEQ(FieldEnvironmentID, v))
@@ -78,6 +90,131 @@ func Expand(v bool) predicate.Variable {
 	return predicate.Variable(sql.FieldEQ(FieldExpand, v))
 }
 
+// Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
+func Secret(v bool) predicate.Variable {
+	return predicate.Variable(sql.FieldEQ(FieldSecret, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldEQ(FieldType, v))
+}
+
+// Pattern applies equality check predicate on the "pattern" field. It's identical to PatternEQ.
+func Pattern(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldEQ(FieldPattern, v))
+}
+
+// Min applies equality check predicate on the "min" field. It's identical to MinEQ.
+func Min(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldEQ(FieldMin, v))
+}
+
+// Max applies equality check predicate on the "max" field. It's identical to MaxEQ.
+func Max(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldEQ(FieldMax, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Variable {
+	return predicate.Variable(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Variable {
+	return predicate.Variable(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Variable {
+	return predicate.Variable(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Variable {
+	return predicate.Variable(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Variable {
+	return predicate.Variable(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Variable {
+	return predicate.Variable(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Variable {
+	return predicate.Variable(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Variable {
+	return predicate.Variable(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.Variable {
+	return predicate.Variable(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.Variable {
+	return predicate.Variable(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Variable {
+	return predicate.Variable(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Variable {
+	return predicate.Variable(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Variable {
+	return predicate.Variable(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Variable {
+	return predicate.Variable(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Variable {
+	return predicate.Variable(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Variable {
+	return predicate.Variable(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Variable {
+	return predicate.Variable(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Variable {
+	return predicate.Variable(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.Variable {
+	return predicate.Variable(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.Variable {
+	return predicate.Variable(sql.FieldNotNull(FieldUpdatedAt))
+}
+
 // EnvironmentIDEQ applies the EQ predicate on the "environment_id" field.
 func EnvironmentIDEQ(v int) predicate.Variable {
 	return predicate.Variable(sql.FieldEQ(FieldEnvironmentID, v))
@@ -323,6 +460,326 @@ func ExpandNotNil() predicate.Variable {
 	return predicate.Variable(sql.FieldNotNull(FieldExpand))
 }
 
+// SecretEQ applies the EQ predicate on the "secret" field.
+func SecretEQ(v bool) predicate.Variable {
+	return predicate.Variable(sql.FieldEQ(FieldSecret, v))
+}
+
+// SecretNEQ applies the NEQ predicate on the "secret" field.
+func SecretNEQ(v bool) predicate.Variable {
+	return predicate.Variable(sql.FieldNEQ(FieldSecret, v))
+}
+
+// SecretIsNil applies the IsNil predicate on the "secret" field.
+func SecretIsNil() predicate.Variable {
+	return predicate.Variable(sql.FieldIsNull(FieldSecret))
+}
+
+// SecretNotNil applies the NotNil predicate on the "secret" field.
+func SecretNotNil() predicate.Variable {
+	return predicate.Variable(sql.FieldNotNull(FieldSecret))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.Variable {
+	return predicate.Variable(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.Variable {
+	return predicate.Variable(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldContainsFold(FieldType, v))
+}
+
+// PatternEQ applies the EQ predicate on the "pattern" field.
+func PatternEQ(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldEQ(FieldPattern, v))
+}
+
+// PatternNEQ applies the NEQ predicate on the "pattern" field.
+func PatternNEQ(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldNEQ(FieldPattern, v))
+}
+
+// PatternIn applies the In predicate on the "pattern" field.
+func PatternIn(vs ...string) predicate.Variable {
+	return predicate.Variable(sql.FieldIn(FieldPattern, vs...))
+}
+
+// PatternNotIn applies the NotIn predicate on the "pattern" field.
+func PatternNotIn(vs ...string) predicate.Variable {
+	return predicate.Variable(sql.FieldNotIn(FieldPattern, vs...))
+}
+
+// PatternGT applies the GT predicate on the "pattern" field.
+func PatternGT(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldGT(FieldPattern, v))
+}
+
+// PatternGTE applies the GTE predicate on the "pattern" field.
+func PatternGTE(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldGTE(FieldPattern, v))
+}
+
+// PatternLT applies the LT predicate on the "pattern" field.
+func PatternLT(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldLT(FieldPattern, v))
+}
+
+// PatternLTE applies the LTE predicate on the "pattern" field.
+func PatternLTE(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldLTE(FieldPattern, v))
+}
+
+// PatternContains applies the Contains predicate on the "pattern" field.
+func PatternContains(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldContains(FieldPattern, v))
+}
+
+// PatternHasPrefix applies the HasPrefix predicate on the "pattern" field.
+func PatternHasPrefix(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldHasPrefix(FieldPattern, v))
+}
+
+// PatternHasSuffix applies the HasSuffix predicate on the "pattern" field.
+func PatternHasSuffix(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldHasSuffix(FieldPattern, v))
+}
+
+// PatternIsNil applies the IsNil predicate on the "pattern" field.
+func PatternIsNil() predicate.Variable {
+	return predicate.Variable(sql.FieldIsNull(FieldPattern))
+}
+
+// PatternNotNil applies the NotNil predicate on the "pattern" field.
+func PatternNotNil() predicate.Variable {
+	return predicate.Variable(sql.FieldNotNull(FieldPattern))
+}
+
+// PatternEqualFold applies the EqualFold predicate on the "pattern" field.
+func PatternEqualFold(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldEqualFold(FieldPattern, v))
+}
+
+// PatternContainsFold applies the ContainsFold predicate on the "pattern" field.
+func PatternContainsFold(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldContainsFold(FieldPattern, v))
+}
+
+// MinEQ applies the EQ predicate on the "min" field.
+func MinEQ(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldEQ(FieldMin, v))
+}
+
+// MinNEQ applies the NEQ predicate on the "min" field.
+func MinNEQ(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldNEQ(FieldMin, v))
+}
+
+// MinIn applies the In predicate on the "min" field.
+func MinIn(vs ...string) predicate.Variable {
+	return predicate.Variable(sql.FieldIn(FieldMin, vs...))
+}
+
+// MinNotIn applies the NotIn predicate on the "min" field.
+func MinNotIn(vs ...string) predicate.Variable {
+	return predicate.Variable(sql.FieldNotIn(FieldMin, vs...))
+}
+
+// MinGT applies the GT predicate on the "min" field.
+func MinGT(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldGT(FieldMin, v))
+}
+
+// MinGTE applies the GTE predicate on the "min" field.
+func MinGTE(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldGTE(FieldMin, v))
+}
+
+// MinLT applies the LT predicate on the "min" field.
+func MinLT(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldLT(FieldMin, v))
+}
+
+// MinLTE applies the LTE predicate on the "min" field.
+func MinLTE(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldLTE(FieldMin, v))
+}
+
+// MinContains applies the Contains predicate on the "min" field.
+func MinContains(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldContains(FieldMin, v))
+}
+
+// MinHasPrefix applies the HasPrefix predicate on the "min" field.
+func MinHasPrefix(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldHasPrefix(FieldMin, v))
+}
+
+// MinHasSuffix applies the HasSuffix predicate on the "min" field.
+func MinHasSuffix(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldHasSuffix(FieldMin, v))
+}
+
+// MinIsNil applies the IsNil predicate on the "min" field.
+func MinIsNil() predicate.Variable {
+	return predicate.Variable(sql.FieldIsNull(FieldMin))
+}
+
+// MinNotNil applies the NotNil predicate on the "min" field.
+func MinNotNil() predicate.Variable {
+	return predicate.Variable(sql.FieldNotNull(FieldMin))
+}
+
+// MinEqualFold applies the EqualFold predicate on the "min" field.
+func MinEqualFold(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldEqualFold(FieldMin, v))
+}
+
+// MinContainsFold applies the ContainsFold predicate on the "min" field.
+func MinContainsFold(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldContainsFold(FieldMin, v))
+}
+
+// MaxEQ applies the EQ predicate on the "max" field.
+func MaxEQ(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldEQ(FieldMax, v))
+}
+
+// MaxNEQ applies the NEQ predicate on the "max" field.
+func MaxNEQ(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldNEQ(FieldMax, v))
+}
+
+// MaxIn applies the In predicate on the "max" field.
+func MaxIn(vs ...string) predicate.Variable {
+	return predicate.Variable(sql.FieldIn(FieldMax, vs...))
+}
+
+// MaxNotIn applies the NotIn predicate on the "max" field.
+func MaxNotIn(vs ...string) predicate.Variable {
+	return predicate.Variable(sql.FieldNotIn(FieldMax, vs...))
+}
+
+// MaxGT applies the GT predicate on the "max" field.
+func MaxGT(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldGT(FieldMax, v))
+}
+
+// MaxGTE applies the GTE predicate on the "max" field.
+func MaxGTE(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldGTE(FieldMax, v))
+}
+
+// MaxLT applies the LT predicate on the "max" field.
+func MaxLT(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldLT(FieldMax, v))
+}
+
+// MaxLTE applies the LTE predicate on the "max" field.
+func MaxLTE(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldLTE(FieldMax, v))
+}
+
+// MaxContains applies the Contains predicate on the "max" field.
+func MaxContains(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldContains(FieldMax, v))
+}
+
+// MaxHasPrefix applies the HasPrefix predicate on the "max" field.
+func MaxHasPrefix(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldHasPrefix(FieldMax, v))
+}
+
+// MaxHasSuffix applies the HasSuffix predicate on the "max" field.
+func MaxHasSuffix(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldHasSuffix(FieldMax, v))
+}
+
+// MaxIsNil applies the IsNil predicate on the "max" field.
+func MaxIsNil() predicate.Variable {
+	return predicate.Variable(sql.FieldIsNull(FieldMax))
+}
+
+// MaxNotNil applies the NotNil predicate on the "max" field.
+func MaxNotNil() predicate.Variable {
+	return predicate.Variable(sql.FieldNotNull(FieldMax))
+}
+
+// MaxEqualFold applies the EqualFold predicate on the "max" field.
+func MaxEqualFold(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldEqualFold(FieldMax, v))
+}
+
+// MaxContainsFold applies the ContainsFold predicate on the "max" field.
+func MaxContainsFold(v string) predicate.Variable {
+	return predicate.Variable(sql.FieldContainsFold(FieldMax, v))
+}
+
+// EnumValuesIsNil applies the IsNil predicate on the "enum_values" field.
+func EnumValuesIsNil() predicate.Variable {
+	return predicate.Variable(sql.FieldIsNull(FieldEnumValues))
+}
+
+// EnumValuesNotNil applies the NotNil predicate on the "enum_values" field.
+func EnumValuesNotNil() predicate.Variable {
+	return predicate.Variable(sql.FieldNotNull(FieldEnumValues))
+}
+
 // HasEnvironment applies the HasEdge predicate on the "environment" edge.
 func HasEnvironment() predicate.Variable {
 	return predicate.Variable(func(s *sql.Selector) {
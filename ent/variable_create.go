@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -22,6 +23,34 @@ type VariableCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (vc *VariableCreate) SetCreatedAt(t time.Time) *VariableCreate {
+	vc.mutation.SetCreatedAt(t)
+	return vc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (vc *VariableCreate) SetNillableCreatedAt(t *time.Time) *VariableCreate {
+	if t != nil {
+		vc.SetCreatedAt(*t)
+	}
+	return vc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (vc *VariableCreate) SetUpdatedAt(t time.Time) *VariableCreate {
+	vc.mutation.SetUpdatedAt(t)
+	return vc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (vc *VariableCreate) SetNillableUpdatedAt(t *time.Time) *VariableCreate {
+	if t != nil {
+		vc.SetUpdatedAt(*t)
+	}
+	return vc
+}
+
 // SetEnvironmentID sets the "environment_id" field.
 func (vc *VariableCreate) SetEnvironmentID(i int) *VariableCreate {
 	vc.mutation.SetEnvironmentID(i)
@@ -68,6 +97,82 @@ func (vc *VariableCreate) SetNillableExpand(b *bool) *VariableCreate {
 	return vc
 }
 
+// SetSecret sets the "secret" field.
+func (vc *VariableCreate) SetSecret(b bool) *VariableCreate {
+	vc.mutation.SetSecret(b)
+	return vc
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (vc *VariableCreate) SetNillableSecret(b *bool) *VariableCreate {
+	if b != nil {
+		vc.SetSecret(*b)
+	}
+	return vc
+}
+
+// SetType sets the "type" field.
+func (vc *VariableCreate) SetType(s string) *VariableCreate {
+	vc.mutation.SetType(s)
+	return vc
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (vc *VariableCreate) SetNillableType(s *string) *VariableCreate {
+	if s != nil {
+		vc.SetType(*s)
+	}
+	return vc
+}
+
+// SetPattern sets the "pattern" field.
+func (vc *VariableCreate) SetPattern(s string) *VariableCreate {
+	vc.mutation.SetPattern(s)
+	return vc
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (vc *VariableCreate) SetNillablePattern(s *string) *VariableCreate {
+	if s != nil {
+		vc.SetPattern(*s)
+	}
+	return vc
+}
+
+// SetMin sets the "min" field.
+func (vc *VariableCreate) SetMin(s string) *VariableCreate {
+	vc.mutation.SetMin(s)
+	return vc
+}
+
+// SetNillableMin sets the "min" field if the given value is not nil.
+func (vc *VariableCreate) SetNillableMin(s *string) *VariableCreate {
+	if s != nil {
+		vc.SetMin(*s)
+	}
+	return vc
+}
+
+// SetMax sets the "max" field.
+func (vc *VariableCreate) SetMax(s string) *VariableCreate {
+	vc.mutation.SetMax(s)
+	return vc
+}
+
+// SetNillableMax sets the "max" field if the given value is not nil.
+func (vc *VariableCreate) SetNillableMax(s *string) *VariableCreate {
+	if s != nil {
+		vc.SetMax(*s)
+	}
+	return vc
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (vc *VariableCreate) SetEnumValues(s []string) *VariableCreate {
+	vc.mutation.SetEnumValues(s)
+	return vc
+}
+
 // SetEnvironment sets the "environment" edge to the Environment entity.
 func (vc *VariableCreate) SetEnvironment(e *Environment) *VariableCreate {
 	return vc.SetEnvironmentID(e.ID)
@@ -80,6 +185,9 @@ func (vc *VariableCreate) Mutation() *VariableMutation {
 
 // Save creates the Variable in the database.
 func (vc *VariableCreate) Save(ctx context.Context) (*Variable, error) {
+	if err := vc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, vc.sqlSave, vc.mutation, vc.hooks)
 }
 
@@ -105,6 +213,29 @@ func (vc *VariableCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (vc *VariableCreate) defaults() error {
+	if _, ok := vc.mutation.CreatedAt(); !ok {
+		if variable.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized variable.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := variable.DefaultCreatedAt()
+		vc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := vc.mutation.UpdatedAt(); !ok {
+		if variable.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized variable.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := variable.DefaultUpdatedAt()
+		vc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := vc.mutation.GetType(); !ok {
+		v := variable.DefaultType
+		vc.mutation.SetType(v)
+	}
+	return nil
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (vc *VariableCreate) check() error {
 	if _, ok := vc.mutation.EnvironmentID(); !ok {
@@ -121,6 +252,14 @@ func (vc *VariableCreate) check() error {
 	if _, ok := vc.mutation.Value(); !ok {
 		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "Variable.value"`)}
 	}
+	if _, ok := vc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Variable.type"`)}
+	}
+	if v, ok := vc.mutation.GetType(); ok {
+		if err := variable.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Variable.type": %w`, err)}
+		}
+	}
 	if len(vc.mutation.EnvironmentIDs()) == 0 {
 		return &ValidationError{Name: "environment", err: errors.New(`ent: missing required edge "Variable.environment"`)}
 	}
@@ -151,6 +290,14 @@ func (vc *VariableCreate) createSpec() (*Variable, *sqlgraph.CreateSpec) {
 		_spec = sqlgraph.NewCreateSpec(variable.Table, sqlgraph.NewFieldSpec(variable.FieldID, field.TypeInt))
 	)
 	_spec.OnConflict = vc.conflict
+	if value, ok := vc.mutation.CreatedAt(); ok {
+		_spec.SetField(variable.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := vc.mutation.UpdatedAt(); ok {
+		_spec.SetField(variable.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
 	if value, ok := vc.mutation.Name(); ok {
 		_spec.SetField(variable.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -167,6 +314,30 @@ func (vc *VariableCreate) createSpec() (*Variable, *sqlgraph.CreateSpec) {
 		_spec.SetField(variable.FieldExpand, field.TypeBool, value)
 		_node.Expand = value
 	}
+	if value, ok := vc.mutation.Secret(); ok {
+		_spec.SetField(variable.FieldSecret, field.TypeBool, value)
+		_node.Secret = value
+	}
+	if value, ok := vc.mutation.GetType(); ok {
+		_spec.SetField(variable.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := vc.mutation.Pattern(); ok {
+		_spec.SetField(variable.FieldPattern, field.TypeString, value)
+		_node.Pattern = value
+	}
+	if value, ok := vc.mutation.Min(); ok {
+		_spec.SetField(variable.FieldMin, field.TypeString, value)
+		_node.Min = value
+	}
+	if value, ok := vc.mutation.Max(); ok {
+		_spec.SetField(variable.FieldMax, field.TypeString, value)
+		_node.Max = value
+	}
+	if value, ok := vc.mutation.EnumValues(); ok {
+		_spec.SetField(variable.FieldEnumValues, field.TypeJSON, value)
+		_node.EnumValues = value
+	}
 	if nodes := vc.mutation.EnvironmentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -191,7 +362,7 @@ func (vc *VariableCreate) createSpec() (*Variable, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.Variable.Create().
-//		SetEnvironmentID(v).
+//		SetCreatedAt(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -200,7 +371,7 @@ func (vc *VariableCreate) createSpec() (*Variable, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.VariableUpsert) {
-//			SetEnvironmentID(v+v).
+//			SetCreatedAt(v+v).
 //		}).
 //		Exec(ctx)
 func (vc *VariableCreate) OnConflict(opts ...sql.ConflictOption) *VariableUpsertOne {
@@ -236,6 +407,24 @@ type (
 	}
 )
 
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VariableUpsert) SetUpdatedAt(v time.Time) *VariableUpsert {
+	u.Set(variable.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VariableUpsert) UpdateUpdatedAt() *VariableUpsert {
+	u.SetExcluded(variable.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *VariableUpsert) ClearUpdatedAt() *VariableUpsert {
+	u.SetNull(variable.FieldUpdatedAt)
+	return u
+}
+
 // SetEnvironmentID sets the "environment_id" field.
 func (u *VariableUpsert) SetEnvironmentID(v int) *VariableUpsert {
 	u.Set(variable.FieldEnvironmentID, v)
@@ -308,6 +497,108 @@ func (u *VariableUpsert) ClearExpand() *VariableUpsert {
 	return u
 }
 
+// SetSecret sets the "secret" field.
+func (u *VariableUpsert) SetSecret(v bool) *VariableUpsert {
+	u.Set(variable.FieldSecret, v)
+	return u
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *VariableUpsert) UpdateSecret() *VariableUpsert {
+	u.SetExcluded(variable.FieldSecret)
+	return u
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (u *VariableUpsert) ClearSecret() *VariableUpsert {
+	u.SetNull(variable.FieldSecret)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *VariableUpsert) SetType(v string) *VariableUpsert {
+	u.Set(variable.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *VariableUpsert) UpdateType() *VariableUpsert {
+	u.SetExcluded(variable.FieldType)
+	return u
+}
+
+// SetPattern sets the "pattern" field.
+func (u *VariableUpsert) SetPattern(v string) *VariableUpsert {
+	u.Set(variable.FieldPattern, v)
+	return u
+}
+
+// UpdatePattern sets the "pattern" field to the value that was provided on create.
+func (u *VariableUpsert) UpdatePattern() *VariableUpsert {
+	u.SetExcluded(variable.FieldPattern)
+	return u
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (u *VariableUpsert) ClearPattern() *VariableUpsert {
+	u.SetNull(variable.FieldPattern)
+	return u
+}
+
+// SetMin sets the "min" field.
+func (u *VariableUpsert) SetMin(v string) *VariableUpsert {
+	u.Set(variable.FieldMin, v)
+	return u
+}
+
+// UpdateMin sets the "min" field to the value that was provided on create.
+func (u *VariableUpsert) UpdateMin() *VariableUpsert {
+	u.SetExcluded(variable.FieldMin)
+	return u
+}
+
+// ClearMin clears the value of the "min" field.
+func (u *VariableUpsert) ClearMin() *VariableUpsert {
+	u.SetNull(variable.FieldMin)
+	return u
+}
+
+// SetMax sets the "max" field.
+func (u *VariableUpsert) SetMax(v string) *VariableUpsert {
+	u.Set(variable.FieldMax, v)
+	return u
+}
+
+// UpdateMax sets the "max" field to the value that was provided on create.
+func (u *VariableUpsert) UpdateMax() *VariableUpsert {
+	u.SetExcluded(variable.FieldMax)
+	return u
+}
+
+// ClearMax clears the value of the "max" field.
+func (u *VariableUpsert) ClearMax() *VariableUpsert {
+	u.SetNull(variable.FieldMax)
+	return u
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (u *VariableUpsert) SetEnumValues(v []string) *VariableUpsert {
+	u.Set(variable.FieldEnumValues, v)
+	return u
+}
+
+// UpdateEnumValues sets the "enum_values" field to the value that was provided on create.
+func (u *VariableUpsert) UpdateEnumValues() *VariableUpsert {
+	u.SetExcluded(variable.FieldEnumValues)
+	return u
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (u *VariableUpsert) ClearEnumValues() *VariableUpsert {
+	u.SetNull(variable.FieldEnumValues)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -318,6 +609,11 @@ func (u *VariableUpsert) ClearExpand() *VariableUpsert {
 //		Exec(ctx)
 func (u *VariableUpsertOne) UpdateNewValues() *VariableUpsertOne {
 	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(variable.FieldCreatedAt)
+		}
+	}))
 	return u
 }
 
@@ -348,6 +644,27 @@ func (u *VariableUpsertOne) Update(set func(*VariableUpsert)) *VariableUpsertOne
 	return u
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VariableUpsertOne) SetUpdatedAt(v time.Time) *VariableUpsertOne {
+	return u.Update(func(s *VariableUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VariableUpsertOne) UpdateUpdatedAt() *VariableUpsertOne {
+	return u.Update(func(s *VariableUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *VariableUpsertOne) ClearUpdatedAt() *VariableUpsertOne {
+	return u.Update(func(s *VariableUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
 // SetEnvironmentID sets the "environment_id" field.
 func (u *VariableUpsertOne) SetEnvironmentID(v int) *VariableUpsertOne {
 	return u.Update(func(s *VariableUpsert) {
@@ -432,6 +749,125 @@ func (u *VariableUpsertOne) ClearExpand() *VariableUpsertOne {
 	})
 }
 
+// SetSecret sets the "secret" field.
+func (u *VariableUpsertOne) SetSecret(v bool) *VariableUpsertOne {
+	return u.Update(func(s *VariableUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *VariableUpsertOne) UpdateSecret() *VariableUpsertOne {
+	return u.Update(func(s *VariableUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (u *VariableUpsertOne) ClearSecret() *VariableUpsertOne {
+	return u.Update(func(s *VariableUpsert) {
+		s.ClearSecret()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *VariableUpsertOne) SetType(v string) *VariableUpsertOne {
+	return u.Update(func(s *VariableUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *VariableUpsertOne) UpdateType() *VariableUpsertOne {
+	return u.Update(func(s *VariableUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetPattern sets the "pattern" field.
+func (u *VariableUpsertOne) SetPattern(v string) *VariableUpsertOne {
+	return u.Update(func(s *VariableUpsert) {
+		s.SetPattern(v)
+	})
+}
+
+// UpdatePattern sets the "pattern" field to the value that was provided on create.
+func (u *VariableUpsertOne) UpdatePattern() *VariableUpsertOne {
+	return u.Update(func(s *VariableUpsert) {
+		s.UpdatePattern()
+	})
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (u *VariableUpsertOne) ClearPattern() *VariableUpsertOne {
+	return u.Update(func(s *VariableUpsert) {
+		s.ClearPattern()
+	})
+}
+
+// SetMin sets the "min" field.
+func (u *VariableUpsertOne) SetMin(v string) *VariableUpsertOne {
+	return u.Update(func(s *VariableUpsert) {
+		s.SetMin(v)
+	})
+}
+
+// UpdateMin sets the "min" field to the value that was provided on create.
+func (u *VariableUpsertOne) UpdateMin() *VariableUpsertOne {
+	return u.Update(func(s *VariableUpsert) {
+		s.UpdateMin()
+	})
+}
+
+// ClearMin clears the value of the "min" field.
+func (u *VariableUpsertOne) ClearMin() *VariableUpsertOne {
+	return u.Update(func(s *VariableUpsert) {
+		s.ClearMin()
+	})
+}
+
+// SetMax sets the "max" field.
+func (u *VariableUpsertOne) SetMax(v string) *VariableUpsertOne {
+	return u.Update(func(s *VariableUpsert) {
+		s.SetMax(v)
+	})
+}
+
+// UpdateMax sets the "max" field to the value that was provided on create.
+func (u *VariableUpsertOne) UpdateMax() *VariableUpsertOne {
+	return u.Update(func(s *VariableUpsert) {
+		s.UpdateMax()
+	})
+}
+
+// ClearMax clears the value of the "max" field.
+func (u *VariableUpsertOne) ClearMax() *VariableUpsertOne {
+	return u.Update(func(s *VariableUpsert) {
+		s.ClearMax()
+	})
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (u *VariableUpsertOne) SetEnumValues(v []string) *VariableUpsertOne {
+	return u.Update(func(s *VariableUpsert) {
+		s.SetEnumValues(v)
+	})
+}
+
+// UpdateEnumValues sets the "enum_values" field to the value that was provided on create.
+func (u *VariableUpsertOne) UpdateEnumValues() *VariableUpsertOne {
+	return u.Update(func(s *VariableUpsert) {
+		s.UpdateEnumValues()
+	})
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (u *VariableUpsertOne) ClearEnumValues() *VariableUpsertOne {
+	return u.Update(func(s *VariableUpsert) {
+		s.ClearEnumValues()
+	})
+}
+
 // Exec executes the query.
 func (u *VariableUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -484,6 +920,7 @@ func (vcb *VariableCreateBulk) Save(ctx context.Context) ([]*Variable, error) {
 	for i := range vcb.builders {
 		func(i int, root context.Context) {
 			builder := vcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*VariableMutation)
 				if !ok {
@@ -566,7 +1003,7 @@ func (vcb *VariableCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.VariableUpsert) {
-//			SetEnvironmentID(v+v).
+//			SetCreatedAt(v+v).
 //		}).
 //		Exec(ctx)
 func (vcb *VariableCreateBulk) OnConflict(opts ...sql.ConflictOption) *VariableUpsertBulk {
@@ -605,6 +1042,13 @@ type VariableUpsertBulk struct {
 //		Exec(ctx)
 func (u *VariableUpsertBulk) UpdateNewValues() *VariableUpsertBulk {
 	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(variable.FieldCreatedAt)
+			}
+		}
+	}))
 	return u
 }
 
@@ -635,6 +1079,27 @@ func (u *VariableUpsertBulk) Update(set func(*VariableUpsert)) *VariableUpsertBu
 	return u
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VariableUpsertBulk) SetUpdatedAt(v time.Time) *VariableUpsertBulk {
+	return u.Update(func(s *VariableUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VariableUpsertBulk) UpdateUpdatedAt() *VariableUpsertBulk {
+	return u.Update(func(s *VariableUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *VariableUpsertBulk) ClearUpdatedAt() *VariableUpsertBulk {
+	return u.Update(func(s *VariableUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
 // SetEnvironmentID sets the "environment_id" field.
 func (u *VariableUpsertBulk) SetEnvironmentID(v int) *VariableUpsertBulk {
 	return u.Update(func(s *VariableUpsert) {
@@ -719,6 +1184,125 @@ func (u *VariableUpsertBulk) ClearExpand() *VariableUpsertBulk {
 	})
 }
 
+// SetSecret sets the "secret" field.
+func (u *VariableUpsertBulk) SetSecret(v bool) *VariableUpsertBulk {
+	return u.Update(func(s *VariableUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *VariableUpsertBulk) UpdateSecret() *VariableUpsertBulk {
+	return u.Update(func(s *VariableUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (u *VariableUpsertBulk) ClearSecret() *VariableUpsertBulk {
+	return u.Update(func(s *VariableUpsert) {
+		s.ClearSecret()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *VariableUpsertBulk) SetType(v string) *VariableUpsertBulk {
+	return u.Update(func(s *VariableUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *VariableUpsertBulk) UpdateType() *VariableUpsertBulk {
+	return u.Update(func(s *VariableUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetPattern sets the "pattern" field.
+func (u *VariableUpsertBulk) SetPattern(v string) *VariableUpsertBulk {
+	return u.Update(func(s *VariableUpsert) {
+		s.SetPattern(v)
+	})
+}
+
+// UpdatePattern sets the "pattern" field to the value that was provided on create.
+func (u *VariableUpsertBulk) UpdatePattern() *VariableUpsertBulk {
+	return u.Update(func(s *VariableUpsert) {
+		s.UpdatePattern()
+	})
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (u *VariableUpsertBulk) ClearPattern() *VariableUpsertBulk {
+	return u.Update(func(s *VariableUpsert) {
+		s.ClearPattern()
+	})
+}
+
+// SetMin sets the "min" field.
+func (u *VariableUpsertBulk) SetMin(v string) *VariableUpsertBulk {
+	return u.Update(func(s *VariableUpsert) {
+		s.SetMin(v)
+	})
+}
+
+// UpdateMin sets the "min" field to the value that was provided on create.
+func (u *VariableUpsertBulk) UpdateMin() *VariableUpsertBulk {
+	return u.Update(func(s *VariableUpsert) {
+		s.UpdateMin()
+	})
+}
+
+// ClearMin clears the value of the "min" field.
+func (u *VariableUpsertBulk) ClearMin() *VariableUpsertBulk {
+	return u.Update(func(s *VariableUpsert) {
+		s.ClearMin()
+	})
+}
+
+// SetMax sets the "max" field.
+func (u *VariableUpsertBulk) SetMax(v string) *VariableUpsertBulk {
+	return u.Update(func(s *VariableUpsert) {
+		s.SetMax(v)
+	})
+}
+
+// UpdateMax sets the "max" field to the value that was provided on create.
+func (u *VariableUpsertBulk) UpdateMax() *VariableUpsertBulk {
+	return u.Update(func(s *VariableUpsert) {
+		s.UpdateMax()
+	})
+}
+
+// ClearMax clears the value of the "max" field.
+func (u *VariableUpsertBulk) ClearMax() *VariableUpsertBulk {
+	return u.Update(func(s *VariableUpsert) {
+		s.ClearMax()
+	})
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (u *VariableUpsertBulk) SetEnumValues(v []string) *VariableUpsertBulk {
+	return u.Update(func(s *VariableUpsert) {
+		s.SetEnumValues(v)
+	})
+}
+
+// UpdateEnumValues sets the "enum_values" field to the value that was provided on create.
+func (u *VariableUpsertBulk) UpdateEnumValues() *VariableUpsertBulk {
+	return u.Update(func(s *VariableUpsert) {
+		s.UpdateEnumValues()
+	})
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (u *VariableUpsertBulk) ClearEnumValues() *VariableUpsertBulk {
+	return u.Update(func(s *VariableUpsert) {
+		s.ClearEnumValues()
+	})
+}
+
 // Exec executes the query.
 func (u *VariableUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
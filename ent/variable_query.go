@@ -298,12 +298,12 @@ func (vq *VariableQuery) WithEnvironment(opts ...func(*EnvironmentQuery)) *Varia
 // Example:
 //
 //	var v []struct {
-//		EnvironmentID int `json:"environment_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Variable.Query().
-//		GroupBy(variable.FieldEnvironmentID).
+//		GroupBy(variable.FieldCreatedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (vq *VariableQuery) GroupBy(field string, fields ...string) *VariableGroupBy {
@@ -321,11 +321,11 @@ func (vq *VariableQuery) GroupBy(field string, fields ...string) *VariableGroupB
 // Example:
 //
 //	var v []struct {
-//		EnvironmentID int `json:"environment_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
 //	client.Variable.Query().
-//		Select(variable.FieldEnvironmentID).
+//		Select(variable.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (vq *VariableQuery) Select(fields ...string) *VariableSelect {
 	vq.ctx.Fields = append(vq.ctx.Fields, fields...)
@@ -6,9 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/kechako/envoke/ent/environment"
 	"github.com/kechako/envoke/ent/predicate"
@@ -28,6 +30,18 @@ func (vu *VariableUpdate) Where(ps ...predicate.Variable) *VariableUpdate {
 	return vu
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (vu *VariableUpdate) SetUpdatedAt(t time.Time) *VariableUpdate {
+	vu.mutation.SetUpdatedAt(t)
+	return vu
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (vu *VariableUpdate) ClearUpdatedAt() *VariableUpdate {
+	vu.mutation.ClearUpdatedAt()
+	return vu
+}
+
 // SetEnvironmentID sets the "environment_id" field.
 func (vu *VariableUpdate) SetEnvironmentID(i int) *VariableUpdate {
 	vu.mutation.SetEnvironmentID(i)
@@ -110,6 +124,118 @@ func (vu *VariableUpdate) ClearExpand() *VariableUpdate {
 	return vu
 }
 
+// SetSecret sets the "secret" field.
+func (vu *VariableUpdate) SetSecret(b bool) *VariableUpdate {
+	vu.mutation.SetSecret(b)
+	return vu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (vu *VariableUpdate) SetNillableSecret(b *bool) *VariableUpdate {
+	if b != nil {
+		vu.SetSecret(*b)
+	}
+	return vu
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (vu *VariableUpdate) ClearSecret() *VariableUpdate {
+	vu.mutation.ClearSecret()
+	return vu
+}
+
+// SetType sets the "type" field.
+func (vu *VariableUpdate) SetType(s string) *VariableUpdate {
+	vu.mutation.SetType(s)
+	return vu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (vu *VariableUpdate) SetNillableType(s *string) *VariableUpdate {
+	if s != nil {
+		vu.SetType(*s)
+	}
+	return vu
+}
+
+// SetPattern sets the "pattern" field.
+func (vu *VariableUpdate) SetPattern(s string) *VariableUpdate {
+	vu.mutation.SetPattern(s)
+	return vu
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (vu *VariableUpdate) SetNillablePattern(s *string) *VariableUpdate {
+	if s != nil {
+		vu.SetPattern(*s)
+	}
+	return vu
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (vu *VariableUpdate) ClearPattern() *VariableUpdate {
+	vu.mutation.ClearPattern()
+	return vu
+}
+
+// SetMin sets the "min" field.
+func (vu *VariableUpdate) SetMin(s string) *VariableUpdate {
+	vu.mutation.SetMin(s)
+	return vu
+}
+
+// SetNillableMin sets the "min" field if the given value is not nil.
+func (vu *VariableUpdate) SetNillableMin(s *string) *VariableUpdate {
+	if s != nil {
+		vu.SetMin(*s)
+	}
+	return vu
+}
+
+// ClearMin clears the value of the "min" field.
+func (vu *VariableUpdate) ClearMin() *VariableUpdate {
+	vu.mutation.ClearMin()
+	return vu
+}
+
+// SetMax sets the "max" field.
+func (vu *VariableUpdate) SetMax(s string) *VariableUpdate {
+	vu.mutation.SetMax(s)
+	return vu
+}
+
+// SetNillableMax sets the "max" field if the given value is not nil.
+func (vu *VariableUpdate) SetNillableMax(s *string) *VariableUpdate {
+	if s != nil {
+		vu.SetMax(*s)
+	}
+	return vu
+}
+
+// ClearMax clears the value of the "max" field.
+func (vu *VariableUpdate) ClearMax() *VariableUpdate {
+	vu.mutation.ClearMax()
+	return vu
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (vu *VariableUpdate) SetEnumValues(s []string) *VariableUpdate {
+	vu.mutation.SetEnumValues(s)
+	return vu
+}
+
+// AppendEnumValues appends s to the "enum_values" field.
+func (vu *VariableUpdate) AppendEnumValues(s []string) *VariableUpdate {
+	vu.mutation.AppendEnumValues(s)
+	return vu
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (vu *VariableUpdate) ClearEnumValues() *VariableUpdate {
+	vu.mutation.ClearEnumValues()
+	return vu
+}
+
 // SetEnvironment sets the "environment" edge to the Environment entity.
 func (vu *VariableUpdate) SetEnvironment(e *Environment) *VariableUpdate {
 	return vu.SetEnvironmentID(e.ID)
@@ -128,6 +254,9 @@ func (vu *VariableUpdate) ClearEnvironment() *VariableUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (vu *VariableUpdate) Save(ctx context.Context) (int, error) {
+	if err := vu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, vu.sqlSave, vu.mutation, vu.hooks)
 }
 
@@ -153,6 +282,18 @@ func (vu *VariableUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (vu *VariableUpdate) defaults() error {
+	if _, ok := vu.mutation.UpdatedAt(); !ok && !vu.mutation.UpdatedAtCleared() {
+		if variable.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized variable.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := variable.UpdateDefaultUpdatedAt()
+		vu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (vu *VariableUpdate) check() error {
 	if v, ok := vu.mutation.Name(); ok {
@@ -160,6 +301,11 @@ func (vu *VariableUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Variable.name": %w`, err)}
 		}
 	}
+	if v, ok := vu.mutation.GetType(); ok {
+		if err := variable.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Variable.type": %w`, err)}
+		}
+	}
 	if vu.mutation.EnvironmentCleared() && len(vu.mutation.EnvironmentIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Variable.environment"`)
 	}
@@ -178,6 +324,15 @@ func (vu *VariableUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if vu.mutation.CreatedAtCleared() {
+		_spec.ClearField(variable.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := vu.mutation.UpdatedAt(); ok {
+		_spec.SetField(variable.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if vu.mutation.UpdatedAtCleared() {
+		_spec.ClearField(variable.FieldUpdatedAt, field.TypeTime)
+	}
 	if value, ok := vu.mutation.Name(); ok {
 		_spec.SetField(variable.FieldName, field.TypeString, value)
 	}
@@ -196,6 +351,44 @@ func (vu *VariableUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if vu.mutation.ExpandCleared() {
 		_spec.ClearField(variable.FieldExpand, field.TypeBool)
 	}
+	if value, ok := vu.mutation.Secret(); ok {
+		_spec.SetField(variable.FieldSecret, field.TypeBool, value)
+	}
+	if vu.mutation.SecretCleared() {
+		_spec.ClearField(variable.FieldSecret, field.TypeBool)
+	}
+	if value, ok := vu.mutation.GetType(); ok {
+		_spec.SetField(variable.FieldType, field.TypeString, value)
+	}
+	if value, ok := vu.mutation.Pattern(); ok {
+		_spec.SetField(variable.FieldPattern, field.TypeString, value)
+	}
+	if vu.mutation.PatternCleared() {
+		_spec.ClearField(variable.FieldPattern, field.TypeString)
+	}
+	if value, ok := vu.mutation.Min(); ok {
+		_spec.SetField(variable.FieldMin, field.TypeString, value)
+	}
+	if vu.mutation.MinCleared() {
+		_spec.ClearField(variable.FieldMin, field.TypeString)
+	}
+	if value, ok := vu.mutation.Max(); ok {
+		_spec.SetField(variable.FieldMax, field.TypeString, value)
+	}
+	if vu.mutation.MaxCleared() {
+		_spec.ClearField(variable.FieldMax, field.TypeString)
+	}
+	if value, ok := vu.mutation.EnumValues(); ok {
+		_spec.SetField(variable.FieldEnumValues, field.TypeJSON, value)
+	}
+	if value, ok := vu.mutation.AppendedEnumValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, variable.FieldEnumValues, value)
+		})
+	}
+	if vu.mutation.EnumValuesCleared() {
+		_spec.ClearField(variable.FieldEnumValues, field.TypeJSON)
+	}
 	if vu.mutation.EnvironmentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -245,6 +438,18 @@ type VariableUpdateOne struct {
 	mutation *VariableMutation
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (vuo *VariableUpdateOne) SetUpdatedAt(t time.Time) *VariableUpdateOne {
+	vuo.mutation.SetUpdatedAt(t)
+	return vuo
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (vuo *VariableUpdateOne) ClearUpdatedAt() *VariableUpdateOne {
+	vuo.mutation.ClearUpdatedAt()
+	return vuo
+}
+
 // SetEnvironmentID sets the "environment_id" field.
 func (vuo *VariableUpdateOne) SetEnvironmentID(i int) *VariableUpdateOne {
 	vuo.mutation.SetEnvironmentID(i)
@@ -327,6 +532,118 @@ func (vuo *VariableUpdateOne) ClearExpand() *VariableUpdateOne {
 	return vuo
 }
 
+// SetSecret sets the "secret" field.
+func (vuo *VariableUpdateOne) SetSecret(b bool) *VariableUpdateOne {
+	vuo.mutation.SetSecret(b)
+	return vuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (vuo *VariableUpdateOne) SetNillableSecret(b *bool) *VariableUpdateOne {
+	if b != nil {
+		vuo.SetSecret(*b)
+	}
+	return vuo
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (vuo *VariableUpdateOne) ClearSecret() *VariableUpdateOne {
+	vuo.mutation.ClearSecret()
+	return vuo
+}
+
+// SetType sets the "type" field.
+func (vuo *VariableUpdateOne) SetType(s string) *VariableUpdateOne {
+	vuo.mutation.SetType(s)
+	return vuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (vuo *VariableUpdateOne) SetNillableType(s *string) *VariableUpdateOne {
+	if s != nil {
+		vuo.SetType(*s)
+	}
+	return vuo
+}
+
+// SetPattern sets the "pattern" field.
+func (vuo *VariableUpdateOne) SetPattern(s string) *VariableUpdateOne {
+	vuo.mutation.SetPattern(s)
+	return vuo
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (vuo *VariableUpdateOne) SetNillablePattern(s *string) *VariableUpdateOne {
+	if s != nil {
+		vuo.SetPattern(*s)
+	}
+	return vuo
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (vuo *VariableUpdateOne) ClearPattern() *VariableUpdateOne {
+	vuo.mutation.ClearPattern()
+	return vuo
+}
+
+// SetMin sets the "min" field.
+func (vuo *VariableUpdateOne) SetMin(s string) *VariableUpdateOne {
+	vuo.mutation.SetMin(s)
+	return vuo
+}
+
+// SetNillableMin sets the "min" field if the given value is not nil.
+func (vuo *VariableUpdateOne) SetNillableMin(s *string) *VariableUpdateOne {
+	if s != nil {
+		vuo.SetMin(*s)
+	}
+	return vuo
+}
+
+// ClearMin clears the value of the "min" field.
+func (vuo *VariableUpdateOne) ClearMin() *VariableUpdateOne {
+	vuo.mutation.ClearMin()
+	return vuo
+}
+
+// SetMax sets the "max" field.
+func (vuo *VariableUpdateOne) SetMax(s string) *VariableUpdateOne {
+	vuo.mutation.SetMax(s)
+	return vuo
+}
+
+// SetNillableMax sets the "max" field if the given value is not nil.
+func (vuo *VariableUpdateOne) SetNillableMax(s *string) *VariableUpdateOne {
+	if s != nil {
+		vuo.SetMax(*s)
+	}
+	return vuo
+}
+
+// ClearMax clears the value of the "max" field.
+func (vuo *VariableUpdateOne) ClearMax() *VariableUpdateOne {
+	vuo.mutation.ClearMax()
+	return vuo
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (vuo *VariableUpdateOne) SetEnumValues(s []string) *VariableUpdateOne {
+	vuo.mutation.SetEnumValues(s)
+	return vuo
+}
+
+// AppendEnumValues appends s to the "enum_values" field.
+func (vuo *VariableUpdateOne) AppendEnumValues(s []string) *VariableUpdateOne {
+	vuo.mutation.AppendEnumValues(s)
+	return vuo
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (vuo *VariableUpdateOne) ClearEnumValues() *VariableUpdateOne {
+	vuo.mutation.ClearEnumValues()
+	return vuo
+}
+
 // SetEnvironment sets the "environment" edge to the Environment entity.
 func (vuo *VariableUpdateOne) SetEnvironment(e *Environment) *VariableUpdateOne {
 	return vuo.SetEnvironmentID(e.ID)
@@ -358,6 +675,9 @@ func (vuo *VariableUpdateOne) Select(field string, fields ...string) *VariableUp
 
 // Save executes the query and returns the updated Variable entity.
 func (vuo *VariableUpdateOne) Save(ctx context.Context) (*Variable, error) {
+	if err := vuo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, vuo.sqlSave, vuo.mutation, vuo.hooks)
 }
 
@@ -383,6 +703,18 @@ func (vuo *VariableUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (vuo *VariableUpdateOne) defaults() error {
+	if _, ok := vuo.mutation.UpdatedAt(); !ok && !vuo.mutation.UpdatedAtCleared() {
+		if variable.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized variable.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := variable.UpdateDefaultUpdatedAt()
+		vuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (vuo *VariableUpdateOne) check() error {
 	if v, ok := vuo.mutation.Name(); ok {
@@ -390,6 +722,11 @@ func (vuo *VariableUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Variable.name": %w`, err)}
 		}
 	}
+	if v, ok := vuo.mutation.GetType(); ok {
+		if err := variable.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Variable.type": %w`, err)}
+		}
+	}
 	if vuo.mutation.EnvironmentCleared() && len(vuo.mutation.EnvironmentIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Variable.environment"`)
 	}
@@ -425,6 +762,15 @@ func (vuo *VariableUpdateOne) sqlSave(ctx context.Context) (_node *Variable, err
 			}
 		}
 	}
+	if vuo.mutation.CreatedAtCleared() {
+		_spec.ClearField(variable.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := vuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(variable.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if vuo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(variable.FieldUpdatedAt, field.TypeTime)
+	}
 	if value, ok := vuo.mutation.Name(); ok {
 		_spec.SetField(variable.FieldName, field.TypeString, value)
 	}
@@ -443,6 +789,44 @@ func (vuo *VariableUpdateOne) sqlSave(ctx context.Context) (_node *Variable, err
 	if vuo.mutation.ExpandCleared() {
 		_spec.ClearField(variable.FieldExpand, field.TypeBool)
 	}
+	if value, ok := vuo.mutation.Secret(); ok {
+		_spec.SetField(variable.FieldSecret, field.TypeBool, value)
+	}
+	if vuo.mutation.SecretCleared() {
+		_spec.ClearField(variable.FieldSecret, field.TypeBool)
+	}
+	if value, ok := vuo.mutation.GetType(); ok {
+		_spec.SetField(variable.FieldType, field.TypeString, value)
+	}
+	if value, ok := vuo.mutation.Pattern(); ok {
+		_spec.SetField(variable.FieldPattern, field.TypeString, value)
+	}
+	if vuo.mutation.PatternCleared() {
+		_spec.ClearField(variable.FieldPattern, field.TypeString)
+	}
+	if value, ok := vuo.mutation.Min(); ok {
+		_spec.SetField(variable.FieldMin, field.TypeString, value)
+	}
+	if vuo.mutation.MinCleared() {
+		_spec.ClearField(variable.FieldMin, field.TypeString)
+	}
+	if value, ok := vuo.mutation.Max(); ok {
+		_spec.SetField(variable.FieldMax, field.TypeString, value)
+	}
+	if vuo.mutation.MaxCleared() {
+		_spec.ClearField(variable.FieldMax, field.TypeString)
+	}
+	if value, ok := vuo.mutation.EnumValues(); ok {
+		_spec.SetField(variable.FieldEnumValues, field.TypeJSON, value)
+	}
+	if value, ok := vuo.mutation.AppendedEnumValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, variable.FieldEnumValues, value)
+		})
+	}
+	if vuo.mutation.EnumValuesCleared() {
+		_spec.ClearField(variable.FieldEnumValues, field.TypeJSON)
+	}
 	if vuo.mutation.EnvironmentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -10,6 +10,7 @@ require (
 	github.com/mattn/go-sqlite3 v1.14.28
 	github.com/rodaine/table v1.3.0
 	github.com/spf13/cobra v1.9.1
+	golang.org/x/term v0.30.0
 )
 
 require (
@@ -0,0 +1,173 @@
+// Package project provides functionality to load per-project settings from a project file.
+package project
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/kechako/envoke/config"
+)
+
+// FileName is the name of the project file.
+const FileName = ".envoke.yaml"
+
+type Project struct {
+	// Path is the absolute path of the project file.
+	Path string `yaml:"-"`
+	// Hash is the SHA-256 hash of the content of the project file.
+	Hash string `yaml:"-"`
+
+	// Environment is the name of the environment of the project.
+	Environment string `yaml:"environment"`
+	// EnvPrefix is prepended to the environment names of the project.
+	EnvPrefix string `yaml:"env_prefix"`
+	// Required is the list of variables that must be defined to run a command.
+	Required []config.RequiredVariable `yaml:"required"`
+	// Export holds the defaults of the export command. A relative path is
+	// relative to the directory of the project file, and the path must be
+	// inside that directory.
+	Export config.ExportConfig `yaml:"export"`
+	// Run holds the settings of the run command.
+	Run config.RunConfig `yaml:"run"`
+}
+
+// Merge returns a copy of cfg with the settings of the project file taking
+// precedence over it. Settings that are not set in the project file are
+// inherited from cfg.
+func (p *Project) Merge(cfg *config.Config) *config.Config {
+	merged := *cfg
+
+	if p.Environment != "" {
+		merged.Environment = p.Environment
+	}
+	if p.EnvPrefix != "" {
+		merged.EnvPrefix = p.EnvPrefix
+	}
+	if p.Required != nil {
+		merged.Required = p.Required
+	}
+	if p.Export.Path != "" {
+		merged.Export.Path = p.Export.Path
+		if !filepath.IsAbs(merged.Export.Path) {
+			merged.Export.Path = filepath.Join(p.Dir(), merged.Export.Path)
+		}
+	}
+	if p.Export.Format != "" {
+		merged.Export.Format = p.Export.Format
+	}
+	if p.Run.CleanKeep != nil {
+		merged.Run.CleanKeep = p.Run.CleanKeep
+	}
+
+	return &merged
+}
+
+// Dir returns the directory containing the project file.
+func (p *Project) Dir() string {
+	return filepath.Dir(p.Path)
+}
+
+// FindPath searches for the project file in dir and its parent directories,
+// and returns its absolute path. It returns an empty string if no project
+// file is found.
+func FindPath(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		name := filepath.Join(dir, FileName)
+		info, err := os.Stat(name)
+		if err == nil && !info.IsDir() {
+			return name, nil
+		}
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("unable to access project file '%s': %w", name, err)
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// Find searches for the project file in dir and its parent directories,
+// and loads it. It returns nil if no project file is found.
+func Find(dir string) (*Project, error) {
+	name, err := FindPath(dir)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return nil, nil
+	}
+
+	return Load(name)
+}
+
+// Load loads the project file.
+func Load(name string) (*Project, error) {
+	name, err := filepath.Abs(name)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read project file '%s': %w", name, err)
+	}
+
+	var p Project
+	if len(bytes.TrimSpace(data)) > 0 {
+		err = yaml.Unmarshal(data, &p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid project file '%s': %w", name, err)
+		}
+		err = config.ValidateRequired(p.Required)
+		if err != nil {
+			return nil, fmt.Errorf("invalid project file '%s': %w", name, err)
+		}
+		err = validateExportPath(filepath.Dir(name), p.Export.Path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid project file '%s': %w", name, err)
+		}
+	}
+
+	p.Path = name
+	p.Hash = Hash(data)
+
+	return &p, nil
+}
+
+// validateExportPath checks that the export path of a project file in dir
+// resolves inside dir, so that a project file cannot make the export command
+// write variables to an arbitrary file.
+func validateExportPath(dir, path string) error {
+	if path == "" {
+		return nil
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+	rel, err := filepath.Rel(dir, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("export path '%s' is outside the project directory", path)
+	}
+	return nil
+}
+
+// Hash returns the hash of the content of a project file.
+func Hash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,123 @@
+// Package varname provides validation of variable names.
+package varname
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Policy is the set of rules that variable names must follow.
+type Policy string
+
+const (
+	// POSIX allows uppercase letters, digits and underscores, as the names
+	// of the variables used by POSIX utilities.
+	POSIX Policy = "posix"
+	// AllowLowercase allows letters, digits and underscores, as the names
+	// of shell variables.
+	AllowLowercase Policy = "allow-lowercase"
+	// AllowDots is like AllowLowercase, but also allows dots, as the names
+	// of Java-style properties.
+	AllowDots Policy = "allow-dots"
+)
+
+// DefaultPolicy is used when no policy is configured.
+const DefaultPolicy = AllowLowercase
+
+// Policies is the list of all policies.
+var Policies = []Policy{POSIX, AllowLowercase, AllowDots}
+
+var patterns = map[Policy]*regexp.Regexp{
+	POSIX:          regexp.MustCompile(`^[A-Z_][A-Z0-9_]*$`),
+	AllowLowercase: regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`),
+	AllowDots:      regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`),
+}
+
+var descriptions = map[Policy]string{
+	POSIX:          "uppercase letters, digits and underscores",
+	AllowLowercase: "letters, digits and underscores",
+	AllowDots:      "letters, digits, underscores and dots",
+}
+
+// ParsePolicy parses the name of a policy. An empty string is parsed as
+// DefaultPolicy.
+func ParsePolicy(s string) (Policy, error) {
+	if s == "" {
+		return DefaultPolicy, nil
+	}
+	p := Policy(s)
+	if _, ok := patterns[p]; !ok {
+		names := make([]string, len(Policies))
+		for i, p := range Policies {
+			names[i] = string(p)
+		}
+		return "", fmt.Errorf("invalid name policy '%s' (must be one of %s)", s, strings.Join(names, ", "))
+	}
+	return p, nil
+}
+
+// Validate checks that name follows the policy.
+func (p Policy) Validate(name string) error {
+	if name == "" {
+		return fmt.Errorf("variable name cannot be empty")
+	}
+	if !patterns[p].MatchString(name) {
+		return fmt.Errorf("invalid variable name '%s' (must consist of %s, and must not start with a digit)", name, descriptions[p])
+	}
+	return nil
+}
+
+// Fix returns a name following the policy made from name, replacing invalid
+// characters with underscores.
+func (p Policy) Fix(name string) string {
+	if p == POSIX {
+		name = strings.ToUpper(name)
+	}
+
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			b.WriteRune(r)
+		case r >= 'a' && r <= 'z' && p != POSIX:
+			b.WriteRune(r)
+		case r == '.' && p == AllowDots:
+			b.WriteRune(r)
+		default:
+			b.WriteByte('_')
+		}
+	}
+
+	fixed := b.String()
+	if fixed == "" || (fixed[0] >= '0' && fixed[0] <= '9') || fixed[0] == '.' {
+		fixed = "_" + fixed
+	}
+	return fixed
+}
+
+var (
+	mu      sync.RWMutex
+	current = DefaultPolicy
+)
+
+// SetPolicy sets the policy used by Validate.
+func SetPolicy(p Policy) {
+	mu.Lock()
+	defer mu.Unlock()
+	current = p
+}
+
+// CurrentPolicy returns the policy used by Validate.
+func CurrentPolicy() Policy {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current
+}
+
+// Validate checks that name follows the current policy. It is used as the
+// validator of the name fields of the database schema.
+func Validate(name string) error {
+	return CurrentPolicy().Validate(name)
+}
@@ -0,0 +1,86 @@
+package varname
+
+import "testing"
+
+func TestParsePolicy(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    Policy
+		wantErr bool
+	}{
+		{s: "", want: DefaultPolicy},
+		{s: "posix", want: POSIX},
+		{s: "allow-lowercase", want: AllowLowercase},
+		{s: "allow-dots", want: AllowDots},
+		{s: "POSIX", wantErr: true},
+		{s: "strict", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParsePolicy(tt.s)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParsePolicy(%q): error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParsePolicy(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestPolicyValidate(t *testing.T) {
+	tests := []struct {
+		policy  Policy
+		name    string
+		wantErr bool
+	}{
+		{policy: POSIX, name: "DB_HOST"},
+		{policy: POSIX, name: "_PRIVATE"},
+		{policy: POSIX, name: "db_host", wantErr: true},
+		{policy: POSIX, name: "1DB", wantErr: true},
+		{policy: POSIX, name: "", wantErr: true},
+		{policy: AllowLowercase, name: "db_host"},
+		{policy: AllowLowercase, name: "DB_HOST2"},
+		{policy: AllowLowercase, name: "db.host", wantErr: true},
+		{policy: AllowLowercase, name: "db-host", wantErr: true},
+		{policy: AllowDots, name: "spring.datasource.url"},
+		{policy: AllowDots, name: ".hidden", wantErr: true},
+		{policy: AllowDots, name: "db-host", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		err := tt.policy.Validate(tt.name)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate(%q): error = %v, wantErr %v", tt.policy, tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestPolicyFix(t *testing.T) {
+	tests := []struct {
+		policy Policy
+		name   string
+		want   string
+	}{
+		{policy: POSIX, name: "db-host", want: "DB_HOST"},
+		{policy: POSIX, name: "db.host", want: "DB_HOST"},
+		{policy: POSIX, name: "1password", want: "_1PASSWORD"},
+		{policy: POSIX, name: "", want: "_"},
+		{policy: AllowLowercase, name: "db-host", want: "db_host"},
+		{policy: AllowLowercase, name: "café", want: "caf_"},
+		{policy: AllowLowercase, name: "db.host", want: "db_host"},
+		{policy: AllowDots, name: "db.host", want: "db.host"},
+		{policy: AllowDots, name: ".hidden", want: "_.hidden"},
+		{policy: AllowDots, name: "my app", want: "my_app"},
+	}
+
+	for _, tt := range tests {
+		got := tt.policy.Fix(tt.name)
+		if got != tt.want {
+			t.Errorf("%s: Fix(%q) = %q, want %q", tt.policy, tt.name, got, tt.want)
+		}
+		if err := tt.policy.Validate(got); err != nil {
+			t.Errorf("%s: Fix(%q) = %q does not follow the policy: %v", tt.policy, tt.name, got, err)
+		}
+	}
+}
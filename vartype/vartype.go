@@ -0,0 +1,256 @@
+// Package vartype provides types and validation rules of variable values.
+package vartype
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Type is the type of the value of a variable.
+type Type string
+
+const (
+	String   Type = "string"
+	Int      Type = "int"
+	Bool     Type = "bool"
+	URL      Type = "url"
+	Port     Type = "port"
+	Duration Type = "duration"
+	Email    Type = "email"
+	JSON     Type = "json"
+	Enum     Type = "enum"
+)
+
+// Types is the list of all types.
+var Types = []Type{String, Int, Bool, URL, Port, Duration, Email, JSON, Enum}
+
+// ValidateType checks that s is the name of a type.
+func ValidateType(s string) error {
+	if !slices.Contains(Types, Type(s)) {
+		names := make([]string, len(Types))
+		for i, t := range Types {
+			names[i] = string(t)
+		}
+		return fmt.Errorf("invalid type '%s' (must be one of %s)", s, strings.Join(names, ", "))
+	}
+	return nil
+}
+
+// Rule is the validation rule of a variable.
+type Rule struct {
+	Type Type
+	// Pattern is a regular expression that must match the whole value.
+	Pattern string
+	// Min and Max are the bounds of the value: the length for string, the
+	// value for int and port, and a duration such as "1s" for duration.
+	Min string
+	Max string
+	// Values is the list of allowed values for enum.
+	Values []string
+}
+
+// Check checks that the rule itself is valid.
+func (r *Rule) Check() error {
+	if r.Type != "" {
+		if err := ValidateType(string(r.Type)); err != nil {
+			return err
+		}
+	}
+
+	if r.Pattern != "" {
+		if _, err := compilePattern(r.Pattern); err != nil {
+			return err
+		}
+	}
+
+	if r.Min != "" || r.Max != "" {
+		if !r.hasBounds() {
+			return fmt.Errorf("min and max are not supported for type '%s'", r.Type)
+		}
+
+		var min, max float64
+		var err error
+		if r.Min != "" {
+			min, err = r.parseBound(r.Min)
+			if err != nil {
+				return fmt.Errorf("invalid min: %w", err)
+			}
+		}
+		if r.Max != "" {
+			max, err = r.parseBound(r.Max)
+			if err != nil {
+				return fmt.Errorf("invalid max: %w", err)
+			}
+		}
+		if r.Min != "" && r.Max != "" && min > max {
+			return fmt.Errorf("min %s is greater than max %s", r.Min, r.Max)
+		}
+	}
+
+	if r.Type == Enum && len(r.Values) == 0 {
+		return errors.New("values are required for type 'enum'")
+	}
+	if r.Type != Enum && len(r.Values) > 0 {
+		return fmt.Errorf("values are not supported for type '%s'", r.Type)
+	}
+
+	return nil
+}
+
+// Validate checks that value satisfies the rule. Errors do not include the
+// value, which may be secret.
+func (r *Rule) Validate(value string) error {
+	if err := r.validateType(value); err != nil {
+		return err
+	}
+
+	if r.Pattern != "" {
+		re, err := compilePattern(r.Pattern)
+		if err != nil {
+			return err
+		}
+		if !re.MatchString(value) {
+			return fmt.Errorf("must match the pattern '%s'", r.Pattern)
+		}
+	}
+
+	if r.hasBounds() && (r.Min != "" || r.Max != "") {
+		n, err := r.measure(value)
+		if err != nil {
+			return err
+		}
+		if r.Min != "" {
+			min, err := r.parseBound(r.Min)
+			if err != nil {
+				return fmt.Errorf("invalid min: %w", err)
+			}
+			if n < min {
+				return fmt.Errorf("%s must be at least %s", r.quantity(), r.Min)
+			}
+		}
+		if r.Max != "" {
+			max, err := r.parseBound(r.Max)
+			if err != nil {
+				return fmt.Errorf("invalid max: %w", err)
+			}
+			if n > max {
+				return fmt.Errorf("%s must be at most %s", r.quantity(), r.Max)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *Rule) validateType(value string) error {
+	switch r.Type {
+	case "", String:
+	case Int:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return errors.New("must be an integer")
+		}
+	case Bool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errors.New("must be a boolean (true or false)")
+		}
+	case URL:
+		u, err := url.Parse(value)
+		if err != nil || u.Scheme == "" || (u.Host == "" && u.Opaque == "") {
+			return errors.New("must be an absolute URL")
+		}
+	case Port:
+		n, err := strconv.ParseUint(value, 10, 16)
+		if err != nil || n == 0 {
+			return errors.New("must be a port number (1-65535)")
+		}
+	case Duration:
+		if _, err := time.ParseDuration(value); err != nil {
+			return errors.New("must be a duration (e.g. 30s, 5m, 1h30m)")
+		}
+	case Email:
+		addr, err := mail.ParseAddress(value)
+		if err != nil || addr.Address != value {
+			return errors.New("must be an email address")
+		}
+	case JSON:
+		if !json.Valid([]byte(value)) {
+			return errors.New("must be valid JSON")
+		}
+	case Enum:
+		if !slices.Contains(r.Values, value) {
+			return fmt.Errorf("must be one of %s", strings.Join(r.Values, ", "))
+		}
+	default:
+		return ValidateType(string(r.Type))
+	}
+
+	return nil
+}
+
+func (r *Rule) hasBounds() bool {
+	switch r.Type {
+	case "", String, Int, Port, Duration:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseBound parses min or max.
+func (r *Rule) parseBound(s string) (float64, error) {
+	switch r.Type {
+	case Duration:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return 0, fmt.Errorf("'%s' is not a duration", s)
+		}
+		return float64(d), nil
+	default:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("'%s' is not an integer", s)
+		}
+		return float64(n), nil
+	}
+}
+
+// measure returns the quantity of value compared with min and max.
+func (r *Rule) measure(value string) (float64, error) {
+	switch r.Type {
+	case Int, Port:
+		n, err := strconv.ParseInt(value, 10, 64)
+		return float64(n), err
+	case Duration:
+		d, err := time.ParseDuration(value)
+		return float64(d), err
+	default:
+		return float64(utf8.RuneCountInString(value)), nil
+	}
+}
+
+// quantity returns the name of the quantity compared with min and max.
+func (r *Rule) quantity() string {
+	switch r.Type {
+	case Int, Port, Duration:
+		return "value"
+	default:
+		return "length"
+	}
+}
+
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	re, err := regexp.Compile(`^(?:` + pattern + `)$`)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern '%s': %w", pattern, err)
+	}
+	return re, nil
+}
@@ -0,0 +1,96 @@
+package vartype
+
+import "testing"
+
+func TestValidateType(t *testing.T) {
+	for _, typ := range Types {
+		if err := ValidateType(string(typ)); err != nil {
+			t.Errorf("ValidateType(%q): unexpected error: %v", typ, err)
+		}
+	}
+
+	for _, s := range []string{"", "integer", "String"} {
+		if err := ValidateType(s); err == nil {
+			t.Errorf("ValidateType(%q): expected an error", s)
+		}
+	}
+}
+
+func TestRuleCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    Rule
+		wantErr bool
+	}{
+		{name: "empty", rule: Rule{}},
+		{name: "string bounds", rule: Rule{Type: String, Min: "1", Max: "10"}},
+		{name: "duration bounds", rule: Rule{Type: Duration, Min: "1s", Max: "1h"}},
+		{name: "enum", rule: Rule{Type: Enum, Values: []string{"a", "b"}}},
+		{name: "invalid type", rule: Rule{Type: "integer"}, wantErr: true},
+		{name: "invalid pattern", rule: Rule{Pattern: "("}, wantErr: true},
+		{name: "bounds not supported", rule: Rule{Type: Bool, Min: "1"}, wantErr: true},
+		{name: "invalid min", rule: Rule{Type: Int, Min: "one"}, wantErr: true},
+		{name: "invalid duration max", rule: Rule{Type: Duration, Max: "10"}, wantErr: true},
+		{name: "min greater than max", rule: Rule{Type: Int, Min: "10", Max: "1"}, wantErr: true},
+		{name: "enum without values", rule: Rule{Type: Enum}, wantErr: true},
+		{name: "values without enum", rule: Rule{Type: String, Values: []string{"a"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Check()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Check(): error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRuleValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    Rule
+		value   string
+		wantErr bool
+	}{
+		{name: "no rule", rule: Rule{}, value: "anything"},
+		{name: "int", rule: Rule{Type: Int}, value: "-42"},
+		{name: "int invalid", rule: Rule{Type: Int}, value: "4.2", wantErr: true},
+		{name: "bool", rule: Rule{Type: Bool}, value: "true"},
+		{name: "bool invalid", rule: Rule{Type: Bool}, value: "yes", wantErr: true},
+		{name: "url", rule: Rule{Type: URL}, value: "https://example.com/path"},
+		{name: "url opaque", rule: Rule{Type: URL}, value: "mailto:user@example.com"},
+		{name: "url relative", rule: Rule{Type: URL}, value: "/path", wantErr: true},
+		{name: "port", rule: Rule{Type: Port}, value: "8080"},
+		{name: "port zero", rule: Rule{Type: Port}, value: "0", wantErr: true},
+		{name: "port too large", rule: Rule{Type: Port}, value: "65536", wantErr: true},
+		{name: "duration", rule: Rule{Type: Duration}, value: "1h30m"},
+		{name: "duration invalid", rule: Rule{Type: Duration}, value: "30", wantErr: true},
+		{name: "email", rule: Rule{Type: Email}, value: "user@example.com"},
+		{name: "email with name", rule: Rule{Type: Email}, value: "User <user@example.com>", wantErr: true},
+		{name: "json", rule: Rule{Type: JSON}, value: `{"a": [1, 2]}`},
+		{name: "json invalid", rule: Rule{Type: JSON}, value: `{"a":`, wantErr: true},
+		{name: "enum", rule: Rule{Type: Enum, Values: []string{"debug", "info"}}, value: "info"},
+		{name: "enum invalid", rule: Rule{Type: Enum, Values: []string{"debug", "info"}}, value: "warn", wantErr: true},
+		{name: "pattern", rule: Rule{Pattern: "[a-z]+"}, value: "abc"},
+		{name: "pattern matches whole value", rule: Rule{Pattern: "[a-z]+"}, value: "abc1", wantErr: true},
+		{name: "string min", rule: Rule{Min: "3"}, value: "ab", wantErr: true},
+		{name: "string max counts runes", rule: Rule{Max: "3"}, value: "日本語"},
+		{name: "string max", rule: Rule{Max: "3"}, value: "abcd", wantErr: true},
+		{name: "int in bounds", rule: Rule{Type: Int, Min: "1", Max: "10"}, value: "10"},
+		{name: "int below min", rule: Rule{Type: Int, Min: "1"}, value: "0", wantErr: true},
+		{name: "port above max", rule: Rule{Type: Port, Max: "1023"}, value: "8080", wantErr: true},
+		{name: "duration in bounds", rule: Rule{Type: Duration, Min: "1s", Max: "1m"}, value: "30s"},
+		{name: "duration above max", rule: Rule{Type: Duration, Max: "1m"}, value: "2m", wantErr: true},
+		{name: "invalid type", rule: Rule{Type: "integer"}, value: "1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate(%q): error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}